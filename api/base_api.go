@@ -19,6 +19,7 @@ import (
 
 type BaseApi struct {
 	engine   *consensus.Engine
+	bc       *blockchain.Blockchain
 	txpool   *mempool.TxPool
 	ks       *keystore.KeyStore
 	secStore *secstore.SecStore
@@ -29,8 +30,8 @@ type BaseTxArgs struct {
 	Epoch uint16 `json:"epoch"`
 }
 
-func NewBaseApi(engine *consensus.Engine, txpool *mempool.TxPool, ks *keystore.KeyStore, secStore *secstore.SecStore) *BaseApi {
-	return &BaseApi{engine, txpool, ks, secStore}
+func NewBaseApi(engine *consensus.Engine, bc *blockchain.Blockchain, txpool *mempool.TxPool, ks *keystore.KeyStore, secStore *secstore.SecStore) *BaseApi {
+	return &BaseApi{engine, bc, txpool, ks, secStore}
 }
 
 func (api *BaseApi) getAppState() *appstate.AppState {
@@ -51,7 +52,8 @@ func (api *BaseApi) getTx(from common.Address, to *common.Address, txType types.
 	// if maxFee is not set, we set it as 2x from fee
 	if maxFee == (decimal.Decimal{}) || maxFee == decimal.Zero {
 		tx := blockchain.BuildTx(api.getAppState(), from, to, txType, amount, maxFee, tips, nonce, epoch, payload)
-		txFee := fee.CalculateFee(api.getAppState().ValidatorsCache.NetworkSize(), api.getAppState().State.FeePerByte(), tx)
+		forkActivated := api.bc.Config().Consensus.IsForkActivated(api.bc.GetCurrentHead().Height() + 1)
+		txFee := fee.CalculateFee(api.getAppState().ValidatorsCache.NetworkSize(), api.getAppState().State.FeePerByte(), tx, forkActivated)
 		maxFee = blockchain.ConvertToFloat(new(big.Int).Mul(txFee, big.NewInt(2)))
 	}
 
@@ -85,11 +87,7 @@ func (api *BaseApi) sendTx(ctx context.Context, from common.Address, to *common.
 func (api *BaseApi) sendInternalTx(ctx context.Context, tx *types.Transaction) (common.Hash, error) {
 	log.Info("Sending new tx", "ip", ctx.Value("remote"), "type", tx.Type, "hash", tx.Hash().Hex(), "nonce", tx.AccountNonce, "epoch", tx.Epoch)
 
-	if err := api.txpool.Add(tx); err != nil {
-		return common.Hash{}, err
-	}
-
-	return tx.Hash(), nil
+	return api.bc.BroadcastTx(tx)
 }
 
 func (api *BaseApi) signTransaction(from common.Address, tx *types.Transaction, key *ecdsa.PrivateKey) (*types.Transaction, error) {
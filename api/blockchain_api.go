@@ -34,6 +34,11 @@ var (
 		types.BurnTx:               "burn",
 		types.ChangeProfileTx:      "changeProfile",
 		types.DeleteFlipTx:         "deleteFlip",
+		types.DelegateTx:           "delegate",
+		types.UnstakeTx:            "unstake",
+		types.ParamChangeTx:        "paramChange",
+		types.ClaimRewardsTx:       "claimRewards",
+		types.SubmitFlipKeyTx:      "submitFlipKey",
 	}
 )
 
@@ -76,6 +81,8 @@ type Transaction struct {
 	Nonce     uint32          `json:"nonce"`
 	Epoch     uint16          `json:"epoch"`
 	Payload   hexutil.Bytes   `json:"payload"`
+	DataHash  common.Hash     `json:"dataHash"`
+	DataSize  int             `json:"dataSize"`
 	BlockHash common.Hash     `json:"blockHash"`
 	UsedFee   decimal.Decimal `json:"usedFee"`
 	Timestamp int64           `json:"timestamp"`
@@ -88,13 +95,15 @@ type BurntCoins struct {
 }
 
 func (api *BlockchainApi) LastBlock() *Block {
-	return api.BlockAt(api.bc.Head.Height())
+	return api.Block(api.bc.GetCurrentHead().Hash())
 }
 
-func (api *BlockchainApi) BlockAt(height uint64) *Block {
-	block := api.bc.GetBlockByHeight(height)
-
-	return convertToBlock(block)
+func (api *BlockchainApi) BlockAt(height uint64) (*Block, error) {
+	block, err := api.bc.GetBlockByHeightWithError(height)
+	if err != nil {
+		return nil, err
+	}
+	return convertToBlock(block), nil
 }
 
 func (api *BlockchainApi) Block(hash common.Hash) *Block {
@@ -122,15 +131,17 @@ func (api *BlockchainApi) Transaction(hash common.Hash) *Transaction {
 	var blockHash common.Hash
 	var feePerByte *big.Int
 	var timestamp int64
+	var forkActivated bool
 	if idx != nil {
 		blockHash = idx.BlockHash
 		block := api.bc.GetBlock(blockHash)
 		if block != nil {
 			feePerByte = block.Header.FeePerByte()
 			timestamp = block.Header.Time()
+			forkActivated = api.bc.Config().Consensus.IsForkActivated(block.Height())
 		}
 	}
-	return convertToTransaction(tx, blockHash, feePerByte, timestamp)
+	return convertToTransaction(tx, blockHash, feePerByte, timestamp, forkActivated)
 }
 
 func (api *BlockchainApi) Mempool() []common.Hash {
@@ -198,7 +209,7 @@ func (api *BlockchainApi) PendingTransactions(args TransactionsArgs) Transaction
 
 	var list []*Transaction
 	for _, item := range txs {
-		list = append(list, convertToTransaction(item, common.Hash{}, nil, 0))
+		list = append(list, convertToTransaction(item, common.Hash{}, nil, 0, false))
 	}
 
 	return Transactions{
@@ -211,6 +222,11 @@ func (api *BlockchainApi) FeePerByte() *big.Int {
 	return api.baseApi.getAppState().State.FeePerByte()
 }
 
+// RebuildValidatorsCache reloads validators from the current identity state
+func (api *BlockchainApi) RebuildValidatorsCache() error {
+	return api.bc.RebuildValidatorsCache()
+}
+
 func (api *BlockchainApi) SendRawTx(ctx context.Context, bytesTx hexutil.Bytes) (common.Hash, error) {
 	var tx types.Transaction
 	if err := tx.FromBytes(bytesTx); err != nil {
@@ -254,7 +270,11 @@ func (api *BlockchainApi) Transactions(args TransactionsArgs) Transactions {
 
 	var list []*Transaction
 	for _, item := range txs {
-		list = append(list, convertToTransaction(item.Tx, item.BlockHash, item.FeePerByte, item.Timestamp))
+		var forkActivated bool
+		if block := api.bc.GetBlock(item.BlockHash); block != nil {
+			forkActivated = api.bc.Config().Consensus.IsForkActivated(block.Height())
+		}
+		list = append(list, convertToTransaction(item.Tx, item.BlockHash, item.FeePerByte, item.Timestamp, forkActivated))
 	}
 
 	var token *hexutil.Bytes
@@ -281,12 +301,14 @@ func (api *BlockchainApi) BurntCoins() []BurntCoins {
 	return res
 }
 
-func convertToTransaction(tx *types.Transaction, blockHash common.Hash, feePerByte *big.Int, timestamp int64) *Transaction {
+func convertToTransaction(tx *types.Transaction, blockHash common.Hash, feePerByte *big.Int, timestamp int64, forkActivated bool) *Transaction {
 	sender, _ := types.Sender(tx)
 	return &Transaction{
 		Hash:      tx.Hash(),
 		Epoch:     tx.Epoch,
 		Payload:   tx.Payload,
+		DataHash:  tx.DataHash(),
+		DataSize:  tx.DataSize(),
 		Amount:    blockchain.ConvertToFloat(tx.Amount),
 		MaxFee:    blockchain.ConvertToFloat(tx.MaxFee),
 		Tips:      blockchain.ConvertToFloat(tx.Tips),
@@ -296,7 +318,7 @@ func convertToTransaction(tx *types.Transaction, blockHash common.Hash, feePerBy
 		Type:      txTypeMap[tx.Type],
 		BlockHash: blockHash,
 		Timestamp: timestamp,
-		UsedFee:   blockchain.ConvertToFloat(fee.CalculateFee(1, feePerByte, tx)),
+		UsedFee:   blockchain.ConvertToFloat(fee.CalculateFee(1, feePerByte, tx, forkActivated)),
 	}
 }
 
@@ -274,27 +274,7 @@ func getIdentityOnlineStatus(state *appstate.AppState, addr common.Address) bool
 }
 
 func convertIdentity(currentEpoch uint16, address common.Address, data state.Identity, flipKeyWordPairs []int) Identity {
-	var s string
-	switch data.State {
-	case state.Invite:
-		s = "Invite"
-	case state.Candidate:
-		s = "Candidate"
-	case state.Newbie:
-		s = "Newbie"
-	case state.Verified:
-		s = "Verified"
-	case state.Suspended:
-		s = "Suspended"
-	case state.Zombie:
-		s = "Zombie"
-	case state.Killed:
-		s = "Killed"
-	case state.Human:
-		s = "Human"
-	default:
-		s = "Undefined"
-	}
+	s := data.StateString()
 
 	var flags []string
 	if data.LastValidationStatus.HasFlag(state.AllFlipsNotQualified) {
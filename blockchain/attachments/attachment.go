@@ -309,3 +309,46 @@ func ParseDeleteFlipAttachment(tx *types.Transaction) *DeleteFlipAttachment {
 	}
 	return attachment
 }
+
+type ParamChangeAttachment struct {
+	Key   string
+	Value []byte
+}
+
+func (s *ParamChangeAttachment) ToBytes() ([]byte, error) {
+	protoAttachment := &models.ProtoParamChangeAttachment{
+		Key:   s.Key,
+		Value: s.Value,
+	}
+	return proto.Marshal(protoAttachment)
+}
+
+func (s *ParamChangeAttachment) FromBytes(data []byte) error {
+	protoAttachment := new(models.ProtoParamChangeAttachment)
+	if err := proto.Unmarshal(data, protoAttachment); err != nil {
+		return err
+	}
+	s.Key = protoAttachment.Key
+	s.Value = protoAttachment.Value
+	return nil
+}
+
+func CreateParamChangeAttachment(key string, value []byte) []byte {
+	attachment := &ParamChangeAttachment{
+		Key:   key,
+		Value: value,
+	}
+	payload, _ := attachment.ToBytes()
+	return payload
+}
+
+func ParseParamChangeAttachment(tx *types.Transaction) *ParamChangeAttachment {
+	if len(tx.Payload) == 0 {
+		return nil
+	}
+	attachment := new(ParamChangeAttachment)
+	if err := attachment.FromBytes(tx.Payload); err != nil {
+		return nil
+	}
+	return attachment
+}
@@ -2,10 +2,12 @@ package blockchain
 
 import (
 	"bytes"
+	"context"
 	"crypto/ecdsa"
 	"fmt"
 	mapset "github.com/deckarep/golang-set"
 	"github.com/golang/protobuf/proto"
+	lru "github.com/hashicorp/golang-lru"
 	"github.com/idena-network/idena-go/blockchain/attachments"
 	"github.com/idena-network/idena-go/blockchain/fee"
 	"github.com/idena-network/idena-go/blockchain/types"
@@ -20,6 +22,7 @@ import (
 	"github.com/idena-network/idena-go/core/state/snapshot"
 	"github.com/idena-network/idena-go/core/validators"
 	"github.com/idena-network/idena-go/crypto"
+	"github.com/idena-network/idena-go/crypto/vrf"
 	"github.com/idena-network/idena-go/crypto/vrf/p256"
 	"github.com/idena-network/idena-go/database"
 	"github.com/idena-network/idena-go/events"
@@ -35,7 +38,10 @@ import (
 	dbm "github.com/tendermint/tm-db"
 	math2 "math"
 	"math/big"
+	"runtime"
 	"sort"
+	"sync"
+	"sync/atomic"
 	"time"
 )
 
@@ -47,20 +53,91 @@ const (
 const (
 	ProposerRole            uint8 = 0x1
 	EmptyBlockTimeIncrement       = time.Second * 20
-	MaxFutureBlockOffset          = time.Minute * 2
-	MinBlockDelay                 = time.Second * 10
+	MaxBlockRange                 = 10000
+	FeeHistoryPruneInterval       = time.Minute * 10
+	ScanBlocksBatchSize           = 100
+
+	blockCacheSize = 256
+	// blocks deeper than this are evicted from cache on block insertion
+	blockCacheDepth = 10
+
+	// space left for the header when block transactions are selected by size
+	blockHeaderSizeReserve = 4 * 1024
+
+	// count of the latest blocks which are never pruned
+	minPruneDepth = 1000
+
+	// count of the latest blocks searched by GetRecentEmptyBlocks
+	maxEmptyBlocksSearch = 10000
+
+	// proposer threshold is lowered above and raised below these counts of empty blocks among the latest
+	// state.RecentBlocksWindow blocks, that is 5% and 1% of them
+	highRecentEmptyBlocks   = 5
+	lowRecentEmptyBlocks    = 1
+	minAdaptiveVrfThreshold = 0.001
+	maxAdaptiveVrfThreshold = 0.999
+)
+
+// Block validation error codes
+const (
+	ErrInvalidSeed = iota + 1
+	ErrInvalidTxHash
+	ErrUnknownProposer
+	ErrInvalidRoot
+	ErrInvalidParentHash
+	ErrInvalidCoinbase
+	ErrInvalidTimestamp
+	ErrInvalidBodyRoot
+	ErrInvalidEmptyBlock
+	ErrInvalidBlockSize
+	ErrInvalidFeeRate
+	ErrInvalidTxOrder
+	ErrInvalidTxBloom
+	ErrInvalidFlags
+	ErrInvalidCid
+	ErrInvalidExtraData
 )
 
 var (
-	MaxHash             *big.Float
-	ParentHashIsInvalid = errors.New("parentHash is invalid")
-	BlockInsertionErr   = errors.New("can't insert block")
+	MaxHash                    *big.Float
+	ParentHashIsInvalid        = &BlockValidationError{Code: ErrInvalidParentHash, msg: "parentHash is invalid"}
+	ErrFutureBlock             = &BlockValidationError{Code: ErrInvalidTimestamp, msg: "block from future"}
+	ErrBlockTooFrequent        = &BlockValidationError{Code: ErrInvalidTimestamp, msg: "block is earlier than min block interval"}
+	BlockInsertionErr          = errors.New("can't insert block")
+	ErrBlockTxCountExceeded    = &BlockValidationError{Code: ErrInvalidBlockSize, msg: "block transactions count exceeded"}
+	ErrBlockTxCapacityExceeded = &BlockValidationError{Code: ErrInvalidBlockSize, msg: "block transactions count exceeded network capacity"}
+	ErrBlockTooLarge           = &BlockValidationError{Code: ErrInvalidBlockSize, msg: "block size exceeded"}
+	ErrTxNotFound              = errors.New("transaction is not found")
+	ErrHeightOutOfRange        = errors.New("height is out of range")
+	ErrCommitteeNotFound       = errors.New("committee is not found")
+	ErrCertNotFound            = errors.New("certificate is not found")
+	ErrExtraDataTooLarge       = &BlockValidationError{Code: ErrInvalidExtraData, msg: "header extra data is too large"}
+	ErrBeforeGenesis           = errors.New("time is before genesis")
+	ErrTxOutOfOrder            = &BlockValidationError{Code: ErrInvalidTxOrder, msg: "block transactions are not in canonical order"}
+	ErrBlockPruned             = errors.New("block is pruned")
+	ErrIdentityStateMismatch   = errors.New("identity state doesn't match current head")
+	ErrFeeExceedsMaxFee        = validation.BigFee
+	ErrInvalidActivationTarget = validation.InvalidRecipient
 )
 
+// BlockValidationError is returned by block validation, Code allows callers to distinguish failure reasons
+type BlockValidationError struct {
+	Code int
+	msg  string
+}
+
+func newBlockValidationError(code int, format string, args ...interface{}) *BlockValidationError {
+	return &BlockValidationError{Code: code, msg: fmt.Sprintf(format, args...)}
+}
+
+func (e *BlockValidationError) Error() string {
+	return e.msg
+}
+
 type Blockchain struct {
 	repo            *database.Repo
 	secStore        *secstore.SecStore
-	Head            *types.Header
+	currentHead     atomic.Value
 	PreliminaryHead *types.Header
 	genesis         *types.Header
 	config          *config.Config
@@ -77,6 +154,18 @@ type Blockchain struct {
 	bus             eventbus.Bus
 	applyNewEpochFn func(height uint64, appState *appstate.AppState, collector collector.StatsCollector) (int, *types.ValidationResults, bool)
 	isSyncing       bool
+	subscribers     map[chan<- *types.Block]struct{}
+	subscribersLock sync.Mutex
+	blockCache      *lru.Cache
+	metrics         *ChainMetrics
+	genesisPatch    atomic.Value
+	headParams      atomic.Value
+	blockIntervals  *blockIntervals
+	unusedInvites   *unusedInvitesCache
+	keyStore        *keystore.KeyStore
+	stopFeePruning  chan struct{}
+	// preset by WithVRFSigner, the secure store evaluates VRF otherwise
+	vrfSigner vrf.PrivateKey
 }
 
 func init() {
@@ -89,21 +178,31 @@ func init() {
 	MaxHash = new(big.Float).SetInt(i)
 }
 
-func NewBlockchain(config *config.Config, db dbm.DB, txpool *mempool.TxPool, appState *appstate.AppState,
-	ipfs ipfs.Proxy, secStore *secstore.SecStore, bus eventbus.Bus, offlineDetector *OfflineDetector, keyStore *keystore.KeyStore) *Blockchain {
-	return &Blockchain{
-		repo:            database.NewRepo(db),
-		config:          config,
-		log:             log.New(),
-		txpool:          txpool,
-		appState:        appState,
-		ipfs:            ipfs,
-		timing:          NewTiming(config.Validation),
-		bus:             bus,
-		secStore:        secStore,
-		offlineDetector: offlineDetector,
-		indexer:         newBlockchainIndexer(db, bus, config, keyStore),
+// NewBlockchain creates the chain with dependencies provided by options, WithRequiredDefaults provides the mandatory ones
+func NewBlockchain(config *config.Config, db dbm.DB, opts ...Option) (*Blockchain, error) {
+	blockCache, _ := lru.New(blockCacheSize)
+	chain := &Blockchain{
+		repo:           database.NewRepo(db),
+		config:         config,
+		log:            log.New(),
+		timing:         NewTiming(config.Validation),
+		subscribers:    make(map[chan<- *types.Block]struct{}),
+		blockCache:     blockCache,
+		metrics:        new(ChainMetrics),
+		blockIntervals: newBlockIntervals(blockIntervalsSize, time.Now),
+		unusedInvites:  new(unusedInvitesCache),
+	}
+	for _, opt := range opts {
+		opt(chain)
+	}
+	if err := chain.checkRequiredDependencies(); err != nil {
+		return nil, err
+	}
+	chain.indexer = newBlockchainIndexer(db, chain.bus, config, chain.keyStore)
+	if err := config.Consensus.Validate(); err != nil {
+		return nil, err
 	}
+	return chain, nil
 }
 
 func (chain *Blockchain) ProvideApplyNewEpochFunc(fn func(height uint64, appState *appstate.AppState, collector collector.StatsCollector) (int, *types.ValidationResults, bool)) {
@@ -111,6 +210,11 @@ func (chain *Blockchain) ProvideApplyNewEpochFunc(fn func(height uint64, appStat
 }
 
 func (chain *Blockchain) GetHead() *types.Header {
+	if chain.GetCurrentHead() != nil {
+		if header := chain.cachedHeader(chain.GetCurrentHead().Hash()); header != nil {
+			return header
+		}
+	}
 	head := chain.repo.ReadHead()
 	return head
 }
@@ -133,7 +237,7 @@ func (chain *Blockchain) InitializeChain() error {
 	chain.pubKey = chain.secStore.GetPubKey()
 	head := chain.GetHead()
 	if head != nil {
-		chain.setCurrentHead(head)
+		chain.SetCurrentHead(head)
 		genesisHeight := uint64(1)
 
 		if chain.config.Network == Testnet {
@@ -143,6 +247,9 @@ func (chain *Blockchain) InitializeChain() error {
 			}
 			genesisHeight = predefinedState.Block
 		}
+		if importedGenesisHeight := chain.repo.ReadImportedGenesisHeight(); importedGenesisHeight > 0 {
+			genesisHeight = importedGenesisHeight
+		}
 
 		if chain.genesis = chain.GetBlockHeaderByHeight(genesisHeight); chain.genesis == nil {
 			return errors.New("genesis block is not found")
@@ -153,20 +260,30 @@ func (chain *Blockchain) InitializeChain() error {
 			return err
 		}
 	}
+	chain.refreshGovernedParams()
+	chain.loadGenesisPatch()
 	chain.indexer.initialize(chain.coinBaseAddress)
 	chain.PreliminaryHead = chain.repo.ReadPreliminaryHead()
-	log.Info("Chain initialized", "block", chain.Head.Hash().Hex(), "height", chain.Head.Height())
+	chain.startFeeHistoryPruning()
+	log.Info("Chain initialized", "block", chain.GetCurrentHead().Hash().Hex(), "height", chain.GetCurrentHead().Height())
 	log.Info("Coinbase address", "addr", chain.coinBaseAddress.Hex())
 	return nil
 }
 
-func (chain *Blockchain) setCurrentHead(head *types.Header) {
-	chain.Head = head
+// SetCurrentHead atomically replaces the current head
+func (chain *Blockchain) SetCurrentHead(head *types.Header) {
+	chain.currentHead.Store(head)
+}
+
+// GetCurrentHead returns the current head, it is safe to call concurrently with SetCurrentHead
+func (chain *Blockchain) GetCurrentHead() *types.Header {
+	head, _ := chain.currentHead.Load().(*types.Header)
+	return head
 }
 
 func (chain *Blockchain) setHead(height uint64, batch dbm.Batch) {
 	chain.repo.SetHead(batch, height)
-	chain.setCurrentHead(chain.GetHead())
+	chain.SetCurrentHead(chain.repo.ReadHead())
 }
 
 func (chain *Blockchain) GenerateGenesis(network types.Network) (*types.Block, error) {
@@ -232,7 +349,7 @@ func (chain *Blockchain) GenerateGenesis(network types.Network) (*types.Block, e
 	block := &types.Block{Header: &types.Header{
 		ProposedHeader: &types.ProposedHeader{
 			ParentHash:   emptyHash,
-			Time:         0,
+			Time:         chain.config.GenesisConf.GenesisTimestamp,
 			Height:       blockNumber,
 			Root:         chain.appState.State.Root(),
 			IdentityRoot: chain.appState.IdentityState.Root(),
@@ -250,20 +367,33 @@ func (chain *Blockchain) GenerateGenesis(network types.Network) (*types.Block, e
 }
 
 func (chain *Blockchain) generateEmptyBlock(checkState *appstate.AppState, prevBlock *types.Header) *types.Block {
+	return chain.generateEmptyBlockWithSeed(checkState, prevBlock, emptyBlockSeed(prevBlock), nil, nil)
+}
+
+// emptyBlockSeed returns the seed of every empty block following prevBlock, it doesn't depend on the proposer
+// so nobody can choose the seed by publishing an empty block
+func emptyBlockSeed(prevBlock *types.Header) types.Seed {
+	return types.Seed(crypto.Keccak256Hash(getSeedData(prevBlock)))
+}
+
+func (chain *Blockchain) generateEmptyBlockWithSeed(checkState *appstate.AppState, prevBlock *types.Header, seed types.Seed,
+	proposerPubKey []byte, seedProof []byte) *types.Block {
 	prevTimestamp := time.Unix(prevBlock.Time(), 0)
 
 	block := &types.Block{
 		Header: &types.Header{
 			EmptyBlockHeader: &types.EmptyBlockHeader{
-				ParentHash: prevBlock.Hash(),
-				Height:     prevBlock.Height() + 1,
-				Time:       prevTimestamp.Add(EmptyBlockTimeIncrement).Unix(),
+				ParentHash:     prevBlock.Hash(),
+				Height:         prevBlock.Height() + 1,
+				Time:           prevTimestamp.Add(EmptyBlockTimeIncrement).Unix(),
+				BlockSeed:      seed,
+				ProposerPubKey: proposerPubKey,
+				SeedProof:      seedProof,
 			},
 		},
 		Body: &types.Body{},
 	}
 
-	block.Header.EmptyBlockHeader.BlockSeed = types.Seed(crypto.Keccak256Hash(getSeedData(prevBlock)))
 	block.Header.EmptyBlockHeader.Flags = chain.calculateFlags(checkState, block)
 
 	chain.applyEmptyBlockOnState(checkState, block, nil)
@@ -274,28 +404,78 @@ func (chain *Blockchain) generateEmptyBlock(checkState *appstate.AppState, prevB
 }
 
 func (chain *Blockchain) GenerateEmptyBlock() *types.Block {
-	appState, _ := chain.appState.ForCheck(chain.Head.Height())
-	return chain.generateEmptyBlock(appState, chain.Head)
+	head := chain.GetCurrentHead()
+	appState, _ := chain.appState.ForCheck(head.Height())
+	return chain.generateEmptyBlock(appState, head)
+}
+
+// ProposeEmptyBlock builds empty block attributed to current node. The seed is the same as the seed of unattributed
+// empty block, the VRF proof of the seed data lets peers verify the proposer
+func (chain *Blockchain) ProposeEmptyBlock() *types.Block {
+	head := chain.GetCurrentHead()
+	appState, _ := chain.appState.ForCheck(head.Height())
+	_, seedProof := chain.vrfEvaluate(getSeedData(head))
+	return chain.generateEmptyBlockWithSeed(appState, head, emptyBlockSeed(head), chain.pubKey, seedProof)
+}
+
+// PrevalidatedBlock carries block proposal which may be already validated by local consensus against current head
+type PrevalidatedBlock struct {
+	Block     *types.Block
+	validated bool
+}
+
+func NewPrevalidatedBlock(block *types.Block, validated bool) *PrevalidatedBlock {
+	return &PrevalidatedBlock{
+		Block:     block,
+		validated: validated,
+	}
+}
+
+func (b *PrevalidatedBlock) Validated() bool {
+	return b.validated
 }
 
+// AddBlock validates and inserts block received from network or storage
 func (chain *Blockchain) AddBlock(block *types.Block, checkState *appstate.AppState,
 	statsCollector collector.StatsCollector) error {
+	return chain.addBlock(block, checkState, false, statsCollector)
+}
+
+// AddProposedBlock inserts block agreed by local consensus, block validation is skipped if it has been done during proposal phase
+func (chain *Blockchain) AddProposedBlock(block *PrevalidatedBlock, statsCollector collector.StatsCollector) error {
+	return chain.addBlock(block.Block, nil, block.Validated(), statsCollector)
+}
+
+func (chain *Blockchain) addBlock(block *types.Block, checkState *appstate.AppState, validated bool,
+	statsCollector collector.StatsCollector) error {
 
-	if err := validateBlockParentHash(block.Header, chain.Head); err != nil {
+	start := time.Now()
+	if err := validateBlockParentHash(block.Header, chain.GetCurrentHead()); err != nil {
+		chain.metrics.addValidationError()
 		return err
 	}
-	if err := chain.ValidateBlock(block, checkState); err != nil {
-		return err
+	if !validated {
+		if err := chain.ValidateBlock(block, checkState); err != nil {
+			chain.metrics.addValidationError()
+			return err
+		}
 	}
 	statsCollector.EnableCollecting()
 	defer statsCollector.CompleteCollecting()
 	diff, err := chain.processBlock(block, statsCollector)
 	if err != nil {
+		chain.metrics.addValidationError()
 		return err
 	}
 	if err := chain.insertBlock(block, diff); err != nil {
 		return err
 	}
+	chain.metrics.addBlock(len(block.Body.Transactions), block.IsEmpty(), time.Since(start))
+	if block.Header.Flags().HasFlag(types.ValidationFinished) {
+		if evicted := chain.txpool.EvictByEpoch(chain.appState.State.Epoch()); evicted > 0 {
+			chain.log.Info("Stale epoch txs evicted from mempool", "count", evicted)
+		}
+	}
 	if !chain.isSyncing {
 		chain.txpool.ResetTo(block)
 	}
@@ -310,14 +490,10 @@ func (chain *Blockchain) AddBlock(block *types.Block, checkState *appstate.AppSt
 func (chain *Blockchain) processBlock(block *types.Block,
 	statsCollector collector.StatsCollector) (diff *state.IdentityStateDiff, err error) {
 
-	var root, identityRoot common.Hash
-	if block.IsEmpty() {
-		root, identityRoot, diff = chain.applyEmptyBlockOnState(chain.appState, block, statsCollector)
-	} else {
-		if root, identityRoot, diff, err = chain.applyBlockAndTxsOnState(chain.appState, block, chain.Head, statsCollector); err != nil {
-			chain.appState.Reset()
-			return nil, err
-		}
+	root, identityRoot, diff, totalFee, err := chain.applyBlockSpeculative(block, statsCollector)
+	if err != nil {
+		chain.appState.Reset()
+		return nil, err
 	}
 
 	if root != block.Root() || identityRoot != block.IdentityRoot() {
@@ -325,38 +501,65 @@ func (chain *Blockchain) processBlock(block *types.Block,
 		return nil, errors.Errorf("Process block. Invalid block roots. Expected=%x & %x, actual=%x & %x", root, identityRoot, block.Root(), block.IdentityRoot())
 	}
 
+	identityStateChanges := chain.appState.State.IdentityStateChanges()
+	minted, burnt := chain.appState.State.SupplyChange()
+	burntFee := chain.calculateBurntFee(chain.GovernedParams(), totalFee)
 	if err := chain.appState.Commit(block); err != nil {
 		return nil, err
 	}
+	chain.refreshGovernedParams()
+	chain.writeIdentityStateChanges(block.Height(), identityStateChanges)
+	chain.writeSupplyRecord(block.Height(), minted, burnt)
+
+	chain.repo.WriteFeeRecord(&types.FeeRecord{
+		Height:    block.Height(),
+		TotalFee:  totalFee,
+		BurnedFee: burntFee,
+		TxCount:   len(block.Body.Transactions),
+	})
 
 	chain.log.Trace("Applied block", "root", fmt.Sprintf("0x%x", block.Root()), "height", block.Height())
 
 	return diff, nil
 }
 
+// applyBlockSpeculative applies block on uncommitted working state, caller resets the state if an error is returned
+func (chain *Blockchain) applyBlockSpeculative(block *types.Block, statsCollector collector.StatsCollector) (
+	root common.Hash, identityRoot common.Hash, diff *state.IdentityStateDiff, totalFee *big.Int, err error) {
+
+	if block.IsEmpty() {
+		root, identityRoot, diff = chain.applyEmptyBlockOnState(chain.appState, block, statsCollector)
+		return root, identityRoot, diff, big.NewInt(0), nil
+	}
+	return chain.applyBlockAndTxsOnState(chain.appState, block, chain.GetCurrentHead(), statsCollector)
+}
+
 func (chain *Blockchain) applyBlockAndTxsOnState(
 	appState *appstate.AppState,
 	block *types.Block,
 	prevBlock *types.Header,
 	statsCollector collector.StatsCollector,
-) (root common.Hash, identityRoot common.Hash, diff *state.IdentityStateDiff, err error) {
-	var totalFee, totalTips *big.Int
+) (root common.Hash, identityRoot common.Hash, diff *state.IdentityStateDiff, totalFee *big.Int, err error) {
+	var totalTips *big.Int
 	if totalFee, totalTips, err = chain.processTxs(appState, block, statsCollector); err != nil {
 		return
 	}
 
 	root, identityRoot, diff = chain.applyBlockOnState(appState, block, prevBlock, totalFee, totalTips, statsCollector)
-	return root, identityRoot, diff, nil
+	return root, identityRoot, diff, totalFee, nil
 }
 
 func (chain *Blockchain) applyBlockOnState(appState *appstate.AppState, block *types.Block, prevBlock *types.Header, totalFee, totalTips *big.Int, statsCollector collector.StatsCollector) (root common.Hash, identityRoot common.Hash, diff *state.IdentityStateDiff) {
 
 	chain.applyNewEpoch(appState, block, statsCollector)
 	chain.applyBlockRewards(totalFee, totalTips, appState, block, prevBlock, statsCollector)
+	chain.applyParamChanges(appState)
 	chain.applyStatusSwitch(appState, block)
+	chain.applyPendingWithdrawals(appState, block)
 	chain.applyGlobalParams(appState, block, statsCollector)
 	chain.applyNextBlockFee(appState, block)
 	chain.applyVrfProposerThreshold(appState, block)
+	chain.applyGenesisPatch(appState, block)
 	diff = appState.Precommit()
 
 	return appState.State.Root(), appState.IdentityState.Root(), diff
@@ -370,8 +573,10 @@ func (chain *Blockchain) applyEmptyBlockOnState(
 
 	chain.applyNewEpoch(appState, block, statsCollector)
 	chain.applyStatusSwitch(appState, block)
+	chain.applyPendingWithdrawals(appState, block)
 	chain.applyGlobalParams(appState, block, statsCollector)
 	chain.applyVrfProposerThreshold(appState, block)
+	chain.applyGenesisPatch(appState, block)
 	diff = appState.Precommit()
 
 	return appState.State.Root(), appState.IdentityState.Root(), diff
@@ -381,13 +586,12 @@ func (chain *Blockchain) applyBlockRewards(totalFee *big.Int, totalTips *big.Int
 	block *types.Block, prevBlock *types.Header, statsCollector collector.StatsCollector) {
 
 	// calculate fee reward
-	burnFee := decimal.NewFromBigInt(totalFee, 0)
-	burnFee = burnFee.Mul(decimal.NewFromFloat32(chain.config.Consensus.FeeBurnRate))
-	intBurn := math.ToInt(burnFee)
+	intBurn := chain.calculateBurntFee(chain.governedParams(appState.State), totalFee)
 	intFeeReward := new(big.Int)
 	intFeeReward.Sub(totalFee, intBurn)
 
-	totalReward := big.NewInt(0).Add(chain.config.Consensus.BlockReward, intFeeReward)
+	blockReward := chain.GetEffectiveBlockReward(block.Height())
+	totalReward := big.NewInt(0).Add(blockReward, intFeeReward)
 	totalReward.Add(totalReward, totalTips)
 
 	coinbase := block.Header.Coinbase()
@@ -400,12 +604,22 @@ func (chain *Blockchain) applyBlockRewards(totalFee *big.Int, totalTips *big.Int
 	collector.BeginProposerRewardBalanceUpdate(statsCollector, coinbase, appState)
 	// update state
 	appState.State.AddBalance(coinbase, balanceAdd)
-	appState.State.AddStake(coinbase, stakeAdd)
+	// stake is attributed to the block reward and to fees with tips in proportion to their shares of the total reward
+	stakeFromBlockReward := new(big.Int)
+	if totalReward.Sign() > 0 {
+		stakeFromBlockReward.Mul(stakeAdd, blockReward)
+		stakeFromBlockReward.Quo(stakeFromBlockReward, totalReward)
+	}
+	addStakeWithSource(appState, chain.config.Consensus, block.Height(), coinbase, stakeFromBlockReward, state.StakeFromBlockRewards)
+	addStakeWithSource(appState, chain.config.Consensus, block.Height(), coinbase, new(big.Int).Sub(stakeAdd, stakeFromBlockReward), state.StakeFromFeeRewards)
 	if penaltySub != nil {
 		appState.State.SubPenalty(coinbase, penaltySub)
 	}
+	appState.State.AddMintedCoins(blockReward)
+	appState.State.AddBurntCoins(intBurn)
+	appState.State.AddBurntCoins(penaltySub)
 	collector.CompleteBalanceUpdate(statsCollector, appState)
-	collector.AddMintedCoins(statsCollector, chain.config.Consensus.BlockReward)
+	collector.AddMintedCoins(statsCollector, blockReward)
 	collector.AfterAddStake(statsCollector, coinbase, stake, appState)
 	collector.AfterSubPenalty(statsCollector, coinbase, penaltySub, appState)
 	collector.AddPenaltyBurntCoins(statsCollector, coinbase, penaltySub)
@@ -414,6 +628,26 @@ func (chain *Blockchain) applyBlockRewards(totalFee *big.Int, totalTips *big.Int
 	chain.rewardFinalCommittee(appState, block, prevBlock, statsCollector)
 }
 
+// GetEffectiveBlockReward returns proposer reward of the block at the given height according to the halving schedule
+func (chain *Blockchain) GetEffectiveBlockReward(height uint64) *big.Int {
+	return effectiveBlockReward(chain.config.Consensus, height)
+}
+
+// GetUnclaimedRewards returns the sum of rewards which were not delivered to the address and can be claimed by ClaimRewardsTx
+func (chain *Blockchain) GetUnclaimedRewards(addr common.Address) (*big.Int, error) {
+	appState, err := chain.appState.Readonly(chain.GetCurrentHead().Height())
+	if err != nil {
+		return nil, err
+	}
+	return appState.State.GetPendingReward(addr), nil
+}
+
+func (chain *Blockchain) calculateBurntFee(params config.GovernedParams, totalFee *big.Int) *big.Int {
+	burnFee := decimal.NewFromBigInt(totalFee, 0)
+	burnFee = burnFee.Mul(decimal.NewFromFloat32(params.FeeBurnRate))
+	return math.ToInt(burnFee)
+}
+
 func calculatePenalty(balanceAppend *big.Int, stakeAppend *big.Int, currentPenalty *big.Int) (balanceAdd *big.Int, stakeAdd *big.Int, penaltySub *big.Int) {
 
 	if common.ZeroOrNil(currentPenalty) {
@@ -441,6 +675,12 @@ func (chain *Blockchain) applyNewEpoch(appState *appstate.AppState, block *types
 	if !block.Header.Flags().HasFlag(types.ValidationFinished) {
 		return
 	}
+	if expiry := chain.config.Consensus.InviteExpiryBlocks; expiry > 0 {
+		if removed := removeExpiredInvites(appState.State, block.Height(), expiry); removed > 0 {
+			chain.log.Info("Expired invites removed", "count", removed)
+		}
+	}
+
 	networkSize, validationResults, failed := chain.applyNewEpochFn(block.Height(), appState, statsCollector)
 	totalInvitesCount := float32(networkSize) * chain.config.Consensus.InvitesPercent
 	setNewIdentitiesAttributes(appState, totalInvitesCount, networkSize, failed, validationResults, statsCollector)
@@ -450,8 +690,16 @@ func (chain *Blockchain) applyNewEpoch(appState *appstate.AppState, block *types
 			statsCollector)
 	}
 
+	compoundStakes(appState, chain.config.Consensus, block.Height())
+
 	clearDustAccounts(appState, networkSize, statsCollector)
 
+	clearDelegations(appState)
+
+	expirePendingRewards(appState)
+
+	appState.State.ClearAllConsensusParamVotes()
+
 	appState.State.IncEpoch()
 	appState.State.ResetBlocksCntWithoutCeremonialTxs()
 
@@ -466,6 +714,98 @@ func (chain *Blockchain) applyNewEpoch(appState *appstate.AppState, block *types
 	appState.State.SetGodAddressInvites(common.GodAddressInvitesCount(networkSize))
 }
 
+// stakes of verified identities grow by interest rate every epoch after the fork
+func compoundStakes(appState *appstate.AppState, conf *config.ConsensusConf, height uint64) {
+	rate := conf.StakeInterestRate
+	if rate <= 0 || !conf.IsForkActivated(height) {
+		return
+	}
+	interests := make(map[common.Address]*big.Int)
+	appState.State.IterateOverIdentities(func(addr common.Address, identity state.Identity) {
+		if identity.State != state.Verified || common.ZeroOrNil(identity.Stake) {
+			return
+		}
+		interest := decimal.NewFromBigInt(identity.Stake, 0).Mul(decimal.NewFromFloat(rate))
+		interests[addr] = math.ToInt(interest)
+	})
+	for addr, interest := range interests {
+		appState.State.AddStake(addr, interest)
+		appState.State.AddMintedCoins(interest)
+	}
+}
+
+// removeExpiredInvites kills invites older than expiry blocks and refunds invitation amounts to their inviters,
+// invites without creation height are kept
+func removeExpiredInvites(stateDB *state.StateDB, height uint64, expiry uint64) int {
+	var expired []common.Address
+	stateDB.IterateOverIdentities(func(addr common.Address, identity state.Identity) {
+		if identity.State == state.Invite && identity.CreatedAtBlock > 0 && height-identity.CreatedAtBlock > expiry {
+			expired = append(expired, addr)
+		}
+	})
+	for _, addr := range expired {
+		if inviter := stateDB.GetInviter(addr); inviter != nil {
+			refundInvitation(stateDB, addr, inviter.Address)
+		}
+		removeLinksWithInviterAndInvitees(stateDB, addr)
+		stateDB.SetState(addr, state.Killed)
+	}
+	return len(expired)
+}
+
+// pending rewards can be claimed till the end of the next epoch, then they are returned to the committee reward pool
+func expirePendingRewards(appState *appstate.AppState) {
+	expired := appState.State.ExpirePendingRewards(appState.State.Epoch())
+	if expired.Sign() == 0 {
+		return
+	}
+	appState.State.SetCommitteeRewardPool(new(big.Int).Add(appState.State.CommitteeRewardPool(), expired))
+}
+
+// refundInvitation returns the amount transferred by InviteTx from the invitee to the inviter, coins sent to the invitee
+// by others are not refunded, the amount is recorded for invites created after the fork only, so older invites get no refund
+func refundInvitation(stateDB *state.StateDB, invitee common.Address, inviter common.Address) {
+	refund := new(big.Int)
+	if amount := stateDB.GetInviteAmount(invitee); amount != nil {
+		refund.Set(amount)
+	}
+	if balance := stateDB.GetBalance(invitee); balance.Cmp(refund) < 0 {
+		refund.Set(balance)
+	}
+	stateDB.SubBalance(invitee, refund)
+	stateDB.AddBalance(inviter, refund)
+}
+
+func isDelegatedSeat(appState *appstate.AppState, addr common.Address) bool {
+	if _, ok := appState.ValidatorsCache.Delegatee(addr); !ok {
+		return false
+	}
+	return appState.IdentityState.IsApproved(addr)
+}
+
+// delegations are valid during one epoch only
+func clearDelegations(appState *appstate.AppState) {
+	var delegators, delegatees []common.Address
+	appState.State.IterateOverIdentities(func(addr common.Address, identity state.Identity) {
+		if identity.Delegatee != nil {
+			delegators = append(delegators, addr)
+		}
+		if identity.Delegators > 0 {
+			delegatees = append(delegatees, addr)
+		}
+	})
+	for _, addr := range delegators {
+		appState.State.ResetDelegatee(addr)
+		if appState.IdentityState.IsApproved(addr) {
+			appState.IdentityState.SetDelegatee(addr, nil)
+		}
+	}
+	// counts of killed delegators are never decremented
+	for _, addr := range delegatees {
+		appState.State.ResetDelegators(addr)
+	}
+}
+
 func calculateNewIdentityStatusFlags(validationResults *types.ValidationResults) map[common.Address]state.ValidationStatusFlag {
 	m := make(map[common.Address]state.ValidationStatusFlag)
 	for addr, item := range validationResults.AuthorResults {
@@ -571,6 +911,7 @@ func setNewIdentitiesAttributes(appState *appstate.AppState, totalInvitesCount f
 		collector.CompleteBalanceUpdate(statsCollector, appState)
 		appState.State.ClearFlips(addr)
 		appState.State.ResetValidationTxBits(addr)
+		appState.State.SetFlipKeyHash(addr, nil)
 
 		if status, ok := identityFlags[addr]; ok && !validationFailed {
 			appState.State.SetValidationStatus(addr, status)
@@ -590,6 +931,7 @@ func clearDustAccounts(appState *appstate.AppState, networkSize int, statsCollec
 	appState.State.IterateOverAccounts(func(addr common.Address, account state.Account) {
 		if account.Balance == nil || account.Balance.Cmp(commonTxCost) == -1 {
 			collector.BeginDustClearingBalanceUpdate(statsCollector, addr, appState)
+			appState.State.AddBurntCoins(account.Balance)
 			appState.State.ClearAccount(addr)
 			collector.CompleteBalanceUpdate(statsCollector, appState)
 		}
@@ -618,6 +960,12 @@ func removeLinkWithInvitees(stateDB *state.StateDB, inviterAddr common.Address)
 	}
 }
 
+// emptyBlocksLimitEnabled reports whether consecutive empty blocks are counted to finish the epoch, the counter is
+// not a part of the state before the fork
+func (chain *Blockchain) emptyBlocksLimitEnabled(height uint64) bool {
+	return chain.config.Consensus.MaxEmptyBlocksPerEpoch > 0 && chain.config.Consensus.IsForkActivated(height)
+}
+
 func (chain *Blockchain) applyGlobalParams(appState *appstate.AppState, block *types.Block,
 	statsCollector collector.StatsCollector) {
 
@@ -635,6 +983,14 @@ func (chain *Blockchain) applyGlobalParams(appState *appstate.AppState, block *t
 	}
 
 	flags := block.Header.Flags()
+	if chain.emptyBlocksLimitEnabled(block.Height()) {
+		if block.IsEmpty() && !flags.HasFlag(types.ValidationFinished) {
+			appState.State.IncConsecutiveEmptyBlocks()
+		} else {
+			appState.State.ResetConsecutiveEmptyBlocks()
+		}
+	}
+
 	if flags.HasFlag(types.FlipLotteryStarted) {
 		appState.State.SetValidationPeriod(state.FlipLotteryPeriod)
 	}
@@ -688,23 +1044,38 @@ func (chain *Blockchain) rewardFinalCommittee(appState *appstate.AppState, block
 	if block.IsEmpty() {
 		return
 	}
-	identities := appState.ValidatorsCache.GetOnlineValidators(prevBlock.Seed(), block.Height(), types.Final, chain.GetCommitteeSize(appState.ValidatorsCache, true))
+	identities := appState.ValidatorsCache.GetOnlineValidators(chain.config.Consensus.CommitteeSeed(prevBlock.Seed()), block.Height(), types.Final, chain.GetCommitteeSize(appState.ValidatorsCache, true))
 	if identities == nil || identities.Cardinality() == 0 {
 		return
 	}
-	totalReward := big.NewInt(0)
-	totalReward.Div(chain.config.Consensus.FinalCommitteeReward, big.NewInt(int64(identities.Cardinality())))
+	// expired pending rewards are returned to the committee and offline members get pending rewards after the fork only
+	forkActivated := chain.config.Consensus.IsForkActivated(block.Height())
+	totalReward := new(big.Int).Set(chain.config.Consensus.FinalCommitteeReward)
+	if forkActivated {
+		totalReward.Add(totalReward, appState.State.CommitteeRewardPool())
+	}
+	totalReward.Div(totalReward, big.NewInt(int64(identities.Cardinality())))
 	collector.SetCommitteeRewardShare(statsCollector, totalReward)
+	if forkActivated && appState.State.CommitteeRewardPool().Sign() > 0 {
+		appState.State.SetCommitteeRewardPool(nil)
+	}
 
 	reward, stake := splitReward(totalReward, false, chain.config.Consensus)
 	newbieReward, newbieStake := splitReward(totalReward, true, chain.config.Consensus)
 
+	godAddress := appState.State.GodAddress()
 	for _, item := range identities.ToSlice() {
 		addr := item.(common.Address)
 
-		identityState := appState.State.GetIdentityState(addr)
+		// the member went offline after the committee was selected (e.g. it was removed by the new epoch),
+		// the reward is kept until claimed by ClaimRewardsTx instead of being credited to an offline identity,
+		// an offline delegator is present in the committee through its delegatee and is credited as an online member
+		if forkActivated && addr != godAddress && !appState.IdentityState.IsOnline(addr) && !isDelegatedSeat(appState, addr) {
+			appState.State.AddPendingReward(addr, totalReward, appState.State.Epoch())
+			continue
+		}
 		r, s := reward, stake
-		if identityState == state.Newbie {
+		if appState.State.GetIdentityState(addr) == state.Newbie {
 			r, s = newbieReward, newbieStake
 		}
 
@@ -714,10 +1085,13 @@ func (chain *Blockchain) rewardFinalCommittee(appState *appstate.AppState, block
 		collector.BeginCommitteeRewardBalanceUpdate(statsCollector, addr, appState)
 		// update state
 		appState.State.AddBalance(addr, balanceAdd)
-		appState.State.AddStake(addr, stakeAdd)
+		addStakeWithSource(appState, chain.config.Consensus, block.Height(), addr, stakeAdd, state.StakeFromCommitteeRewards)
 		if penaltySub != nil {
 			appState.State.SubPenalty(addr, penaltySub)
 		}
+		appState.State.AddMintedCoins(r)
+		appState.State.AddMintedCoins(s)
+		appState.State.AddBurntCoins(penaltySub)
 		collector.CompleteBalanceUpdate(statsCollector, appState)
 		collector.AddMintedCoins(statsCollector, r)
 		collector.AddMintedCoins(statsCollector, s)
@@ -734,24 +1108,66 @@ func (chain *Blockchain) processTxs(appState *appstate.AppState, block *types.Bl
 	totalTips = new(big.Int)
 	minFeePerByte := fee.GetFeePerByteForNetwork(appState.ValidatorsCache.NetworkSize())
 
+	recoverSenders(block.Body.Transactions)
+
 	for i := 0; i < len(block.Body.Transactions); i++ {
 		tx := block.Body.Transactions[i]
-		if err := validation.ValidateTx(appState, tx, minFeePerByte, validation.InBlockTx); err != nil {
+		if err := validation.ValidateTx(appState, tx, minFeePerByte, validation.InBlockTx, chain.config.Consensus); err != nil {
 			return nil, nil, err
 		}
-		if usedFee, err := chain.ApplyTxOnState(appState, tx, statsCollector); err != nil {
+		appState.State.SetTxHash(tx.Hash())
+		snapshot := appState.State.Snapshot()
+		if usedFee, err := chain.applyTxOnState(appState, tx, block.Height(), statsCollector); err != nil {
+			appState.State.RevertToSnapshot(snapshot)
 			return nil, nil, err
 		} else {
 			totalFee.Add(totalFee, usedFee)
 			totalTips.Add(totalTips, tx.TipsOrZero())
 		}
 	}
+	appState.State.SetTxHash(common.Hash{})
 
 	return totalFee, totalTips, nil
 }
 
+// recoverSenders recovers tx senders in parallel, recovered addresses are cached inside transactions
+// so sequential validation and applying don't verify signatures again
+func recoverSenders(txs []*types.Transaction) {
+	if len(txs) < 2 {
+		return
+	}
+	workers := runtime.NumCPU()
+	if workers > len(txs) {
+		workers = len(txs)
+	}
+	jobs := make(chan *types.Transaction, len(txs))
+	for _, tx := range txs {
+		jobs <- tx
+	}
+	close(jobs)
+
+	wg := sync.WaitGroup{}
+	wg.Add(workers)
+	for i := 0; i < workers; i++ {
+		go func() {
+			defer wg.Done()
+			for tx := range jobs {
+				types.Sender(tx)
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+// ApplyTxOnState applies the tx to appState as a tx of the block following the current head
 func (chain *Blockchain) ApplyTxOnState(appState *appstate.AppState, tx *types.Transaction,
 	statsCollector collector.StatsCollector) (*big.Int, error) {
+	return chain.applyTxOnState(appState, tx, chain.GetCurrentHead().Height()+1, statsCollector)
+}
+
+// applyTxOnState applies the tx to appState as a tx of the block at the given height
+func (chain *Blockchain) applyTxOnState(appState *appstate.AppState, tx *types.Transaction, height uint64,
+	statsCollector collector.StatsCollector) (*big.Int, error) {
 
 	collector.BeginTxBalanceUpdate(statsCollector, tx, appState)
 	defer collector.CompleteBalanceUpdate(statsCollector, appState)
@@ -779,12 +1195,24 @@ func (chain *Blockchain) ApplyTxOnState(appState *appstate.AppState, tx *types.T
 			currentNonce+1, tx.AccountNonce))
 	}
 
+	if changesInvites(tx.Type) {
+		chain.unusedInvites.markChanged()
+	}
+
 	feePerByte := appState.State.FeePerByte()
-	fee := chain.getTxFee(feePerByte, tx)
-	totalCost := chain.getTxCost(feePerByte, tx)
+	fee := chain.getTxFee(feePerByte, tx, height)
+	// max fee wasn't checked on applying before the fork
+	if tx.MaxFee != nil && chain.config.Consensus.IsForkActivated(height) && fee.Cmp(tx.MaxFee) > 0 {
+		return nil, ErrFeeExceedsMaxFee
+	}
+	totalCost := chain.getTxCost(feePerByte, tx, globalState.Epoch(), height)
 
 	switch tx.Type {
 	case types.ActivationTx:
+		// only a new or invited identity can be activated, checked here too since the tx overwrites recipient state
+		if recipientState := stateDB.GetIdentityState(*tx.To); recipientState != state.Invite && recipientState != state.Undefined {
+			return nil, ErrInvalidActivationTarget
+		}
 		balance := stateDB.GetBalance(sender)
 		generation, code := stateDB.GeneticCode(sender)
 		change := new(big.Int).Sub(balance, totalCost)
@@ -796,7 +1224,11 @@ func (chain *Blockchain) ApplyTxOnState(appState *appstate.AppState, tx *types.T
 		// verify identity and add transfer all available funds from temp account
 		recipient := *tx.To
 		stateDB.SetState(recipient, state.Candidate)
-		stateDB.AddBalance(recipient, change)
+		if tx.RefundTo != nil {
+			stateDB.AddBalance(*tx.RefundTo, change)
+		} else {
+			stateDB.AddBalance(recipient, change)
+		}
 		stateDB.SetPubKey(recipient, tx.Payload)
 		stateDB.SetGeneticCode(recipient, generation, code)
 
@@ -809,8 +1241,10 @@ func (chain *Blockchain) ApplyTxOnState(appState *appstate.AppState, tx *types.T
 			}
 		}
 
+		stateDB.AddBurntCoins(tx.AmountOrZero())
 		collector.AddActivationTxBalanceTransfer(statsCollector, tx, change)
 		if sender != *tx.To {
+			stateDB.AddBurntCoins(stateDB.GetStakeBalance(sender))
 			collector.AddInviteBurntCoins(statsCollector, sender, appState.State.GetStakeBalance(sender), tx)
 		}
 	case types.SendTx:
@@ -818,6 +1252,7 @@ func (chain *Blockchain) ApplyTxOnState(appState *appstate.AppState, tx *types.T
 		stateDB.AddBalance(*tx.To, tx.AmountOrZero())
 	case types.BurnTx:
 		stateDB.SubBalance(sender, totalCost)
+		stateDB.AddBurntCoins(tx.AmountOrZero())
 		collector.AddBurnTxBurntCoins(statsCollector, sender, tx)
 	case types.InviteTx:
 		if sender == stateDB.GodAddress() {
@@ -827,6 +1262,8 @@ func (chain *Blockchain) ApplyTxOnState(appState *appstate.AppState, tx *types.T
 		}
 
 		stateDB.SubBalance(sender, totalCost)
+		// the invitation cost isn't transferred to the invitee
+		stateDB.AddBurntCoins(chain.getInvitationCost(globalState.Epoch()))
 		generation, code := stateDB.GeneticCode(sender)
 
 		stateDB.SetState(*tx.To, state.Invite)
@@ -834,6 +1271,12 @@ func (chain *Blockchain) ApplyTxOnState(appState *appstate.AppState, tx *types.T
 		stateDB.SetGeneticCode(*tx.To, generation+1, append(code[1:], sender[0]))
 
 		stateDB.SetInviter(*tx.To, sender, tx.Hash())
+		if chain.config.Consensus.IsForkActivated(height) {
+			stateDB.SetInviteAmount(*tx.To, tx.AmountOrZero())
+			if chain.config.Consensus.InviteExpiryBlocks > 0 {
+				stateDB.SetCreatedAtBlock(*tx.To, height)
+			}
+		}
 	case types.KillTx:
 		removeLinksWithInviterAndInvitees(stateDB, sender)
 		stateDB.SetState(sender, state.Killed)
@@ -858,6 +1301,13 @@ func (chain *Blockchain) ApplyTxOnState(appState *appstate.AppState, tx *types.T
 			stakeToTransfer := stateDB.GetStakeBalance(*tx.To)
 			stateDB.AddBalance(sender, stakeToTransfer)
 			collector.AddKillInviteeTxStakeTransfer(statsCollector, tx, stakeToTransfer)
+		} else {
+			// stake of killed identity is burnt
+			stateDB.AddBurntCoins(stateDB.GetStakeBalance(*tx.To))
+		}
+		// return coins locked by unused invitation back to inviter
+		if inviteePrevState == state.Invite && chain.config.Consensus.IsForkActivated(height) {
+			refundInvitation(stateDB, *tx.To, sender)
 		}
 		if sender != stateDB.GodAddress() && stateDB.GetIdentityState(sender).VerifiedOrBetter() &&
 			(inviteePrevState == state.Invite || inviteePrevState == state.Candidate) {
@@ -886,6 +1336,28 @@ func (chain *Blockchain) ApplyTxOnState(appState *appstate.AppState, tx *types.T
 		stateDB.SubBalance(sender, tx.TipsOrZero())
 		attachment := attachments.ParseDeleteFlipAttachment(tx)
 		stateDB.DeleteFlip(sender, attachment.Cid)
+	case types.DelegateTx:
+		stateDB.SubBalance(sender, fee)
+		stateDB.SubBalance(sender, tx.TipsOrZero())
+		stateDB.SetDelegatee(sender, *tx.To)
+		appState.IdentityState.SetDelegatee(sender, tx.To)
+	case types.ParamChangeTx:
+		stateDB.SubBalance(sender, fee)
+		stateDB.SubBalance(sender, tx.TipsOrZero())
+		attachment := attachments.ParseParamChangeAttachment(tx)
+		if attachment != nil && config.ValidateParam(attachment.Key, attachment.Value) == nil {
+			stateDB.SetConsensusParamVote(attachment.Key, attachment.Value, sender)
+		}
+	case types.UnstakeTx:
+		stateDB.SubStake(sender, tx.AmountOrZero())
+		unlockBlock := height + chain.config.Consensus.UnstakeLockupBlocks
+		stateDB.AddPendingWithdrawal(sender, tx.AmountOrZero(), unlockBlock)
+	case types.ClaimRewardsTx:
+		claimed := stateDB.ClaimPendingRewards(sender)
+		stateDB.AddBalance(sender, claimed)
+		stateDB.AddMintedCoins(claimed)
+	case types.SubmitFlipKeyTx:
+		stateDB.SetFlipKeyHash(sender, tx.Payload)
 	case types.SubmitAnswersHashTx, types.SubmitShortAnswersTx, types.EvidenceTx, types.SubmitLongAnswersTx:
 		stateDB.SetValidationTxBit(sender, tx.Type)
 	}
@@ -895,13 +1367,34 @@ func (chain *Blockchain) ApplyTxOnState(appState *appstate.AppState, tx *types.T
 	if senderAccount.Epoch() != tx.Epoch {
 		stateDB.SetEpoch(sender, tx.Epoch)
 	}
-	collector.AddFeeBurntCoins(statsCollector, sender, fee, chain.config.Consensus.FeeBurnRate, tx)
+	collector.AddFeeBurntCoins(statsCollector, sender, fee, chain.governedParams(stateDB).FeeBurnRate, tx)
 
 	return fee, nil
 }
 
-func (chain *Blockchain) getTxFee(feePerByte *big.Int, tx *types.Transaction) *big.Int {
-	return fee.CalculateFee(chain.appState.ValidatorsCache.NetworkSize(), feePerByte, tx)
+func (chain *Blockchain) getTxFee(feePerByte *big.Int, tx *types.Transaction, height uint64) *big.Int {
+	return fee.CalculateFee(chain.appState.ValidatorsCache.NetworkSize(), feePerByte, tx, chain.config.Consensus.IsForkActivated(height))
+}
+
+// EstimateFee returns fee which would be charged for tx in the next block, zero networkSize means current network size
+func (chain *Blockchain) EstimateFee(tx *types.Transaction, networkSize int) *big.Int {
+	if networkSize == 0 {
+		networkSize = chain.appState.ValidatorsCache.NetworkSize()
+	}
+	forkActivated := chain.config.Consensus.IsForkActivated(chain.GetCurrentHead().Height() + 1)
+	return fee.CalculateFee(networkSize, chain.appState.State.FeePerByte(), tx, forkActivated)
+}
+
+// BroadcastTx is the entry point for submitting txs: tx is checked statelessly and added to the mempool which
+// validates it against the head state and publishes it to peers
+func (chain *Blockchain) BroadcastTx(tx *types.Transaction) (common.Hash, error) {
+	if err := tx.Verify(); err != nil {
+		return common.Hash{}, err
+	}
+	if err := chain.txpool.Add(tx); err != nil {
+		return common.Hash{}, err
+	}
+	return tx.Hash(), nil
 }
 
 func (chain *Blockchain) applyNextBlockFee(appState *appstate.AppState, block *types.Block) {
@@ -938,6 +1431,9 @@ func (chain *Blockchain) calculateNextBlockFeePerByte(appState *appstate.AppStat
 
 func (chain *Blockchain) applyVrfProposerThreshold(appState *appstate.AppState, block *types.Block) {
 	appState.State.AddBlockBit(block.IsEmpty())
+	if chain.config.Consensus.IsForkActivated(block.Height()) {
+		appState.State.AddRecentBlockBit(block.IsEmpty())
+	}
 	currentThreshold := appState.State.VrfProposerThreshold()
 
 	online := float64(appState.ValidatorsCache.OnlineSize())
@@ -947,8 +1443,9 @@ func (chain *Blockchain) applyVrfProposerThreshold(appState *appstate.AppState,
 
 	emptyBlocks := appState.State.EmptyBlocksCount()
 
-	minVrf := math2.Max(chain.config.Consensus.MinProposerThreshold, 1.0-5.0/online)
-	maxVrf := math2.Max(chain.config.Consensus.MinProposerThreshold, 1.0-1.0/online)
+	minProposerThreshold := chain.governedParams(appState.State).MinProposerThreshold
+	minVrf := math2.Max(minProposerThreshold, 1.0-5.0/online)
+	maxVrf := math2.Max(minProposerThreshold, 1.0-1.0/online)
 
 	step := (maxVrf - minVrf) / 60
 	switch emptyBlocks {
@@ -977,8 +1474,19 @@ func (chain *Blockchain) applyStatusSwitch(appState *appstate.AppState, block *t
 	appState.State.ClearStatusSwitchAddresses()
 }
 
-func (chain *Blockchain) getTxCost(feePerByte *big.Int, tx *types.Transaction) *big.Int {
-	return fee.CalculateCost(chain.appState.ValidatorsCache.NetworkSize(), feePerByte, tx)
+func (chain *Blockchain) applyPendingWithdrawals(appState *appstate.AppState, block *types.Block) {
+	for _, withdrawal := range appState.State.ReleasePendingWithdrawals(block.Height()) {
+		appState.State.AddBalance(withdrawal.Addr, withdrawal.Amount)
+	}
+}
+
+func (chain *Blockchain) getTxCost(feePerByte *big.Int, tx *types.Transaction, epoch uint16, height uint64) *big.Int {
+	return fee.CalculateCost(chain.appState.ValidatorsCache.NetworkSize(), feePerByte, tx, epoch, chain.config.Consensus,
+		chain.config.Consensus.IsForkActivated(height))
+}
+
+func (chain *Blockchain) getInvitationCost(epoch uint16) *big.Int {
+	return fee.CalculateInvitationCost(epoch, chain.appState.ValidatorsCache.NetworkSize(), chain.config.Consensus)
 }
 
 func getSeedData(prevBlock *types.Header) []byte {
@@ -990,30 +1498,45 @@ func getSeedData(prevBlock *types.Header) []byte {
 func (chain *Blockchain) GetProposerSortition() (bool, []byte) {
 
 	if checkIfProposer(chain.coinBaseAddress, chain.appState) {
-		return chain.getSortition(chain.getProposerData(), chain.appState.State.VrfProposerThreshold())
+		return chain.getSortition(chain.getProposerData(), chain.ComputeVRFThreshold(chain.GetCurrentHead().Height()+1))
 	}
 
 	return false, nil
 }
 
 func (chain *Blockchain) ProposeBlock(proof []byte) *types.BlockProposal {
-	head := chain.Head
+	head := chain.GetCurrentHead()
+
+	// automine nodes don't wait for the block time, so their timestamps run ahead of the local clock
+	if !chain.config.Consensus.Automine && time.Now().UTC().Unix()-head.Time() < int64(chain.config.Consensus.MinBlockInterval.Seconds()) {
+		chain.log.Warn("Block proposal is skipped, min block interval has not passed", "parent", head.Height())
+		return nil
+	}
+
+	txs := types.Transactions(chain.txpool.BuildBlockTransactions())
+	checkState, _ := chain.appState.ForCheck(head.Height())
+	txs.SortCanonically(canonicalTxFee(checkState, chain.config.Consensus))
+
+	newBlockTime := chain.newBlockTime(head.Time())
+	block, _, _ := chain.buildBlock(checkState, head, txs, newBlockTime, true)
 
-	txs := chain.txpool.BuildBlockTransactions()
-	checkState, _ := chain.appState.ForCheck(chain.Head.Height())
+	proposal := &types.BlockProposal{Block: block, Proof: proof}
+	hash := crypto.SignatureHash(proposal)
+	proposal.Signature = chain.secStore.Sign(hash[:])
+	return proposal
+}
 
-	filteredTxs, totalFee, totalTips := chain.filterTxs(checkState, txs)
+// buildBlock creates the block proposed by the node on top of head, txs are filtered and applied to checkState
+// together with the block state changes
+func (chain *Blockchain) buildBlock(checkState *appstate.AppState, head *types.Header, txs types.Transactions, blockTime int64,
+	proposeOffline bool) (block *types.Block, totalFee, totalTips *big.Int) {
+	filteredTxs, totalFee, totalTips := chain.filterTxs(checkState, txs, head.Height()+1)
 	body := &types.Body{
 		Transactions: filteredTxs,
 	}
 	var cid cid2.Cid
 	cid, _ = chain.ipfs.Cid(body.ToBytes())
 
-	prevBlockTime := time.Unix(chain.Head.Time(), 0)
-	newBlockTime := prevBlockTime.Add(MinBlockDelay).Unix()
-	if localTime := time.Now().UTC().Unix(); localTime > newBlockTime {
-		newBlockTime = localTime
-	}
 	var cidBytes []byte
 	if cid != ipfs.EmptyCid {
 		cidBytes = cid.Bytes()
@@ -1021,37 +1544,76 @@ func (chain *Blockchain) ProposeBlock(proof []byte) *types.BlockProposal {
 	header := &types.ProposedHeader{
 		Height:         head.Height() + 1,
 		ParentHash:     head.Hash(),
-		Time:           newBlockTime,
+		Time:           blockTime,
 		ProposerPubKey: chain.pubKey,
 		TxHash:         types.DeriveSha(types.Transactions(filteredTxs)),
 		IpfsHash:       cidBytes,
 		FeePerByte:     chain.appState.State.FeePerByte(),
 	}
 
-	block := &types.Block{
+	block = &types.Block{
 		Header: &types.Header{
 			ProposedHeader: header,
 		},
 		Body: body,
 	}
 
-	block.Header.ProposedHeader.BlockSeed, block.Header.ProposedHeader.SeedProof = chain.secStore.VrfEvaluate(getSeedData(head))
+	block.Header.ProposedHeader.BlockSeed, block.Header.ProposedHeader.SeedProof = chain.vrfEvaluate(getSeedData(head))
 
 	block.Header.ProposedHeader.TxBloom = calculateTxBloom(block)
+	if chain.config.Consensus.IsForkActivated(block.Height()) {
+		block.Header.ProposedHeader.BodyRoot = body.MerkleRoot(block.Header.ProposedHeader.BlockSeed)
+	}
 
-	addr, flag := chain.offlineDetector.ProposeOffline(head)
-	if addr != nil {
-		block.Header.ProposedHeader.OfflineAddr = addr
-		block.Header.ProposedHeader.Flags |= flag
+	if proposeOffline {
+		addr, flag := chain.offlineDetector.ProposeOffline(head)
+		if addr != nil {
+			block.Header.ProposedHeader.OfflineAddr = addr
+			block.Header.ProposedHeader.Flags |= flag
+		}
 	}
 	block.Header.ProposedHeader.Flags |= chain.calculateFlags(checkState, block)
 
-	block.Header.ProposedHeader.Root, block.Header.ProposedHeader.IdentityRoot, _ = chain.applyBlockOnState(checkState, block, chain.Head, totalFee, totalTips, nil)
+	block.Header.ProposedHeader.Root, block.Header.ProposedHeader.IdentityRoot, _ = chain.applyBlockOnState(checkState, block, head, totalFee, totalTips, nil)
+	return block, totalFee, totalTips
+}
+
+type SimulationResult struct {
+	Transactions   []*types.Transaction
+	TotalFee       *big.Int
+	TotalBurned    *big.Int
+	ProposerReward *big.Int
+	StateRoot      common.Hash
+}
 
-	proposal := &types.BlockProposal{Block: block, Proof: proof}
-	hash := crypto.SignatureHash(proposal)
-	proposal.Signature = chain.secStore.Sign(hash[:])
-	return proposal
+// SimulateBlock builds the block the node would propose on top of the current head with given txs,
+// invalid txs are dropped as during block proposal. Neither the chain nor its state is changed
+func (chain *Blockchain) SimulateBlock(txs []*types.Transaction) (*SimulationResult, error) {
+	head := chain.GetCurrentHead()
+	checkState, err := chain.appState.ForCheck(head.Height())
+	if err != nil {
+		return nil, err
+	}
+	sorted := make(types.Transactions, len(txs))
+	copy(sorted, txs)
+	sorted.SortCanonically(canonicalTxFee(checkState, chain.config.Consensus))
+
+	blockTime := chain.newBlockTime(head.Time())
+	_, burntBefore := checkState.State.SupplyChange()
+	params := chain.governedParams(checkState.State)
+	block, totalFee, totalTips := chain.buildBlock(checkState, head, sorted, blockTime, false)
+	_, burnt := checkState.State.SupplyChange()
+
+	proposerReward := chain.GetEffectiveBlockReward(block.Height())
+	proposerReward.Add(proposerReward, new(big.Int).Sub(totalFee, chain.calculateBurntFee(params, totalFee)))
+	proposerReward.Add(proposerReward, totalTips)
+	return &SimulationResult{
+		Transactions:   block.Body.Transactions,
+		TotalFee:       totalFee,
+		TotalBurned:    burnt.Sub(burnt, burntBefore),
+		ProposerReward: proposerReward,
+		StateRoot:      block.Root(),
+	}, nil
 }
 
 func calculateTxBloom(block *types.Block) []byte {
@@ -1084,7 +1646,7 @@ func (chain *Blockchain) calculateFlags(appState *appstate.AppState, block *type
 	var flags types.BlockFlag
 
 	for _, tx := range block.Body.Transactions {
-		if tx.Type == types.KillTx || tx.Type == types.KillInviteeTx {
+		if tx.Type == types.KillTx || tx.Type == types.KillInviteeTx || tx.Type == types.DelegateTx {
 			flags |= types.IdentityUpdate
 		}
 	}
@@ -1114,6 +1676,12 @@ func (chain *Blockchain) calculateFlags(appState *appstate.AppState, block *type
 		flags |= types.IdentityUpdate
 	}
 
+	if block.IsEmpty() && chain.emptyBlocksLimitEnabled(block.Height()) &&
+		stateDb.ConsecutiveEmptyBlocks() >= uint32(chain.config.Consensus.MaxEmptyBlocksPerEpoch) {
+		flags |= types.ValidationFinished
+		flags |= types.IdentityUpdate
+	}
+
 	if block.Height()-appState.State.LastSnapshot() >= chain.config.Consensus.SnapshotRange && appState.State.ValidationPeriod() == state.NonePeriod &&
 		!flags.HasFlag(types.ValidationFinished) && !flags.HasFlag(types.FlipLotteryStarted) {
 		flags |= types.Snapshot
@@ -1130,26 +1698,75 @@ func (chain *Blockchain) calculateFlags(appState *appstate.AppState, block *type
 	return flags
 }
 
-func (chain *Blockchain) filterTxs(appState *appstate.AppState, txs []*types.Transaction) ([]*types.Transaction, *big.Int, *big.Int) {
+// canonicalTxFee returns fee used for canonical ordering of block transactions
+func canonicalTxFee(appState *appstate.AppState, conf *config.ConsensusConf) func(tx *types.Transaction) *big.Int {
+	networkSize := appState.ValidatorsCache.NetworkSize()
+	feePerByte := appState.State.FeePerByte()
+	forkActivated := conf.IsForkActivated(uint64(appState.State.Version()) + 1)
+	return func(tx *types.Transaction) *big.Int {
+		return fee.CalculateFee(networkSize, feePerByte, tx, forkActivated)
+	}
+}
+
+func (chain *Blockchain) filterTxs(appState *appstate.AppState, txs []*types.Transaction, height uint64) ([]*types.Transaction, *big.Int, *big.Int) {
 	var result []*types.Transaction
 
 	minFeePerByte := fee.GetFeePerByteForNetwork(appState.ValidatorsCache.NetworkSize())
+	maxTxs := types.MaxTransactions(appState.ValidatorsCache.NetworkSize())
+	if chain.config.Consensus.MaxBlockTransactions < maxTxs {
+		maxTxs = chain.config.Consensus.MaxBlockTransactions
+	}
 
 	totalFee := new(big.Int)
 	totalTips := new(big.Int)
+	size := blockHeaderSizeReserve
 	for _, tx := range txs {
-		if err := validation.ValidateTx(appState, tx, minFeePerByte, validation.InBlockTx); err != nil {
+		if len(result) >= maxTxs {
+			break
+		}
+		txSize := tx.SizeInBlock()
+		if maxSize := chain.config.Consensus.MaxBlockSize; maxSize > 0 && size+txSize > maxSize {
+			break
+		}
+		if err := validation.ValidateTx(appState, tx, minFeePerByte, validation.InBlockTx, chain.config.Consensus); err != nil {
 			continue
 		}
-		if fee, err := chain.ApplyTxOnState(appState, tx, nil); err == nil {
+		snapshot := appState.State.Snapshot()
+		if fee, err := chain.applyTxOnState(appState, tx, height, nil); err == nil {
 			totalFee.Add(totalFee, fee)
 			totalTips.Add(totalTips, tx.TipsOrZero())
 			result = append(result, tx)
+			size += txSize
+		} else {
+			appState.State.RevertToSnapshot(snapshot)
 		}
 	}
 	return result, totalFee, totalTips
 }
 
+// ValidateTxBatch validates txs as if they were included into the next block in the given order, every valid tx is applied
+// to a check state so following txs see its nonce and balance changes. Errors are aligned with txs, nil means the tx is valid
+func (chain *Blockchain) ValidateTxBatch(txs []*types.Transaction) ([]error, error) {
+	checkState, err := chain.appState.ForCheck(chain.GetCurrentHead().Height())
+	if err != nil {
+		return nil, err
+	}
+	minFeePerByte := fee.GetFeePerByteForNetwork(checkState.ValidatorsCache.NetworkSize())
+	result := make([]error, len(txs))
+	for i, tx := range txs {
+		if err := validation.ValidateTx(checkState, tx, minFeePerByte, validation.InBlockTx, chain.config.Consensus); err != nil {
+			result[i] = err
+			continue
+		}
+		snapshot := checkState.State.Snapshot()
+		if _, err := chain.ApplyTxOnState(checkState, tx, nil); err != nil {
+			checkState.State.RevertToSnapshot(snapshot)
+			result[i] = err
+		}
+	}
+	return result, nil
+}
+
 func (chain *Blockchain) insertHeader(header *types.Header) {
 	chain.repo.WriteBlockHeader(header)
 	chain.repo.WriteHead(nil, header)
@@ -1164,11 +1781,50 @@ func (chain *Blockchain) insertBlock(block *types.Block, diff *state.IdentitySta
 	chain.insertHeader(block.Header)
 	chain.WriteIdentityStateDiff(block.Height(), diff)
 	chain.WriteTxIndex(block.Hash(), block.Body.Transactions)
+	chain.writeProposerRecord(block.Header)
+	chain.writeCommitteeSizeRecord(block.Height())
+	chain.repo.WriteBlockSeed(block.Height(), block.Seed())
 	chain.indexer.HandleBlockTransactions(block.Header, block.Body.Transactions)
-	chain.setCurrentHead(block.Header)
+	chain.SetCurrentHead(block.Header)
+	chain.cacheBlock(block)
+	chain.blockIntervals.add()
+	chain.unusedInvites.onBlockInserted()
+	chain.notifySubscribers(block)
 	return nil
 }
 
+// cacheBlock stores a copy of the block, cached blocks are returned as copies too so callers can't modify them
+func (chain *Blockchain) cacheBlock(block *types.Block) {
+	chain.blockCache.Add(block.Hash(), block.Clone())
+	if block.Height() > blockCacheDepth {
+		chain.blockCache.Remove(chain.repo.ReadCanonicalHash(block.Height() - blockCacheDepth))
+	}
+}
+
+// SubscribeToNewBlocks registers channel to receive every inserted block, blocks are dropped if channel is full
+func (chain *Blockchain) SubscribeToNewBlocks(ch chan<- *types.Block) {
+	chain.subscribersLock.Lock()
+	defer chain.subscribersLock.Unlock()
+	chain.subscribers[ch] = struct{}{}
+}
+
+func (chain *Blockchain) Unsubscribe(ch chan<- *types.Block) {
+	chain.subscribersLock.Lock()
+	defer chain.subscribersLock.Unlock()
+	delete(chain.subscribers, ch)
+}
+
+func (chain *Blockchain) notifySubscribers(block *types.Block) {
+	chain.subscribersLock.Lock()
+	defer chain.subscribersLock.Unlock()
+	for ch := range chain.subscribers {
+		select {
+		case ch <- block:
+		default:
+		}
+	}
+}
+
 func (chain *Blockchain) WriteTxIndex(hash common.Hash, txs types.Transactions) {
 	for i, tx := range txs {
 		idx := &types.TransactionIndex{
@@ -1179,16 +1835,85 @@ func (chain *Blockchain) WriteTxIndex(hash common.Hash, txs types.Transactions)
 	}
 }
 
+// RebuildTransactionIndex writes tx index for canonical blocks in [from, to] range and returns count of indexed txs,
+// pruned blocks are skipped
+func (chain *Blockchain) RebuildTransactionIndex(from, to uint64) (int, error) {
+	if from == 0 {
+		from = 1
+	}
+	if from > to || to > chain.GetCurrentHead().Height() {
+		return 0, ErrHeightOutOfRange
+	}
+	cnt := 0
+	for height := from; height <= to; height++ {
+		block, err := chain.GetBlockByHeightWithError(height)
+		if err == ErrBlockPruned {
+			continue
+		}
+		if block == nil {
+			return cnt, errors.Errorf("block is not found, height: %v", height)
+		}
+		if block.IsEmpty() {
+			continue
+		}
+		chain.WriteTxIndex(block.Hash(), block.Body.Transactions)
+		cnt += len(block.Body.Transactions)
+	}
+	return cnt, nil
+}
+
+// RebuildValidatorsCache reloads validators from the identity state at current head, it repairs the cache after
+// it diverged from the state because of a partial import or crash
+func (chain *Blockchain) RebuildValidatorsCache() error {
+	head := chain.GetCurrentHead()
+	if chain.appState.IdentityState.Root() != head.IdentityRoot() {
+		return ErrIdentityStateMismatch
+	}
+	cache := chain.appState.ValidatorsCache
+	before, beforeOnline := cache.NetworkSize(), cache.OnlineSize()
+	cache.Rebuild(chain.appState.State.GodAddress())
+	chain.log.Info("Validators cache rebuilt", "height", head.Height(), "totalBefore", before, "onlineBefore", beforeOnline,
+		"total", cache.NetworkSize(), "online", cache.OnlineSize())
+	return nil
+}
+
 func (chain *Blockchain) getProposerData() []byte {
-	head := chain.Head
+	head := chain.GetCurrentHead()
 	result := head.Seed().Bytes()
 	result = append(result, common.ToBytes(ProposerRole)...)
 	result = append(result, common.ToBytes(head.Height()+1)...)
 	return result
 }
 
+// ComputeVRFThreshold returns the proposer threshold for the block at the given height adapted to the count of recent empty blocks
+// after the fork: it is lowered when too many blocks are empty and raised when almost none are
+func (chain *Blockchain) ComputeVRFThreshold(height uint64) float64 {
+	appState := chain.appState
+	if height != chain.GetCurrentHead().Height()+1 {
+		var err error
+		if appState, err = chain.appState.Readonly(height - 1); err != nil {
+			chain.log.Warn("failed to load state for vrf threshold", "height", height, "err", err)
+			appState = chain.appState
+		}
+	}
+	if !chain.config.Consensus.IsForkActivated(height) {
+		return appState.State.VrfProposerThreshold()
+	}
+	return adaptVrfThreshold(appState.State.VrfProposerThreshold(), appState.State.RecentEmptyBlocksCount())
+}
+
+func adaptVrfThreshold(threshold float64, recentEmptyBlocks int) float64 {
+	switch {
+	case recentEmptyBlocks > highRecentEmptyBlocks:
+		threshold *= 0.9
+	case recentEmptyBlocks < lowRecentEmptyBlocks:
+		threshold *= 1.05
+	}
+	return math2.Max(minAdaptiveVrfThreshold, math2.Min(threshold, maxAdaptiveVrfThreshold))
+}
+
 func (chain *Blockchain) getSortition(data []byte, threshold float64) (bool, []byte) {
-	hash, proof := chain.secStore.VrfEvaluate(data)
+	hash, proof := chain.vrfEvaluate(data)
 
 	v := new(big.Float).SetInt(new(big.Int).SetBytes(hash[:]))
 
@@ -1203,34 +1928,74 @@ func (chain *Blockchain) getSortition(data []byte, threshold float64) (bool, []b
 func (chain *Blockchain) validateBlock(checkState *appstate.AppState, block *types.Block, prevBlock *types.Header) error {
 
 	if block.IsEmpty() {
-		if chain.generateEmptyBlock(checkState, prevBlock).Hash() == block.Hash() {
-			return nil
+		var expected *types.Block
+		if header := block.Header.EmptyBlockHeader; len(header.ProposerPubKey) > 0 {
+			if err := chain.ValidateHeader(block.Header, prevBlock); err != nil {
+				return err
+			}
+			proposerAddr, _ := crypto.PubKeyBytesToAddress(header.ProposerPubKey)
+			if !checkIfProposer(proposerAddr, checkState) {
+				return newBlockValidationError(ErrUnknownProposer, "proposer is not identity")
+			}
+			expected = chain.generateEmptyBlockWithSeed(checkState, prevBlock, header.BlockSeed, header.ProposerPubKey, header.SeedProof)
+			expected.Header.EmptyBlockHeader.ExtraData = header.ExtraData
+		} else {
+			expected = chain.generateEmptyBlock(checkState, prevBlock)
 		}
-		return errors.New("empty blocks' hashes mismatch")
+		if expected.Hash() == block.Hash() {
+			return nil
+		}
+		return newBlockValidationError(ErrInvalidEmptyBlock, "empty blocks' hashes mismatch")
 	}
 
 	if err := chain.ValidateHeader(block.Header, prevBlock); err != nil {
 		return err
 	}
 
+	// before the fork the limits and the canonical order apply to own proposals only, blocks of other nodes weren't checked
+	forkActivated := chain.config.Consensus.IsForkActivated(block.Height())
+
+	if forkActivated && len(block.Body.Transactions) > chain.config.Consensus.MaxBlockTransactions {
+		return ErrBlockTxCountExceeded
+	}
+
+	if forkActivated && len(block.Body.Transactions) > types.MaxTransactions(checkState.ValidatorsCache.NetworkSize()) {
+		return ErrBlockTxCapacityExceeded
+	}
+
+	if maxSize := chain.config.Consensus.MaxBlockSize; forkActivated && maxSize > 0 && block.Size() > maxSize {
+		return ErrBlockTooLarge
+	}
+
 	if !common.ZeroOrNil(block.Header.ProposedHeader.FeePerByte) && checkState.State.FeePerByte().Cmp(block.Header.ProposedHeader.FeePerByte) != 0 {
-		return errors.New("fee rate is invalid")
+		return newBlockValidationError(ErrInvalidFeeRate, "fee rate is invalid")
 	}
 
 	proposerAddr, _ := crypto.PubKeyBytesToAddress(block.Header.ProposedHeader.ProposerPubKey)
 
 	if !checkIfProposer(proposerAddr, checkState) {
-		return errors.New("proposer is not identity")
+		return newBlockValidationError(ErrUnknownProposer, "proposer is not identity")
 	}
 
 	var txs = types.Transactions(block.Body.Transactions)
 
 	if types.DeriveSha(txs) != block.Header.ProposedHeader.TxHash {
-		return errors.New("txHash is invalid")
+		return newBlockValidationError(ErrInvalidTxHash, "txHash is invalid")
+	}
+
+	if forkActivated && !txs.IsSortedCanonically(canonicalTxFee(checkState, chain.config.Consensus)) {
+		return ErrTxOutOfOrder
 	}
 
 	if bytes.Compare(calculateTxBloom(block), block.Header.ProposedHeader.TxBloom) != 0 {
-		return errors.New("tx bloom is invalid")
+		return newBlockValidationError(ErrInvalidTxBloom, "tx bloom is invalid")
+	}
+
+	// blocks proposed before the fork have no body root
+	bodyRoot := block.Header.ProposedHeader.BodyRoot
+	if (bodyRoot != common.Hash{} || forkActivated) &&
+		block.Body.MerkleRoot(block.Seed()) != bodyRoot {
+		return newBlockValidationError(ErrInvalidBodyRoot, "body root is invalid")
 	}
 
 	var totalFee, totalTips *big.Int
@@ -1242,11 +2007,11 @@ func (chain *Blockchain) validateBlock(checkState *appstate.AppState, block *typ
 	persistentFlags := block.Header.ProposedHeader.Flags.UnsetFlag(types.OfflinePropose).UnsetFlag(types.OfflineCommit)
 
 	if expected := chain.calculateFlags(checkState, block); expected != persistentFlags {
-		return errors.Errorf("flags are invalid, expected=%v, actual=%v", expected, persistentFlags)
+		return newBlockValidationError(ErrInvalidFlags, "flags are invalid, expected=%v, actual=%v", expected, persistentFlags)
 	}
 
 	if root, identityRoot, _ := chain.applyBlockOnState(checkState, block, prevBlock, totalFee, totalTips, nil); root != block.Root() || identityRoot != block.IdentityRoot() {
-		return errors.Errorf("invalid block roots. Expected=%x & %x, actual=%x & %x", root, identityRoot, block.Root(), block.IdentityRoot())
+		return newBlockValidationError(ErrInvalidRoot, "invalid block roots. Expected=%x & %x, actual=%x & %x", root, identityRoot, block.Root(), block.IdentityRoot())
 	}
 
 	cid, _ := chain.ipfs.Cid(block.Body.ToBytes())
@@ -1256,74 +2021,69 @@ func (chain *Blockchain) validateBlock(checkState *appstate.AppState, block *typ
 		cidBytes = cid.Bytes()
 	}
 	if bytes.Compare(cidBytes, block.Header.ProposedHeader.IpfsHash) != 0 {
-		return errors.New("invalid block cid")
+		return newBlockValidationError(ErrInvalidCid, "invalid block cid")
 	}
 
 	return nil
 }
 
 func (chain *Blockchain) ValidateBlockCertOnHead(block *types.Header, cert *types.BlockCert) error {
-	return chain.ValidateBlockCert(chain.Head, block, cert, chain.appState.ValidatorsCache)
+	return chain.ValidateBlockCert(chain.GetCurrentHead(), block, cert, chain.appState.ValidatorsCache)
 }
 
 func (chain *Blockchain) ValidateBlockCert(prevBlock *types.Header, block *types.Header, cert *types.BlockCert, validatorsCache *validators.ValidatorsCache) (err error) {
 
 	step := cert.Step
-	validators := validatorsCache.GetOnlineValidators(prevBlock.Seed(), block.Height(), step, chain.GetCommitteeSize(validatorsCache, step == types.Final))
-
-	voters := mapset.NewSet()
-
-	for _, signature := range cert.Signatures {
-
-		vote := types.Vote{
-			Header: &types.VoteHeader{
-				Step:        step,
-				Round:       cert.Round,
-				TurnOffline: signature.TurnOffline,
-				Upgrade:     signature.Upgrade,
-				VotedHash:   cert.VotedHash,
-				ParentHash:  prevBlock.Hash(),
-			},
-			Signature: signature.Signature,
-		}
-
-		if !validators.Contains(vote.VoterAddr()) {
-			return errors.New("invalid voter")
-		}
-		if vote.Header.Round != block.Height() {
-			return errors.New("invalid vote header")
-		}
-		if vote.Header.VotedHash != block.Hash() {
-			return errors.New("invalid voted hash")
-		}
-
-		if vote.Header.ParentHash != prevBlock.Hash() {
-			return errors.New("invalid parent hash")
-		}
-		voters.Add(vote.VoterAddr())
+	params, err := chain.governedParamsAt(prevBlock.Height())
+	if err != nil {
+		return err
 	}
+	validators := validatorsCache.GetOnlineValidators(chain.config.Consensus.CommitteeSeed(prevBlock.Seed()), block.Height(), step, chain.committeeSize(validatorsCache, step == types.Final, params))
 
-	if voters.Cardinality() < chain.GetCommitteeVotesThreshold(validatorsCache, step == types.Final) {
-		return errors.New("not enough votes")
+	if cert.Round != block.Height() {
+		return errors.New("invalid vote header")
 	}
-	return nil
+	if cert.VotedHash != block.Hash() {
+		return errors.New("invalid voted hash")
+	}
+	return cert.ValidateWeighted(prevBlock.Hash(), chain.committeeVotesThreshold(validatorsCache, step == types.Final, params),
+		func(voter common.Address) int {
+			return validatorsCache.VoteWeight(validators, voter)
+		})
 }
 
 func (chain *Blockchain) ValidateBlock(block *types.Block, checkState *appstate.AppState) error {
 	if checkState == nil {
 		var err error
-		checkState, err = chain.appState.ForCheck(chain.Head.Height())
+		checkState, err = chain.appState.ForCheck(chain.GetCurrentHead().Height())
 		if err != nil {
 			return err
 		}
 	}
 
-	return chain.validateBlock(checkState, block, chain.Head)
+	return chain.validateBlock(checkState, block, chain.GetCurrentHead())
+}
+
+// ReplayBlock re-executes block on a copy of startState including its uncommitted changes, startState is expected
+// to be at parent height and is not modified. Resulting state is returned even if validation fails, so it can be inspected
+func (chain *Blockchain) ReplayBlock(block *types.Block, startState *appstate.AppState) (*appstate.AppState, error) {
+	if block.Height() == 0 {
+		return nil, errors.New("genesis block can't be replayed")
+	}
+	prevBlock := chain.GetBlockHeaderByHeight(block.Height() - 1)
+	if prevBlock == nil {
+		return nil, errors.Errorf("parent block is not found, height: %v", block.Height()-1)
+	}
+	checkState, err := startState.Copy()
+	if err != nil {
+		return nil, err
+	}
+	return checkState, chain.validateBlock(checkState, block, prevBlock)
 }
 
 func validateBlockParentHash(block *types.Header, prevBlock *types.Header) error {
 	if prevBlock.Height()+1 != (block.Height()) {
-		return errors.New(fmt.Sprintf("Height is invalid. Expected=%v but received=%v", prevBlock.Height()+1, block.Height()))
+		return newBlockValidationError(ErrInvalidParentHash, "Height is invalid. Expected=%v but received=%v", prevBlock.Height()+1, block.Height())
 	}
 	if prevBlock.Hash() != block.ParentHash() {
 		return ParentHashIsInvalid
@@ -1331,16 +2091,31 @@ func validateBlockParentHash(block *types.Header, prevBlock *types.Header) error
 	return nil
 }
 
-func validateBlockTimestamp(block *types.Header, prevBlock *types.Header) error {
-	blockTime := time.Unix(block.Time(), 0)
+// newBlockTime returns timestamp for the block following prevBlockTime, the engine waits for MinBlockDistance before
+// proposing, so the local time is used unless it is earlier than the minimum accepted by validators
+func (chain *Blockchain) newBlockTime(prevBlockTime int64) int64 {
+	newBlockTime := time.Unix(prevBlockTime, 0).Add(chain.config.Consensus.MinBlockDistance / 2).Unix()
+	if localTime := time.Now().UTC().Unix(); localTime > newBlockTime {
+		newBlockTime = localTime
+	}
+	return newBlockTime
+}
 
-	if blockTime.Sub(time.Now().UTC()) > MaxFutureBlockOffset {
-		return errors.New("block from future")
+// validateBlockTimestamp allows blocks at least minBlockDistance/2 after the previous one to tolerate clock skew,
+// minBlockInterval is a hard minimum
+func validateBlockTimestamp(block *types.Header, prevBlock *types.Header, maxDrift time.Duration, minBlockDistance time.Duration,
+	minBlockInterval time.Duration) error {
+	if block.Time() > time.Now().UTC().Unix()+int64(maxDrift.Seconds()) {
+		return ErrFutureBlock
+	}
+	if minBlockInterval > 0 && block.Time()-prevBlock.Time() < int64(minBlockInterval.Seconds()) {
+		return ErrBlockTooFrequent
 	}
+	blockTime := time.Unix(block.Time(), 0)
 	prevBlockTime := time.Unix(prevBlock.Time(), 0)
 
-	if blockTime.Sub(prevBlockTime) < MinBlockDelay {
-		return errors.Errorf("block is too close to previous one, prev: %v, current: %v", prevBlockTime.Unix(), blockTime.Unix())
+	if !blockTime.After(prevBlockTime) || blockTime.Sub(prevBlockTime) < minBlockDistance/2 {
+		return newBlockValidationError(ErrInvalidTimestamp, "block is too close to previous one, prev: %v, current: %v", prevBlockTime.Unix(), blockTime.Unix())
 	}
 
 	return nil
@@ -1362,7 +2137,7 @@ func (chain *Blockchain) ValidateProposerProof(proof []byte, pubKeyData []byte)
 
 	q := new(big.Float).Quo(v, MaxHash).SetPrec(10)
 
-	if f, _ := q.Float64(); f < chain.appState.State.VrfProposerThreshold() {
+	if f, _ := q.Float64(); f < chain.ComputeVRFThreshold(chain.GetCurrentHead().Height()+1) {
 		return errors.New("Proposer is invalid")
 	}
 
@@ -1375,10 +2150,157 @@ func (chain *Blockchain) ValidateProposerProof(proof []byte, pubKeyData []byte)
 }
 
 func (chain *Blockchain) Round() uint64 {
-	return chain.Head.Height() + 1
+	return chain.GetCurrentHead().Height() + 1
 }
-func (chain *Blockchain) WriteFinalConsensus(hash common.Hash) {
+
+// WriteFinalConsensus marks the block as final if the cert is valid against the final committee of the block
+func (chain *Blockchain) WriteFinalConsensus(hash common.Hash, cert *types.BlockCert) error {
+	if err := chain.validateHistoricalCert(hash, cert); err != nil {
+		return err
+	}
 	chain.repo.WriteFinalConsensus(hash)
+	chain.writeFinalCommittee(hash)
+	return nil
+}
+
+// VerifyBlockCert checks the stored certificate of the block against the committee computed from the state at parent height
+func (chain *Blockchain) VerifyBlockCert(hash common.Hash) error {
+	cert := chain.repo.ReadCertificate(hash)
+	if cert == nil {
+		return ErrCertNotFound
+	}
+	return chain.validateHistoricalCert(hash, cert)
+}
+
+// ScanAndVerifyCerts verifies stored certificates of canonical blocks with heights [from; to], blocks without certificates are skipped
+func (chain *Blockchain) ScanAndVerifyCerts(from, to uint64) []error {
+	if from <= chain.genesis.Height() {
+		from = chain.genesis.Height() + 1
+	}
+	if to > chain.GetCurrentHead().Height() {
+		to = chain.GetCurrentHead().Height()
+	}
+	var errs []error
+	for height := from; height <= to; height++ {
+		hash := chain.repo.ReadCanonicalHash(height)
+		if hash == (common.Hash{}) {
+			errs = append(errs, errors.Errorf("block is not found, height: %v", height))
+			continue
+		}
+		if err := chain.VerifyBlockCert(hash); err != nil && err != ErrCertNotFound {
+			errs = append(errs, errors.Wrapf(err, "invalid certificate, height: %v", height))
+		}
+	}
+	return errs
+}
+
+func (chain *Blockchain) validateHistoricalCert(hash common.Hash, cert *types.BlockCert) error {
+	header := chain.repo.ReadBlockHeader(hash)
+	if header == nil {
+		return errors.New("block is not found")
+	}
+	prevBlock := chain.GetBlockHeaderByHeight(header.Height() - 1)
+	if prevBlock == nil {
+		return errors.New("parent block is not found")
+	}
+	appState, err := chain.appState.Readonly(prevBlock.Height())
+	if err != nil {
+		return err
+	}
+	return chain.ValidateBlockCert(prevBlock, header, cert, appState.ValidatorsCache)
+}
+
+// writeFinalCommittee stores final committee of the block computed from the state at previous height
+func (chain *Blockchain) writeFinalCommittee(hash common.Hash) {
+	header := chain.repo.ReadBlockHeader(hash)
+	if header == nil || header.Height() <= chain.genesis.Height() {
+		return
+	}
+	committee, err := chain.GetCommitteeByHeight(header.Height())
+	if err != nil {
+		chain.log.Warn("Failed to store final committee", "err", err)
+		return
+	}
+	chain.repo.WriteFinalCommittee(hash, committee)
+}
+
+// GetCommitteeByHeight recomputes final committee of the block at given height from the seed of the previous block
+// and the validators loaded from the historical state at previous height, addresses are sorted
+func (chain *Blockchain) GetCommitteeByHeight(height uint64) ([]common.Address, error) {
+	if height <= chain.genesis.Height() || height > chain.GetCurrentHead().Height() {
+		return nil, ErrHeightOutOfRange
+	}
+	prevBlock := chain.GetBlockHeaderByHeight(height - 1)
+	if prevBlock == nil {
+		return nil, ErrHeightOutOfRange
+	}
+	appState, err := chain.appState.Readonly(prevBlock.Height())
+	if err != nil {
+		return nil, err
+	}
+	var committee []common.Address
+	validators := appState.ValidatorsCache.GetOnlineValidators(chain.config.Consensus.CommitteeSeed(prevBlock.Seed()), height, types.Final,
+		chain.GetCommitteeSize(appState.ValidatorsCache, true))
+	if validators != nil {
+		for _, item := range validators.ToSlice() {
+			committee = append(committee, item.(common.Address))
+		}
+	}
+	sort.Slice(committee, func(i, j int) bool {
+		return bytes.Compare(committee[i][:], committee[j][:]) < 0
+	})
+	return committee, nil
+}
+
+// StakeInfo is the current stake of an identity with the cumulative amounts credited by each tracked source.
+// Sources are tracked since the fork, untracked sources like epoch rewards and stake interest and any stake withdrawals
+// make TotalStake differ from the sum.
+type StakeInfo struct {
+	TotalStake           *big.Int
+	FromBlockRewards     *big.Int
+	FromFeeRewards       *big.Int
+	FromCommitteeRewards *big.Int
+	FromInvites          *big.Int
+}
+
+// GetStakeInfo returns the stake of the identity at the current head
+func (chain *Blockchain) GetStakeInfo(addr common.Address) (*StakeInfo, error) {
+	appState, err := chain.appState.Readonly(chain.GetCurrentHead().Height())
+	if err != nil {
+		return nil, err
+	}
+	identity := appState.State.GetIdentity(addr)
+	return &StakeInfo{
+		TotalStake:           bigIntOrZero(identity.Stake),
+		FromBlockRewards:     bigIntOrZero(identity.StakeFromBlockRewards),
+		FromFeeRewards:       bigIntOrZero(identity.StakeFromFeeRewards),
+		FromCommitteeRewards: bigIntOrZero(identity.StakeFromCommitteeRewards),
+		FromInvites:          bigIntOrZero(identity.StakeFromInvites),
+	}, nil
+}
+
+func bigIntOrZero(value *big.Int) *big.Int {
+	if value == nil {
+		return new(big.Int)
+	}
+	return new(big.Int).Set(value)
+}
+
+// GetCommitteeMembership returns final committee of the canonical block at given height, committees are stored for blocks with final consensus only
+func (chain *Blockchain) GetCommitteeMembership(blockHeight uint64) (mapset.Set, error) {
+	hash := chain.repo.ReadCanonicalHash(blockHeight)
+	if hash == (common.Hash{}) {
+		return nil, ErrCommitteeNotFound
+	}
+	committee := chain.repo.ReadFinalCommittee(hash)
+	if committee == nil {
+		return nil, ErrCommitteeNotFound
+	}
+	result := mapset.NewSet()
+	for _, addr := range committee {
+		result.Add(addr)
+	}
+	return result, nil
 }
 
 func (chain *Blockchain) WriteCertificate(hash common.Hash, cert *types.BlockCert, persistent bool) {
@@ -1389,6 +2311,36 @@ func (chain *Blockchain) WriteCertificate(hash common.Hash, cert *types.BlockCer
 }
 
 func (chain *Blockchain) GetBlock(hash common.Hash) *types.Block {
+	if block := chain.cachedBlock(hash); block != nil {
+		return block
+	}
+	block := chain.readBlock(hash)
+	if block != nil {
+		chain.blockCache.Add(hash, block.Clone())
+	}
+	return block
+}
+
+func (chain *Blockchain) cachedBlock(hash common.Hash) *types.Block {
+	block, ok := chain.blockCache.Get(hash)
+	chain.metrics.addBlockCacheLookup(ok)
+	if !ok {
+		return nil
+	}
+	return block.(*types.Block).Clone()
+}
+
+// cachedHeader clones only the header of the cached block, header lookups don't need copies of block transactions
+func (chain *Blockchain) cachedHeader(hash common.Hash) *types.Header {
+	block, ok := chain.blockCache.Get(hash)
+	chain.metrics.addBlockCacheLookup(ok)
+	if !ok {
+		return nil
+	}
+	return block.(*types.Block).Header.Clone()
+}
+
+func (chain *Blockchain) readBlock(hash common.Hash) *types.Block {
 	header := chain.repo.ReadBlockHeader(hash)
 	if header == nil {
 		return nil
@@ -1427,11 +2379,203 @@ func (chain *Blockchain) GetBlockWithRetry(hash common.Hash) *types.Block {
 }
 
 func (chain *Blockchain) GetBlockByHeight(height uint64) *types.Block {
+	block, _ := chain.GetBlockByHeightWithError(height)
+	return block
+}
+
+// GetBlockByHeightWithError returns the canonical block, nil block without error means it is not found.
+// ErrBlockPruned is returned for blocks removed by PruneBlocksBefore
+func (chain *Blockchain) GetBlockByHeightWithError(height uint64) (*types.Block, error) {
+	if chain.isPruned(height) {
+		return nil, ErrBlockPruned
+	}
 	hash := chain.repo.ReadCanonicalHash(height)
 	if hash == (common.Hash{}) {
-		return nil
+		return nil, nil
 	}
-	return chain.GetBlock(hash)
+	return chain.GetBlock(hash), nil
+}
+
+func (chain *Blockchain) isPruned(height uint64) bool {
+	return height > chain.genesis.Height() && height < chain.repo.ReadPrunedHeight()
+}
+
+// PruneBlocksBefore removes bodies, canonical hashes and tx indices of blocks below the height except genesis and returns the count
+// of removed bodies. Headers and certificates are kept by hash, so the chain can still be verified.
+// The latest minPruneDepth blocks are never pruned, greater heights are lowered to this floor
+func (chain *Blockchain) PruneBlocksBefore(height uint64) (int, error) {
+	head := chain.GetCurrentHead().Height()
+	if head <= minPruneDepth {
+		return 0, nil
+	}
+	if floor := head - minPruneDepth; height > floor {
+		height = floor
+	}
+	from := chain.repo.ReadPrunedHeight()
+	if from <= chain.genesis.Height() {
+		from = chain.genesis.Height() + 1
+	}
+	cnt := 0
+	for h := from; h < height; h++ {
+		header := chain.GetBlockHeaderByHeight(h)
+		if header == nil {
+			return cnt, errors.Errorf("block header is not found, height: %v", h)
+		}
+		if header.ProposedHeader == nil {
+			continue
+		}
+		if block := chain.GetBlock(header.Hash()); block != nil {
+			for _, tx := range block.Body.Transactions {
+				chain.repo.RemoveTxIndex(tx.Hash())
+			}
+		}
+		if bodyCid, _ := cid2.Cast(header.ProposedHeader.IpfsHash); bodyCid != ipfs.EmptyCid {
+			if err := chain.ipfs.Unpin(header.ProposedHeader.IpfsHash); err != nil {
+				return cnt, errors.WithMessagef(err, "failed to unpin block body, height: %v", h)
+			}
+		}
+		chain.blockCache.Remove(header.Hash())
+		chain.repo.RemoveCanonicalHash(h)
+		cnt++
+	}
+	if height > from {
+		chain.repo.WritePrunedHeight(height)
+	}
+	return cnt, nil
+}
+
+// GetBlockRange returns canonical blocks with heights [from; to] ordered by height
+func (chain *Blockchain) GetBlockRange(from, to uint64) ([]*types.Block, error) {
+	if to < from {
+		return nil, errors.Errorf("invalid block range [%v; %v]", from, to)
+	}
+	if to-from+1 > MaxBlockRange {
+		return nil, errors.Errorf("block range is too big, max: %v", MaxBlockRange)
+	}
+	hashes, err := chain.repo.ReadCanonicalHashes(from, to)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*types.Block, 0, len(hashes))
+	for i, hash := range hashes {
+		block := chain.GetBlock(hash)
+		if block == nil {
+			return nil, errors.Errorf("block is not found, height: %v", from+uint64(i))
+		}
+		result = append(result, block)
+	}
+	return result, nil
+}
+
+// GetCertifiedBlocks returns canonical blocks with heights [from; to] marked as final, ordered by height
+func (chain *Blockchain) GetCertifiedBlocks(from, to uint64) ([]*types.Block, error) {
+	if to < from {
+		return nil, errors.Errorf("invalid block range [%v; %v]", from, to)
+	}
+	if to-from+1 > MaxBlockRange {
+		return nil, errors.Errorf("block range is too big, max: %v", MaxBlockRange)
+	}
+	hashes, err := chain.repo.ReadCanonicalHashes(from, to)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*types.Block, 0)
+	for i, hash := range hashes {
+		if !chain.repo.ReadFinalConsensus(hash) {
+			continue
+		}
+		block := chain.GetBlock(hash)
+		if block == nil {
+			return nil, errors.Errorf("block is not found, height: %v", from+uint64(i))
+		}
+		result = append(result, block)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Height() < result[j].Height()
+	})
+	return result, nil
+}
+
+// GetFeeHistory returns fee records for heights [from; to] ordered by height, pruned heights are omitted
+func (chain *Blockchain) GetFeeHistory(from, to uint64) ([]*types.FeeRecord, error) {
+	if to < from {
+		return nil, errors.Errorf("invalid block range [%v; %v]", from, to)
+	}
+	if to-from+1 > MaxBlockRange {
+		return nil, errors.Errorf("block range is too big, max: %v", MaxBlockRange)
+	}
+	return chain.repo.ReadFeeRecords(from, to), nil
+}
+
+// startFeeHistoryPruning stops the pruning loop started by the previous chain initialization and starts a new one
+func (chain *Blockchain) startFeeHistoryPruning() {
+	chain.StopFeeHistoryPruning()
+	if chain.config.Blockchain.FeeHistoryRange == 0 {
+		return
+	}
+	chain.stopFeePruning = make(chan struct{})
+	go chain.pruneFeeHistoryLoop(chain.stopFeePruning)
+}
+
+// StopFeeHistoryPruning stops the background pruning of fee records
+func (chain *Blockchain) StopFeeHistoryPruning() {
+	if chain.stopFeePruning != nil {
+		close(chain.stopFeePruning)
+		chain.stopFeePruning = nil
+	}
+}
+
+func (chain *Blockchain) pruneFeeHistoryLoop(stop <-chan struct{}) {
+	ticker := time.NewTicker(FeeHistoryPruneInterval)
+	defer ticker.Stop()
+	for {
+		chain.repo.DeleteOutdatedFeeRecords(chain.GetCurrentHead().Height(), chain.config.Blockchain.FeeHistoryRange)
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// ScanBlocks streams canonical blocks from given height up to head which match filter,
+// returned channel is closed when head is reached, a block can't be read or scan is cancelled
+func (chain *Blockchain) ScanBlocks(from uint64, filter func(*types.Block) bool) (<-chan *types.Block, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(context.Background())
+	ch := make(chan *types.Block)
+	go func() {
+		defer close(ch)
+		for height := from; height <= chain.GetCurrentHead().Height(); height += ScanBlocksBatchSize {
+			to := height + ScanBlocksBatchSize - 1
+			if head := chain.GetCurrentHead().Height(); to > head {
+				to = head
+			}
+			hashes, err := chain.repo.ReadCanonicalHashes(height, to)
+			if err != nil {
+				chain.log.Warn("Failed to scan blocks", "err", err)
+				return
+			}
+			for _, hash := range hashes {
+				block := chain.GetBlock(hash)
+				if block == nil {
+					chain.log.Warn("Failed to scan blocks, block is not found", "hash", hash.Hex())
+					return
+				}
+				if !filter(block) {
+					continue
+				}
+				if ctx.Err() != nil {
+					return
+				}
+				select {
+				case ch <- block:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+	return ch, cancel
 }
 
 func (chain *Blockchain) GetBlockHeaderByHeight(height uint64) *types.Header {
@@ -1439,6 +2583,9 @@ func (chain *Blockchain) GetBlockHeaderByHeight(height uint64) *types.Header {
 	if hash == (common.Hash{}) {
 		return nil
 	}
+	if header := chain.cachedHeader(hash); header != nil {
+		return header
+	}
 	return chain.repo.ReadBlockHeader(hash)
 }
 
@@ -1446,6 +2593,22 @@ func (chain *Blockchain) GetTxIndex(hash common.Hash) *types.TransactionIndex {
 	return chain.repo.ReadTxIndex(hash)
 }
 
+func (chain *Blockchain) GetTransactionByHash(hash common.Hash) (*types.Transaction, *types.Block, int, error) {
+	idx := chain.repo.ReadTxIndex(hash)
+	if idx == nil {
+		return nil, nil, -1, ErrTxNotFound
+	}
+	block := chain.GetBlock(idx.BlockHash)
+	if block == nil || int(idx.Idx) >= len(block.Body.Transactions) {
+		return nil, nil, -1, ErrTxNotFound
+	}
+	tx := block.Body.Transactions[idx.Idx]
+	if tx.Hash() != hash {
+		return nil, nil, -1, ErrTxNotFound
+	}
+	return tx, block, int(idx.Idx), nil
+}
+
 func (chain *Blockchain) GetTx(hash common.Hash) (*types.Transaction, *types.TransactionIndex) {
 	idx := chain.repo.ReadTxIndex(hash)
 	if idx == nil {
@@ -1475,8 +2638,12 @@ func (chain *Blockchain) GetTx(hash common.Hash) (*types.Transaction, *types.Tra
 }
 
 func (chain *Blockchain) GetCommitteeSize(vc *validators.ValidatorsCache, final bool) int {
+	return chain.committeeSize(vc, final, chain.GovernedParams())
+}
+
+func (chain *Blockchain) committeeSize(vc *validators.ValidatorsCache, final bool, params config.GovernedParams) int {
 	var cnt = vc.OnlineSize()
-	percent := chain.config.Consensus.CommitteePercent
+	percent := params.CommitteePercent
 	if final {
 		percent = chain.config.Consensus.FinalCommitteePercent
 	}
@@ -1492,6 +2659,10 @@ func (chain *Blockchain) GetCommitteeSize(vc *validators.ValidatorsCache, final
 }
 
 func (chain *Blockchain) GetCommitteeVotesThreshold(vc *validators.ValidatorsCache, final bool) int {
+	return chain.committeeVotesThreshold(vc, final, chain.GovernedParams())
+}
+
+func (chain *Blockchain) committeeVotesThreshold(vc *validators.ValidatorsCache, final bool, params config.GovernedParams) int {
 
 	var cnt = vc.OnlineSize()
 	switch cnt {
@@ -1506,7 +2677,7 @@ func (chain *Blockchain) GetCommitteeVotesThreshold(vc *validators.ValidatorsCac
 	case 8:
 		return 5
 	}
-	size := chain.GetCommitteeSize(vc, final)
+	size := chain.committeeSize(vc, final, params)
 	return int(math2.Round(float64(size) * chain.config.Consensus.AgreementThreshold))
 }
 
@@ -1514,6 +2685,449 @@ func (chain *Blockchain) Genesis() *types.Header {
 	return chain.genesis
 }
 
+// CountUpgradeSignals returns how many of the last n canonical blocks have upgradeID as the first byte of header extra data
+func (chain *Blockchain) CountUpgradeSignals(n int, upgradeID byte) int {
+	head := chain.GetCurrentHead().Height()
+	count := 0
+	for i := uint64(0); i < uint64(n) && i <= head-chain.genesis.Height(); i++ {
+		header := chain.GetBlockHeaderByHeight(head - i)
+		if header == nil {
+			break
+		}
+		if extra := header.ExtraData(); len(extra) > 0 && extra[0] == upgradeID {
+			count++
+		}
+	}
+	return count
+}
+
+// GetBlockAtTime returns the highest canonical block with timestamp not after t, block timestamps don't decrease
+// so the block is found by binary search over heights reading O(log(height)) headers
+func (chain *Blockchain) GetBlockAtTime(t time.Time) (*types.Block, error) {
+	timestamp := t.Unix()
+	if timestamp < chain.genesis.Time() {
+		return nil, ErrBeforeGenesis
+	}
+	lo, hi := chain.genesis.Height(), chain.GetCurrentHead().Height()
+	if pruned := chain.repo.ReadPrunedHeight(); pruned > lo {
+		header := chain.GetBlockHeaderByHeight(pruned)
+		if header == nil {
+			return nil, errors.Errorf("block is not found, height: %v", pruned)
+		}
+		if timestamp < header.Time() {
+			return nil, ErrBlockPruned
+		}
+		lo = pruned
+	}
+	for lo < hi {
+		mid := lo + (hi-lo+1)/2
+		header := chain.GetBlockHeaderByHeight(mid)
+		if header == nil {
+			return nil, errors.Errorf("block is not found, height: %v", mid)
+		}
+		if header.Time() <= timestamp {
+			lo = mid
+		} else {
+			hi = mid - 1
+		}
+	}
+	block, err := chain.GetBlockByHeightWithError(lo)
+	if err != nil {
+		return nil, err
+	}
+	if block == nil {
+		return nil, errors.Errorf("block is not found, height: %v", lo)
+	}
+	return block, nil
+}
+
+// GetSeedAt returns seed of the canonical block at given height from the seed index,
+// blocks inserted before the index existed are read from headers and indexed on the first request
+func (chain *Blockchain) GetSeedAt(height uint64) (types.Seed, error) {
+	if height == 0 || height < chain.genesis.Height() || height > chain.GetCurrentHead().Height() {
+		return types.Seed{}, ErrHeightOutOfRange
+	}
+	if seed, ok := chain.repo.ReadBlockSeed(height); ok {
+		return seed, nil
+	}
+	header := chain.GetBlockHeaderByHeight(height)
+	if header == nil {
+		return types.Seed{}, errors.Errorf("block is not found, height: %v", height)
+	}
+	chain.repo.WriteBlockSeed(height, header.Seed())
+	return header.Seed(), nil
+}
+
+type SeedRecord struct {
+	Height uint64
+	Seed   types.Seed
+}
+
+// GetSeedHistory returns seeds of the latest n canonical blocks in descending height order,
+// all available seeds are returned if the chain is shorter than n blocks
+func (chain *Blockchain) GetSeedHistory(n int) ([]SeedRecord, error) {
+	if n <= 0 {
+		return nil, nil
+	}
+	head := chain.GetCurrentHead().Height()
+	lowest := chain.genesis.Height()
+	if lowest == 0 {
+		lowest = 1
+	}
+	if head < lowest {
+		return nil, nil
+	}
+	if available := head - lowest + 1; uint64(n) > available {
+		n = int(available)
+	}
+	result := make([]SeedRecord, 0, n)
+	for height := head; len(result) < n; height-- {
+		seed, err := chain.GetSeedAt(height)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, SeedRecord{height, seed})
+	}
+	return result, nil
+}
+
+// GetRecentEmptyBlocks returns up to n latest empty blocks in descending height order, only the latest
+// maxEmptyBlocksSearch blocks are searched and blocks found there are returned even if there are less than n
+func (chain *Blockchain) GetRecentEmptyBlocks(n int) ([]*types.Block, error) {
+	var result []*types.Block
+	head := chain.GetCurrentHead().Height()
+	lowest := chain.genesis.Height()
+	if head >= maxEmptyBlocksSearch && head-maxEmptyBlocksSearch+1 > lowest {
+		lowest = head - maxEmptyBlocksSearch + 1
+	}
+	for height := head; height >= lowest && len(result) < n; height-- {
+		block, err := chain.GetBlockByHeightWithError(height)
+		// pruned blocks are skipped, they can't be read by height anymore
+		if err == ErrBlockPruned {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		if block == nil {
+			return nil, errors.Errorf("block is not found, height: %v", height)
+		}
+		if block.IsEmpty() {
+			result = append(result, block)
+		}
+		if height == 0 {
+			break
+		}
+	}
+	return result, nil
+}
+
+// GetIdentityAt returns identity state committed at given height, state versions match block heights
+func (chain *Blockchain) GetIdentityAt(addr common.Address, blockHeight uint64) (state.Identity, error) {
+	if blockHeight < chain.genesis.Height() || blockHeight > chain.GetCurrentHead().Height() {
+		return state.Identity{}, ErrHeightOutOfRange
+	}
+	stateDb, err := chain.appState.State.Readonly(int64(blockHeight))
+	if err != nil {
+		return state.Identity{}, errors.Wrapf(err, "state at height %v is not available", blockHeight)
+	}
+	return stateDb.GetIdentity(addr), nil
+}
+
+// CalculateAddressBalance returns balance and stake of given address read from the same state snapshot
+func (chain *Blockchain) CalculateAddressBalance(addr common.Address) (balance *big.Int, stake *big.Int, err error) {
+	if addr == (common.Address{}) {
+		return big.NewInt(0), big.NewInt(0), nil
+	}
+	height := chain.GetCurrentHead().Height()
+	stateDb, err := chain.appState.State.Readonly(int64(height))
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "state at height %v is not available", height)
+	}
+	balance, stake = stateDb.GetBalance(addr), stateDb.GetStakeBalance(addr)
+	if balance == nil {
+		balance = big.NewInt(0)
+	}
+	if stake == nil {
+		stake = big.NewInt(0)
+	}
+	return balance, stake, nil
+}
+
+type ProposerRecord struct {
+	BlockHeight     uint64
+	ProposerAddress common.Address
+	BlockHash       common.Hash
+	Timestamp       time.Time
+}
+
+func (chain *Blockchain) proposerHistorySize() uint64 {
+	if chain.config.Blockchain.ProposerHistorySize == 0 {
+		return config.DefaultProposerHistorySize
+	}
+	return chain.config.Blockchain.ProposerHistorySize
+}
+
+// writeProposerRecord stores block proposer into circular buffer, empty blocks without proposer attribution are stored with zero address
+func (chain *Blockchain) writeProposerRecord(header *types.Header) {
+	chain.repo.WriteProposerRecord(header.Height()%chain.proposerHistorySize(), &types.ProposerRecord{
+		BlockHeight:     header.Height(),
+		ProposerAddress: header.ProposerAddress(),
+		BlockHash:       header.Hash(),
+		Timestamp:       uint64(header.Time()),
+	})
+}
+
+// GetProposerHistory returns up to n most recent proposer records in descending height order
+func (chain *Blockchain) GetProposerHistory(n int) ([]ProposerRecord, error) {
+	if n < 0 {
+		return nil, errors.Errorf("invalid records count: %v", n)
+	}
+	size := chain.proposerHistorySize()
+	result := make([]ProposerRecord, 0)
+	for height := chain.GetCurrentHead().Height(); height > 0 && len(result) < n; height-- {
+		record := chain.repo.ReadProposerRecord(height % size)
+		// slot is missing or already overwritten by another height
+		if record == nil || record.BlockHeight != height {
+			break
+		}
+		result = append(result, ProposerRecord{
+			BlockHeight:     record.BlockHeight,
+			ProposerAddress: record.ProposerAddress,
+			BlockHash:       record.BlockHash,
+			Timestamp:       time.Unix(int64(record.Timestamp), 0),
+		})
+	}
+	return result, nil
+}
+
+type CommitteeSizeRecord struct {
+	BlockHeight   uint64
+	NetworkSize   int
+	CommitteeSize int
+}
+
+// writeCommitteeSizeRecord stores network and final committee sizes after the block into circular buffer of proposer history size
+func (chain *Blockchain) writeCommitteeSizeRecord(height uint64) {
+	validatorsCache := chain.appState.ValidatorsCache
+	// validators cache is not loaded yet while genesis is inserted
+	if validatorsCache == nil {
+		return
+	}
+	chain.repo.WriteCommitteeSizeRecord(height%chain.proposerHistorySize(), &types.CommitteeSizeRecord{
+		BlockHeight:   height,
+		NetworkSize:   uint64(validatorsCache.NetworkSize()),
+		CommitteeSize: uint64(chain.GetCommitteeSize(validatorsCache, true)),
+	})
+}
+
+// GetCommitteeSizeHistory returns up to n most recent committee size records in descending height order,
+// expected reward of a final committee member is FinalCommitteeReward / CommitteeSize
+func (chain *Blockchain) GetCommitteeSizeHistory(n int) ([]CommitteeSizeRecord, error) {
+	if n < 0 {
+		return nil, errors.Errorf("invalid records count: %v", n)
+	}
+	size := chain.proposerHistorySize()
+	result := make([]CommitteeSizeRecord, 0)
+	for height := chain.GetCurrentHead().Height(); height > 0 && len(result) < n; height-- {
+		record := chain.repo.ReadCommitteeSizeRecord(height % size)
+		if record == nil || record.BlockHeight != height {
+			break
+		}
+		result = append(result, CommitteeSizeRecord{
+			BlockHeight:   record.BlockHeight,
+			NetworkSize:   int(record.NetworkSize),
+			CommitteeSize: int(record.CommitteeSize),
+		})
+	}
+	return result, nil
+}
+
+// VerifySupplyInvariant checks that coins held at head (balances, stakes of alive identities and pending withdrawals)
+// differ from coins held at genesis exactly by the amount minted minus the amount burnt since genesis
+func (chain *Blockchain) VerifySupplyInvariant() error {
+	genesisSupply, genesisMinted, genesisBurnt, err := chain.supplyAt(chain.genesis.Height())
+	if err != nil {
+		return errors.Wrap(err, "failed to read genesis state")
+	}
+	supply, minted, burnt, err := chain.supplyAt(chain.GetCurrentHead().Height())
+	if err != nil {
+		return errors.Wrap(err, "failed to read head state")
+	}
+	expected := new(big.Int).Add(genesisSupply, new(big.Int).Sub(minted, genesisMinted))
+	expected.Sub(expected, new(big.Int).Sub(burnt, genesisBurnt))
+	if supply.Cmp(expected) != 0 {
+		return errors.Errorf("supply invariant is violated at height %v: supply %v, expected %v (genesis %v, minted %v, burnt %v)",
+			chain.GetCurrentHead().Height(), supply, expected, genesisSupply, minted, burnt)
+	}
+	return nil
+}
+
+func (chain *Blockchain) supplyAt(height uint64) (supply, minted, burnt *big.Int, err error) {
+	appState, err := chain.appState.Readonly(height)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	supply = big.NewInt(0)
+	appState.State.IterateBalances(func(addr common.Address, balance, stake *big.Int) bool {
+		supply.Add(supply, balance)
+		supply.Add(supply, stake)
+		return false
+	})
+	for _, withdrawal := range appState.State.PendingWithdrawals() {
+		supply.Add(supply, withdrawal.Amount)
+	}
+	minted, burnt = chain.supplyTotals(height)
+	return supply, minted, burnt, nil
+}
+
+// writeSupplyRecord adds coins minted and burnt by the block to totals of the previous block
+func (chain *Blockchain) writeSupplyRecord(height uint64, minted, burnt *big.Int) {
+	prevMinted, prevBurnt := chain.supplyTotals(height - 1)
+	chain.repo.WriteSupplyRecord(height, &types.SupplyRecord{
+		Minted: minted.Add(minted, prevMinted),
+		Burnt:  burnt.Add(burnt, prevBurnt),
+	})
+}
+
+// supplyTotals returns coins minted and burnt since genesis up to the block, heights without records have zero totals
+func (chain *Blockchain) supplyTotals(height uint64) (minted, burnt *big.Int) {
+	record := chain.repo.ReadSupplyRecord(height)
+	if record == nil {
+		return new(big.Int), new(big.Int)
+	}
+	return bigIntOrZero(record.Minted), bigIntOrZero(record.Burnt)
+}
+
+type IdentityStateChange struct {
+	Block    uint64
+	OldState state.IdentityState
+	NewState state.IdentityState
+	TxHash   common.Hash
+}
+
+func (chain *Blockchain) writeIdentityStateChanges(height uint64, changes []*state.IdentityStateChange) {
+	var addresses []common.Address
+	changesByAddr := make(map[common.Address][]*types.IdentityStateChange)
+	for _, change := range changes {
+		if _, ok := changesByAddr[change.Address]; !ok {
+			addresses = append(addresses, change.Address)
+		}
+		changesByAddr[change.Address] = append(changesByAddr[change.Address], &types.IdentityStateChange{
+			Height:   height,
+			OldState: uint8(change.OldState),
+			NewState: uint8(change.NewState),
+			TxHash:   change.TxHash,
+		})
+	}
+	for _, addr := range addresses {
+		chain.repo.WriteIdentityStateChanges(addr, height, changesByAddr[addr])
+	}
+}
+
+// GetIdentityHistory returns state transitions of the identity ordered by block, transitions made by
+// validation ceremony have empty tx hash
+func (chain *Blockchain) GetIdentityHistory(addr common.Address) ([]IdentityStateChange, error) {
+	result := make([]IdentityStateChange, 0)
+	for _, record := range chain.repo.ReadIdentityStateChanges(addr) {
+		// records of blocks above head are left after chain reset
+		if record.Height > chain.GetCurrentHead().Height() {
+			break
+		}
+		result = append(result, IdentityStateChange{
+			Block:    record.Height,
+			OldState: state.IdentityState(record.OldState),
+			NewState: state.IdentityState(record.NewState),
+			TxHash:   record.TxHash,
+		})
+	}
+	return result, nil
+}
+
+type EpochInfo struct {
+	Epoch           uint16
+	StartBlock      uint64
+	NextEpochBlock  uint64
+	BlocksRemaining uint64
+	VerifiedCount   int
+	CandidateCount  int
+	TotalInvites    int
+}
+
+// GetEpochInfo returns current epoch metadata read from the state committed at head,
+// next epoch block is estimated assuming blocks are produced every EmptyBlockTimeIncrement
+func (chain *Blockchain) GetEpochInfo() (*EpochInfo, error) {
+	head := chain.GetCurrentHead()
+	stateDb, err := chain.appState.State.Readonly(int64(head.Height()))
+	if err != nil {
+		return nil, errors.Wrapf(err, "state at height %v is not available", head.Height())
+	}
+	info := &EpochInfo{
+		Epoch:      stateDb.Epoch(),
+		StartBlock: stateDb.EpochBlock(),
+	}
+	blockInterval := int64(EmptyBlockTimeIncrement / time.Second)
+	if timeLeft := stateDb.NextValidationTime().Unix() - head.Time(); timeLeft > 0 {
+		info.BlocksRemaining = uint64((timeLeft + blockInterval - 1) / blockInterval)
+	}
+	info.NextEpochBlock = head.Height() + info.BlocksRemaining
+	stateDb.IterateOverIdentities(func(addr common.Address, identity state.Identity) {
+		switch identity.State {
+		case state.Verified:
+			info.VerifiedCount++
+		case state.Candidate:
+			info.CandidateCount++
+		}
+		info.TotalInvites += int(identity.Invites)
+	})
+	return info, nil
+}
+
+// GetValidatorSet returns all validators at head sorted by address
+func (chain *Blockchain) GetValidatorSet() ([]common.Address, error) {
+	if chain.appState.ValidatorsCache == nil {
+		return nil, errors.New("validators cache is not initialized")
+	}
+	return chain.appState.ValidatorsCache.List(), nil
+}
+
+type NetworkStats struct {
+	CurrentHeight  uint64        `json:"currentHeight"`
+	GenesisHash    common.Hash   `json:"genesisHash"`
+	Network        types.Network `json:"network"`
+	EpochNumber    uint16        `json:"epochNumber"`
+	NextEpochBlock uint64        `json:"nextEpochBlock"`
+	ValidatorCount int           `json:"validatorCount"`
+	CandidateCount int           `json:"candidateCount"`
+	PendingTxCount int           `json:"pendingTxCount"`
+	LastBlockTime  time.Time     `json:"lastBlockTime"`
+}
+
+// GetNetworkStats returns chain health snapshot, epoch data is taken from GetEpochInfo
+func (chain *Blockchain) GetNetworkStats() (*NetworkStats, error) {
+	head := chain.GetCurrentHead()
+	epochInfo, err := chain.GetEpochInfo()
+	if err != nil {
+		return nil, err
+	}
+	return &NetworkStats{
+		CurrentHeight:  head.Height(),
+		GenesisHash:    chain.genesis.Hash(),
+		Network:        chain.config.Network,
+		EpochNumber:    epochInfo.Epoch,
+		NextEpochBlock: epochInfo.NextEpochBlock,
+		ValidatorCount: chain.appState.ValidatorsCache.NetworkSize(),
+		CandidateCount: epochInfo.CandidateCount,
+		PendingTxCount: chain.txpool.TotalSize(),
+		LastBlockTime:  time.Unix(head.Time(), 0).UTC(),
+	}, nil
+}
+
+// GetTxPoolStats returns mempool fill level for congestion indicators
+func (chain *Blockchain) GetTxPoolStats() mempool.TxPoolStats {
+	return chain.txpool.Stats()
+}
+
 func (chain *Blockchain) ValidateSubChain(startHeight uint64, blocks []types.BlockBundle) error {
 	checkState, err := chain.appState.ForCheckWithOverwrite(startHeight)
 	if err != nil {
@@ -1549,11 +3163,12 @@ func (chain *Blockchain) ValidateSubChain(startHeight uint64, blocks []types.Blo
 }
 
 func (chain *Blockchain) ResetTo(height uint64) error {
-	prevHead := chain.Head.Height()
+	prevHead := chain.GetCurrentHead().Height()
 	if err := chain.appState.ResetTo(height); err != nil {
 		return errors.WithMessage(err, "state is corrupted, try to resync from scratch")
 	}
 	chain.setHead(height, nil)
+	chain.refreshGovernedParams()
 
 	for h := height + 1; h <= prevHead; h++ {
 		hash := chain.repo.ReadCanonicalHash(h)
@@ -1562,18 +3177,67 @@ func (chain *Blockchain) ResetTo(height uint64) error {
 		}
 		chain.repo.RemoveHeader(hash)
 		chain.repo.RemoveCanonicalHash(h)
+		chain.repo.RemoveBlockSeed(h)
+		chain.blockCache.Remove(hash)
 	}
 
 	return nil
 }
 
+// ForkAtHeight creates independent chain backed by in-memory db which shares canonical blocks and state with this chain up to height,
+// the fork has its own tx pool and app state and is already initialized. Block bodies are stored in the same ipfs proxy.
+func (chain *Blockchain) ForkAtHeight(height uint64) (*Blockchain, error) {
+	if height == 0 || height > chain.GetCurrentHead().Height() {
+		return nil, errors.Errorf("invalid fork height: %v", height)
+	}
+	db := dbm.NewMemDB()
+	if err := chain.repo.CopyTo(db); err != nil {
+		return nil, err
+	}
+	bus := eventbus.New()
+	appState := appstate.NewAppState(db, bus)
+	if err := appState.Initialize(chain.GetCurrentHead().Height()); err != nil {
+		return nil, err
+	}
+	cfg := *chain.config
+	consensusCfg := *chain.config.Consensus
+	cfg.Consensus = &consensusCfg
+	mempoolCfg := cfg.Mempool
+	if mempoolCfg == nil {
+		mempoolCfg = config.GetDefaultMempoolConfig()
+	}
+	txPool := mempool.NewTxPool(appState, bus, mempoolCfg, cfg.Consensus)
+	offlineDetector := NewOfflineDetector(&cfg, db, appState, chain.secStore, bus)
+
+	fork, err := NewBlockchain(&cfg, db, WithRequiredDefaults(txPool, appState, chain.ipfs, chain.secStore, bus, offlineDetector, chain.keyStore),
+		WithLogger(chain.log), WithVRFSigner(chain.vrfSigner))
+	if err != nil {
+		return nil, err
+	}
+	fork.coinBaseAddress = chain.coinBaseAddress
+	fork.pubKey = chain.pubKey
+	fork.genesis = chain.genesis
+	fork.applyNewEpochFn = chain.applyNewEpochFn
+	fork.SetCurrentHead(fork.repo.ReadHead())
+	if err := fork.ResetTo(height); err != nil {
+		return nil, err
+	}
+	// reload caches built on top of the removed state versions
+	if err := appState.Initialize(height); err != nil {
+		return nil, err
+	}
+	fork.indexer.initialize(fork.coinBaseAddress)
+	txPool.Initialize(fork.GetCurrentHead(), fork.coinBaseAddress)
+	return fork, nil
+}
+
 func (chain *Blockchain) EnsureIntegrity() error {
 	wasReset := false
-	for chain.Head.Root() != chain.appState.State.Root() ||
-		chain.Head.IdentityRoot() != chain.appState.IdentityState.Root() {
+	for chain.GetCurrentHead().Root() != chain.appState.State.Root() ||
+		chain.GetCurrentHead().IdentityRoot() != chain.appState.IdentityState.Root() {
 		wasReset = true
 		resetTo := uint64(0)
-		for h, tryCnt := chain.Head.Height()-1, 0; h >= 1 && tryCnt < int(state.SyncTreeKeepEvery)+1; h, tryCnt = h-1, tryCnt+1 {
+		for h, tryCnt := chain.GetCurrentHead().Height()-1, 0; h >= 1 && tryCnt < int(state.SyncTreeKeepEvery)+1; h, tryCnt = h-1, tryCnt+1 {
 			if chain.appState.IdentityState.HasVersion(h) {
 				resetTo = h
 				break
@@ -1587,7 +3251,7 @@ func (chain *Blockchain) EnsureIntegrity() error {
 		}
 	}
 	if wasReset {
-		chain.log.Warn("Blockchain was reset", "new head", chain.Head.Height())
+		chain.log.Warn("Blockchain was reset", "new head", chain.GetCurrentHead().Height())
 	}
 	return nil
 }
@@ -1599,7 +3263,7 @@ func (chain *Blockchain) StartSync() {
 
 func (chain *Blockchain) StopSync() {
 	chain.isSyncing = false
-	chain.txpool.StopSync(chain.GetBlockWithRetry(chain.Head.Hash()))
+	chain.txpool.StopSync(chain.GetBlockWithRetry(chain.GetCurrentHead().Hash()))
 }
 
 func checkIfProposer(addr common.Address, appState *appstate.AppState) bool {
@@ -1611,7 +3275,7 @@ func (chain *Blockchain) AddHeader(header *types.Header) error {
 
 	prev := chain.PreliminaryHead
 	if prev == nil {
-		prev = chain.Head
+		prev = chain.GetCurrentHead()
 	}
 	if err := chain.ValidateHeader(header, prev); err != nil {
 		return err
@@ -1626,50 +3290,87 @@ func (chain *Blockchain) AddHeader(header *types.Header) error {
 }
 
 func (chain *Blockchain) ValidateHeader(header, prevBlock *types.Header) error {
+	if len(header.ExtraData()) > types.MaxHeaderExtraDataSize {
+		return ErrExtraDataTooLarge
+	}
+
 	if err := validateBlockParentHash(header, prevBlock); err != nil {
 		return err
 	}
 
-	if err := validateBlockTimestamp(header, prevBlock); err != nil {
+	if err := validateBlockTimestamp(header, prevBlock, chain.config.Consensus.MaxBlockTimeDrift, chain.config.Consensus.MinBlockDistance,
+		chain.config.Consensus.MinBlockInterval); err != nil {
 		return err
 	}
 
+	if chain.genesis != nil && header.Time() < chain.genesis.Time() {
+		return newBlockValidationError(ErrInvalidTimestamp, "block is before genesis, genesis: %v, current: %v", chain.genesis.Time(), header.Time())
+	}
+
 	if header.EmptyBlockHeader != nil {
+		if len(header.EmptyBlockHeader.ProposerPubKey) > 0 {
+			if header.Seed() != emptyBlockSeed(prevBlock) {
+				return newBlockValidationError(ErrInvalidSeed, "empty block seed is invalid")
+			}
+			_, err := verifySeedProof(header.EmptyBlockHeader.ProposerPubKey, header.EmptyBlockHeader.SeedProof, prevBlock)
+			return err
+		}
 		//TODO: validate empty block hash
 		return nil
 	}
 
 	coinbase := header.Coinbase()
 	if coinbase == (common.Address{}) {
-		return errors.New("invalid coinbase")
+		return newBlockValidationError(ErrInvalidCoinbase, "invalid coinbase")
 	}
 
-	var seedData = getSeedData(prevBlock)
-	pubKey, err := crypto.UnmarshalPubkey(header.ProposedHeader.ProposerPubKey)
+	//TODO: add proposer's check??
+	return validateSeedProof(header.Seed(), header.ProposedHeader.ProposerPubKey, header.ProposedHeader.SeedProof, prevBlock)
+}
+
+func validateSeedProof(seed types.Seed, proposerPubKey []byte, seedProof []byte, prevBlock *types.Header) error {
+	hash, err := verifySeedProof(proposerPubKey, seedProof, prevBlock)
 	if err != nil {
 		return err
 	}
-	verifier, err := p256.NewVRFVerifier(pubKey)
-	if err != nil {
-		return err
+	if hash != seed {
+		return newBlockValidationError(ErrInvalidSeed, "seed is invalid")
+	}
+	if !seed.IsValid() {
+		return newBlockValidationError(ErrInvalidSeed, "seed has low entropy")
 	}
+	return nil
+}
 
-	hash, err := verifier.ProofToHash(seedData, header.ProposedHeader.SeedProof)
+// verifySeedProof checks the VRF proof of the seed data of prevBlock and returns the VRF output
+func verifySeedProof(proposerPubKey []byte, seedProof []byte, prevBlock *types.Header) (types.Seed, error) {
+	pubKey, err := crypto.UnmarshalPubkey(proposerPubKey)
 	if err != nil {
-		return err
+		return types.Seed{}, err
 	}
-	if hash != header.Seed() {
-		return errors.New("seed is invalid")
+	verifier, err := p256.NewVRFVerifier(pubKey)
+	if err != nil {
+		return types.Seed{}, err
 	}
-	//TODO: add proposer's check??
-
-	return nil
+	hash, err := verifier.ProofToHash(getSeedData(prevBlock), seedProof)
+	if err != nil {
+		return types.Seed{}, err
+	}
+	return hash, nil
 }
 
 func (chain *Blockchain) GetCertificate(hash common.Hash) *types.BlockCert {
 	return chain.repo.ReadCertificate(hash)
 }
 
+func (chain *Blockchain) GetBlockCert(hash common.Hash) (*types.BlockCert, error) {
+	cert := chain.repo.ReadCertificate(hash)
+	if cert == nil {
+		return nil, ErrCertNotFound
+	}
+	return cert, nil
+}
+
 func (chain *Blockchain) GetIdentityDiff(height uint64) *state.IdentityStateDiff {
 	data := chain.repo.ReadIdentityStateDiff(height)
 	if data == nil {
@@ -1747,10 +3448,11 @@ func (chain *Blockchain) ReadBlockForForkedPeer(blocks []common.Hash) []types.Bl
 		}
 	}
 	result := make([]types.BlockBundle, 0)
-	if commonHeight == 1 {
+	// blocks below the pruned height can't be read by height, the peer has to sync from another node
+	if commonHeight == 1 || chain.isPruned(commonHeight+1) {
 		return result
 	}
-	for h := commonHeight + 1; h < commonHeight+needBlocks+1 && h <= chain.Head.Height(); h++ {
+	for h := commonHeight + 1; h < commonHeight+needBlocks+1 && h <= chain.GetCurrentHead().Height(); h++ {
 		block := chain.GetBlockByHeight(h)
 		if block == nil {
 			break
@@ -1787,7 +3489,7 @@ func (chain *Blockchain) ReadBlockForForkedPeer(blocks []common.Hash) []types.Bl
 
 func (chain *Blockchain) GetTopBlockHashes(count int) []common.Hash {
 	result := make([]common.Hash, 0, count)
-	head := chain.Head.Height()
+	head := chain.GetCurrentHead().Height()
 	for i := uint64(0); i < uint64(count); i++ {
 		hash := chain.repo.ReadCanonicalHash(head - i)
 		if hash == (common.Hash{}) {
@@ -1816,7 +3518,8 @@ func (chain *Blockchain) AtomicSwitchToPreliminary(manifest *snapshot.Manifest)
 	if err := batch.WriteSync(); err != nil {
 		return err
 	}
-	chain.setCurrentHead(newHead)
+	chain.SetCurrentHead(newHead)
+	chain.refreshGovernedParams()
 	go func() {
 		common.ClearDb(oldIdentityStateDb)
 		common.ClearDb(oldStateDb)
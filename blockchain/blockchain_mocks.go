@@ -54,15 +54,18 @@ func NewTestBlockchainWithConfig(withIdentity bool, conf *config.ConsensusConf,
 		}
 	}
 
-	txPool := mempool.NewTxPool(appState, bus, cfg.Mempool)
+	txPool := mempool.NewTxPool(appState, bus, cfg.Mempool, cfg.Consensus)
 	offline := NewOfflineDetector(cfg, db, appState, secStore, bus)
 	keyStore := keystore.NewKeyStore("./testdata", keystore.StandardScryptN, keystore.StandardScryptP)
 
-	chain := NewBlockchain(cfg, db, txPool, appState, ipfs.NewMemoryIpfsProxy(), secStore, bus, offline, keyStore)
+	chain, err := NewBlockchain(cfg, db, WithRequiredDefaults(txPool, appState, ipfs.NewMemoryIpfsProxy(), secStore, bus, offline, keyStore))
+	if err != nil {
+		panic(err)
+	}
 
 	chain.InitializeChain()
-	appState.Initialize(chain.Head.Height())
-	txPool.Initialize(chain.Head, secStore.GetAddress())
+	appState.Initialize(chain.GetCurrentHead().Height())
+	txPool.Initialize(chain.GetCurrentHead(), secStore.GetAddress())
 
 	return &TestBlockchain{db, chain}, appState, txPool, key
 }
@@ -70,6 +73,7 @@ func NewTestBlockchainWithConfig(withIdentity bool, conf *config.ConsensusConf,
 func NewTestBlockchain(withIdentity bool, alloc map[common.Address]config.GenesisAllocation) (*TestBlockchain, *appstate.AppState, *mempool.TxPool, *ecdsa.PrivateKey) {
 	cfg := config.GetDefaultConsensusConfig()
 	cfg.Automine = true
+	cfg.ForkHeight = 0
 	return NewTestBlockchainWithConfig(withIdentity, cfg, &config.ValidationConfig{}, alloc, -1, -1, 0, 0)
 }
 
@@ -79,21 +83,27 @@ func NewTestBlockchainWithBlocks(blocksCount int, emptyBlocksCount int) (*TestBl
 }
 
 func NewCustomTestBlockchain(blocksCount int, emptyBlocksCount int, key *ecdsa.PrivateKey) (*TestBlockchain, *appstate.AppState) {
-	addr := crypto.PubkeyToAddress(key.PublicKey)
+	cfg := NewTestConfig(crypto.PubkeyToAddress(key.PublicKey), nil)
+	return NewCustomTestBlockchainWithConfig(blocksCount, emptyBlocksCount, key, cfg)
+}
+
+// NewTestConfig returns the config of an automined test chain with the first ceremony far in the future and
+// the fork activated from genesis, tests adjust it before passing to NewCustomTestBlockchainWithConfig
+func NewTestConfig(godAddress common.Address, alloc map[common.Address]config.GenesisAllocation) *config.Config {
 	consensusCfg := config.GetDefaultConsensusConfig()
 	consensusCfg.Automine = true
-	cfg := &config.Config{
+	consensusCfg.ForkHeight = 0
+	return &config.Config{
 		Network:   0x99,
 		Consensus: consensusCfg,
 		GenesisConf: &config.GenesisConf{
-			Alloc:             nil,
-			GodAddress:        addr,
+			Alloc:             alloc,
+			GodAddress:        godAddress,
 			FirstCeremonyTime: 4070908800, //01.01.2099
 		},
 		Validation: &config.ValidationConfig{},
 		Blockchain: &config.BlockchainConfig{},
 	}
-	return NewCustomTestBlockchainWithConfig(blocksCount, emptyBlocksCount, key, cfg)
 }
 
 func NewCustomTestBlockchainWithConfig(blocksCount int, emptyBlocksCount int, key *ecdsa.PrivateKey, cfg *config.Config) (*TestBlockchain, *appstate.AppState) {
@@ -105,17 +115,20 @@ func NewCustomTestBlockchainWithConfig(blocksCount int, emptyBlocksCount int, ke
 	if cfg.OfflineDetection == nil {
 		cfg.OfflineDetection = config.GetDefaultOfflineDetectionConfig()
 	}
-	txPool := mempool.NewTxPool(appState, bus, config.GetDefaultMempoolConfig())
+	txPool := mempool.NewTxPool(appState, bus, config.GetDefaultMempoolConfig(), cfg.Consensus)
 	offline := NewOfflineDetector(cfg, db, appState, secStore, bus)
 	keyStore := keystore.NewKeyStore("./testdata", keystore.StandardScryptN, keystore.StandardScryptP)
 
-	chain := NewBlockchain(cfg, db, txPool, appState, ipfs.NewMemoryIpfsProxy(), secStore, bus, offline, keyStore)
+	chain, err := NewBlockchain(cfg, db, WithRequiredDefaults(txPool, appState, ipfs.NewMemoryIpfsProxy(), secStore, bus, offline, keyStore))
+	if err != nil {
+		panic(err)
+	}
 	chain.InitializeChain()
-	appState.Initialize(chain.Head.Height())
+	appState.Initialize(chain.GetCurrentHead().Height())
 
 	result := &TestBlockchain{db, chain}
 	result.GenerateBlocks(blocksCount).GenerateEmptyBlocks(emptyBlocksCount)
-	txPool.Initialize(chain.Head, secStore.GetAddress())
+	txPool.Initialize(chain.GetCurrentHead(), secStore.GetAddress())
 	return result, appState
 }
 
@@ -134,27 +147,18 @@ func (chain *TestBlockchain) Copy() (*TestBlockchain, *appstate.AppState) {
 		db.Set(it.Key(), it.Value())
 	}
 	appState := appstate.NewAppState(db, bus)
-	consensusCfg := config.GetDefaultConsensusConfig()
-	consensusCfg.Automine = true
-	cfg := &config.Config{
-		Network:   0x99,
-		Consensus: consensusCfg,
-		GenesisConf: &config.GenesisConf{
-			Alloc:             nil,
-			GodAddress:        chain.secStore.GetAddress(),
-			FirstCeremonyTime: 4070908800, //01.01.2099
-		},
-		Validation:       &config.ValidationConfig{},
-		Blockchain:       &config.BlockchainConfig{},
-		OfflineDetection: config.GetDefaultOfflineDetectionConfig(),
-	}
-	txPool := mempool.NewTxPool(appState, bus, config.GetDefaultMempoolConfig())
+	cfg := NewTestConfig(chain.secStore.GetAddress(), nil)
+	cfg.OfflineDetection = config.GetDefaultOfflineDetectionConfig()
+	txPool := mempool.NewTxPool(appState, bus, config.GetDefaultMempoolConfig(), cfg.Consensus)
 	offline := NewOfflineDetector(cfg, db, appState, chain.secStore, bus)
 	keyStore := keystore.NewKeyStore("./testdata", keystore.StandardScryptN, keystore.StandardScryptP)
 
-	copy := NewBlockchain(cfg, db, txPool, appState, ipfs.NewMemoryIpfsProxy(), chain.secStore, bus, offline, keyStore)
+	copy, err := NewBlockchain(cfg, db, WithRequiredDefaults(txPool, appState, ipfs.NewMemoryIpfsProxy(), chain.secStore, bus, offline, keyStore))
+	if err != nil {
+		panic(err)
+	}
 	copy.InitializeChain()
-	appState.Initialize(copy.Head.Height())
+	appState.Initialize(copy.GetCurrentHead().Height())
 	return &TestBlockchain{db, copy}, appState
 }
 
@@ -177,7 +181,7 @@ func (chain *TestBlockchain) addCert(block *types.Block) {
 func (chain *TestBlockchain) GenerateBlocks(count int) *TestBlockchain {
 	for i := 0; i < count; i++ {
 		block := chain.ProposeBlock([]byte{})
-		block.Block.Header.ProposedHeader.Time = chain.Head.Time() + 20
+		block.Block.Header.ProposedHeader.Time = chain.GetCurrentHead().Time() + 20
 		err := chain.AddBlock(block.Block, nil, collector.NewStatsCollector())
 		if err != nil {
 			panic(err)
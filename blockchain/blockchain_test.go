@@ -1,19 +1,38 @@
 package blockchain
 
 import (
+	"bytes"
+	"crypto/ecdsa"
+	"encoding/json"
+	"fmt"
 	"github.com/idena-network/idena-go/blockchain/attachments"
 	fee2 "github.com/idena-network/idena-go/blockchain/fee"
 	"github.com/idena-network/idena-go/blockchain/types"
 	"github.com/idena-network/idena-go/blockchain/validation"
 	"github.com/idena-network/idena-go/common"
+	"github.com/idena-network/idena-go/common/eventbus"
 	"github.com/idena-network/idena-go/common/math"
 	"github.com/idena-network/idena-go/config"
+	"github.com/idena-network/idena-go/core/appstate"
+	"github.com/idena-network/idena-go/core/mempool"
 	"github.com/idena-network/idena-go/core/state"
+	"github.com/idena-network/idena-go/core/validators"
 	"github.com/idena-network/idena-go/crypto"
+	"github.com/idena-network/idena-go/crypto/vrf"
+	"github.com/idena-network/idena-go/crypto/vrf/p256"
+	"github.com/idena-network/idena-go/ipfs"
+	"github.com/idena-network/idena-go/keystore"
+	"github.com/idena-network/idena-go/rlp"
+	"github.com/idena-network/idena-go/secstore"
+	"github.com/idena-network/idena-go/stats/collector"
 	"github.com/idena-network/idena-go/tests"
+	"github.com/pkg/errors"
 	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
 	"math/big"
+	"sort"
+	"sync/atomic"
 	"testing"
 	"time"
 )
@@ -23,7 +42,7 @@ func Test_ApplyBlockRewards(t *testing.T) {
 
 	header := &types.ProposedHeader{
 		Height:         2,
-		ParentHash:     chain.Head.Hash(),
+		ParentHash:     chain.GetCurrentHead().Hash(),
 		Time:           time.Now().UTC().Unix(),
 		ProposerPubKey: chain.pubKey,
 		TxHash:         types.DeriveSha(types.Transactions([]*types.Transaction{})),
@@ -42,7 +61,7 @@ func Test_ApplyBlockRewards(t *testing.T) {
 	tips := new(big.Int).Mul(big.NewInt(1e+18), big.NewInt(10))
 
 	appState, _ := chain.appState.ForCheck(1)
-	chain.applyBlockRewards(fee, tips, appState, block, chain.Head, nil)
+	chain.applyBlockRewards(fee, tips, appState, block, chain.GetCurrentHead(), nil)
 
 	burnFee := decimal.NewFromBigInt(fee, 0)
 	coef := decimal.NewFromFloat32(0.9)
@@ -62,8 +81,50 @@ func Test_ApplyBlockRewards(t *testing.T) {
 	require.Equal(t, 0, stake.Cmp(appState.State.GetStakeBalance(chain.coinBaseAddress)))
 }
 
+func Test_rewardFinalCommitteeOfflineMember(t *testing.T) {
+	require := require.New(t)
+	member := tests.GetRandAddr()
+	alloc := map[common.Address]config.GenesisAllocation{
+		member: {State: uint8(state.Verified)},
+	}
+	chain, appState, _, _ := NewTestBlockchain(false, alloc)
+	appState.IdentityState.SetOnline(member, true)
+	appState.IdentityState.Commit(false)
+	appState.ValidatorsCache.Load()
+	// the member goes offline after the committee is selected
+	appState.IdentityState.SetOnline(member, false)
+	appState.State.SetCommitteeRewardPool(big.NewInt(1e+18))
+
+	block := &types.Block{
+		Header: &types.Header{
+			ProposedHeader: &types.ProposedHeader{
+				Height:     2,
+				ParentHash: chain.GetCurrentHead().Hash(),
+			},
+		},
+		Body: &types.Body{},
+	}
+
+	// pre-fork blocks credit the offline member and keep the pool
+	chain.config.Consensus.ForkHeight = config.ForkNotScheduled
+	chain.rewardFinalCommittee(appState, block, chain.GetCurrentHead(), nil)
+	expectedBalance, expectedStake := splitReward(chain.config.Consensus.FinalCommitteeReward, false, chain.config.Consensus)
+	require.Equal(expectedBalance, appState.State.GetBalance(member))
+	require.Equal(expectedStake, appState.State.GetStakeBalance(member))
+	require.Zero(appState.State.GetPendingReward(member).Sign())
+	require.Equal(big.NewInt(1e+18), appState.State.CommitteeRewardPool())
+
+	// after the fork the reward of the offline member becomes pending and the pool is shared
+	chain.config.Consensus.ForkHeight = 0
+	chain.rewardFinalCommittee(appState, block, chain.GetCurrentHead(), nil)
+	require.Equal(expectedBalance, appState.State.GetBalance(member))
+	require.Equal(new(big.Int).Add(chain.config.Consensus.FinalCommitteeReward, big.NewInt(1e+18)), appState.State.GetPendingReward(member))
+	require.Zero(appState.State.CommitteeRewardPool().Sign())
+}
+
 func Test_ApplyInviteTx(t *testing.T) {
 	chain, _, _, _ := NewTestBlockchain(false, nil)
+	chain.config.Consensus.InviteExpiryBlocks = 100
 	stateDb := chain.appState.State
 
 	key, _ := crypto.GenerateKey()
@@ -93,6 +154,109 @@ func Test_ApplyInviteTx(t *testing.T) {
 	require.Equal(t, uint8(0), stateDb.GetInvites(addr))
 	require.Equal(t, state.Invite, stateDb.GetIdentityState(receiver))
 	require.Equal(t, -1, big.NewInt(0).Cmp(stateDb.GetBalance(receiver)))
+	require.Equal(t, &state.TxAddr{TxHash: signed.Hash(), Address: addr}, stateDb.GetInviter(receiver))
+	require.Equal(t, chain.GetCurrentHead().Height()+1, stateDb.GetIdentity(receiver).CreatedAtBlock)
+	require.Equal(t, big.NewInt(1e+18), stateDb.GetInviteAmount(receiver))
+
+	// invitation data is not recorded before the fork
+	chain.config.Consensus.ForkHeight = config.ForkNotScheduled
+	receiver2 := tests.GetRandAddr()
+	id.AddInvite(1)
+	tx = &types.Transaction{
+		Type:         types.InviteTx,
+		Amount:       big.NewInt(1e+18),
+		AccountNonce: 2,
+		To:           &receiver2,
+	}
+	signed, _ = types.SignTx(tx, key)
+	_, err := chain.ApplyTxOnState(chain.appState, signed, nil)
+	require.NoError(t, err)
+	require.Equal(t, state.Invite, stateDb.GetIdentityState(receiver2))
+	require.Zero(t, stateDb.GetIdentity(receiver2).CreatedAtBlock)
+	require.Nil(t, stateDb.GetInviteAmount(receiver2))
+}
+
+func Test_ApplyInviteTxWithInvitationCost(t *testing.T) {
+	require := require.New(t)
+	inviterKey, _ := crypto.GenerateKey()
+	inviter := crypto.PubkeyToAddress(inviterKey.PublicKey)
+	alloc := map[common.Address]config.GenesisAllocation{
+		inviter: {
+			State:   uint8(state.Verified),
+			Balance: new(big.Int).Mul(common.DnaBase, big.NewInt(100)),
+		},
+	}
+	chain, appState, _, _ := NewTestBlockchain(true, alloc)
+	chain.config.Consensus.InviteBaseCoef = 1
+	stateDb := appState.State
+	stateDb.AddInvite(inviter, 1)
+
+	invitationCost := chain.getInvitationCost(stateDb.Epoch())
+	require.Equal(1, invitationCost.Sign())
+
+	receiver := tests.GetRandAddr()
+	tx := &types.Transaction{
+		Type:         types.InviteTx,
+		Amount:       big.NewInt(1e+18),
+		AccountNonce: 1,
+		To:           &receiver,
+	}
+	signed, _ := types.SignTx(tx, inviterKey)
+	balance := stateDb.GetBalance(inviter)
+	_, burntBefore := stateDb.SupplyChange()
+
+	_, err := chain.ApplyTxOnState(appState, signed, nil)
+	require.NoError(err)
+
+	// invitation cost is charged to the inviter and burnt, the invitee receives only the amount
+	require.Equal(new(big.Int).Sub(balance, new(big.Int).Add(tx.Amount, invitationCost)), stateDb.GetBalance(inviter))
+	require.Equal(big.NewInt(1e+18), stateDb.GetBalance(receiver))
+	_, burntAfter := stateDb.SupplyChange()
+	require.Equal(invitationCost, new(big.Int).Sub(burntAfter, burntBefore))
+}
+
+func Test_removeExpiredInvites(t *testing.T) {
+	require := require.New(t)
+	_, appState, _, _ := NewTestBlockchain(false, nil)
+	stateDb := appState.State
+
+	inviter := tests.GetRandAddr()
+	stateDb.SetState(inviter, state.Verified)
+	invite := func(createdAt uint64, identityState state.IdentityState) common.Address {
+		addr := tests.GetRandAddr()
+		stateDb.SetState(addr, identityState)
+		stateDb.SetBalance(addr, big.NewInt(5))
+		stateDb.SetInviteAmount(addr, big.NewInt(3))
+		stateDb.SetInviter(addr, inviter, common.Hash{0x1})
+		stateDb.AddInvitee(inviter, addr, common.Hash{0x1})
+		stateDb.SetCreatedAtBlock(addr, createdAt)
+		return addr
+	}
+	expired := invite(10, state.Invite)
+	fresh := invite(150, state.Invite)
+	legacy := invite(0, state.Invite)
+	candidate := invite(10, state.Candidate)
+	require.NoError(appState.Commit(nil))
+
+	require.Equal(1, removeExpiredInvites(stateDb, 200, 100))
+
+	require.Equal(state.Killed, stateDb.GetIdentityState(expired))
+	require.Equal(0, big.NewInt(2).Cmp(stateDb.GetBalance(expired)))
+	require.Nil(stateDb.GetInviter(expired))
+	require.Equal(0, big.NewInt(3).Cmp(stateDb.GetBalance(inviter)))
+	require.Len(stateDb.GetInvitees(inviter), 3)
+
+	for _, addr := range []common.Address{fresh, legacy} {
+		require.Equal(state.Invite, stateDb.GetIdentityState(addr))
+		require.Equal(0, big.NewInt(5).Cmp(stateDb.GetBalance(addr)))
+	}
+	require.Equal(state.Candidate, stateDb.GetIdentityState(candidate))
+
+	require.NoError(appState.Commit(nil))
+	require.Equal(1, removeExpiredInvites(stateDb, 251, 100))
+	require.Equal(state.Killed, stateDb.GetIdentityState(fresh))
+	require.Equal(state.Candidate, stateDb.GetIdentityState(candidate))
+	require.Equal(0, big.NewInt(6).Cmp(stateDb.GetBalance(inviter)))
 }
 
 func Test_ApplyActivateTx(t *testing.T) {
@@ -128,6 +292,66 @@ func Test_ApplyActivateTx(t *testing.T) {
 	require.Equal(t, -1, big.NewInt(0).Cmp(appState.State.GetBalance(receiver)))
 }
 
+func Test_ApplyActivateTxToExistingIdentity(t *testing.T) {
+	require := require.New(t)
+	chain, appState, _, _ := NewTestBlockchain(false, nil)
+
+	for _, recipientState := range []state.IdentityState{state.Verified, state.Killed, state.Candidate} {
+		key, _ := crypto.GenerateKey()
+		sender := crypto.PubkeyToAddress(key.PublicKey)
+		receiver := tests.GetRandAddr()
+		balance := new(big.Int).Mul(big.NewInt(100), common.DnaBase)
+		appState.State.SetBalance(sender, balance)
+		appState.State.SetState(sender, state.Invite)
+		appState.State.SetState(receiver, recipientState)
+
+		tx := &types.Transaction{
+			Type:         types.ActivationTx,
+			Amount:       big.NewInt(0),
+			AccountNonce: 1,
+			To:           &receiver,
+		}
+		signed, _ := types.SignTx(tx, key)
+
+		_, err := chain.ApplyTxOnState(appState, signed, nil)
+		require.Equal(ErrInvalidActivationTarget, err)
+		require.Equal(recipientState, appState.State.GetIdentityState(receiver))
+		require.Equal(state.Invite, appState.State.GetIdentityState(sender))
+		require.Equal(balance, appState.State.GetBalance(sender))
+	}
+}
+
+func Test_ApplyActivateTxWithRefundTo(t *testing.T) {
+	chain, appState, _, _ := NewTestBlockchain(false, nil)
+
+	key, _ := crypto.GenerateKey()
+	key2, _ := crypto.GenerateKey()
+	sender := crypto.PubkeyToAddress(key.PublicKey)
+	receiver := crypto.PubkeyToAddress(key2.PublicKey)
+	refundTo := tests.GetRandAddr()
+
+	balance := new(big.Int).Mul(big.NewInt(200000), common.DnaBase)
+	appState.State.SetBalance(sender, balance)
+	appState.State.SetState(sender, state.Invite)
+	appState.State.SetState(refundTo, state.Verified)
+
+	tx := &types.Transaction{
+		Type:         types.ActivationTx,
+		Amount:       big.NewInt(0),
+		AccountNonce: 1,
+		To:           &receiver,
+		RefundTo:     &refundTo,
+	}
+	signed, _ := types.SignTx(tx, key)
+
+	chain.ApplyTxOnState(chain.appState, signed, nil)
+	require.Equal(t, state.Killed, appState.State.GetIdentityState(sender))
+	require.Equal(t, 0, big.NewInt(0).Cmp(appState.State.GetBalance(sender)))
+	require.Equal(t, state.Candidate, appState.State.GetIdentityState(receiver))
+	require.Equal(t, 0, big.NewInt(0).Cmp(appState.State.GetBalance(receiver)))
+	require.Equal(t, 0, balance.Cmp(appState.State.GetBalance(refundTo)))
+}
+
 func Test_ApplyKillTx(t *testing.T) {
 	require := require.New(t)
 	chain, appState, _, _ := NewTestBlockchain(true, nil)
@@ -160,7 +384,7 @@ func Test_ApplyKillTx(t *testing.T) {
 	signed, _ := types.SignTx(tx, key)
 
 	chain.appState.State.SetFeePerByte(new(big.Int).Div(big.NewInt(1e+18), big.NewInt(1000)))
-	fee := fee2.CalculateFee(chain.appState.ValidatorsCache.NetworkSize(), chain.appState.State.FeePerByte(), tx)
+	fee := fee2.CalculateFee(chain.appState.ValidatorsCache.NetworkSize(), chain.appState.State.FeePerByte(), tx, true)
 
 	chain.ApplyTxOnState(chain.appState, signed, nil)
 
@@ -202,13 +426,68 @@ func Test_ApplyDoubleKillTx(t *testing.T) {
 	signedTx2, _ := types.SignTx(tx2, key)
 
 	chain.appState.State.SetFeePerByte(fee2.MinFeePerByte)
-	require.Nil(validation.ValidateTx(chain.appState, signedTx1, fee2.MinFeePerByte, validation.InBlockTx))
-	require.Nil(validation.ValidateTx(chain.appState, signedTx2, fee2.MinFeePerByte, validation.InBlockTx))
+	require.Nil(validation.ValidateTx(chain.appState, signedTx1, fee2.MinFeePerByte, validation.InBlockTx, chain.config.Consensus))
+	require.Nil(validation.ValidateTx(chain.appState, signedTx2, fee2.MinFeePerByte, validation.InBlockTx, chain.config.Consensus))
 
 	_, err := chain.ApplyTxOnState(chain.appState, signedTx1, nil)
 
 	require.Nil(err)
-	require.Equal(validation.InsufficientFunds, validation.ValidateTx(chain.appState, signedTx2, fee2.MinFeePerByte, validation.InBlockTx))
+	require.Equal(validation.InsufficientFunds, validation.ValidateTx(chain.appState, signedTx2, fee2.MinFeePerByte, validation.InBlockTx, chain.config.Consensus))
+}
+
+func Test_ApplyTxMaxFee(t *testing.T) {
+	require := require.New(t)
+	chain, appState, _, key := NewTestBlockchain(true, nil)
+	sender := crypto.PubkeyToAddress(key.PublicKey)
+	receiver := tests.GetRandAddr()
+	appState.State.SetBalance(sender, new(big.Int).Mul(common.DnaBase, big.NewInt(10)))
+	// current fee is above the minimal one, so max fee between them is valid but too low to be applied
+	appState.State.SetFeePerByte(new(big.Int).Mul(fee2.MinFeePerByte, big.NewInt(2)))
+
+	buildTx := func(nonce uint32, maxFee *big.Int) *types.Transaction {
+		tx := &types.Transaction{
+			AccountNonce: nonce,
+			Type:         types.SendTx,
+			To:           &receiver,
+			Amount:       big.NewInt(1),
+			MaxFee:       maxFee,
+		}
+		signedTx, _ := types.SignTx(tx, key)
+		return signedTx
+	}
+	calculateFee := func(tx *types.Transaction) *big.Int {
+		return fee2.CalculateFee(appState.ValidatorsCache.NetworkSize(), appState.State.FeePerByte(), tx, true)
+	}
+	// max fee is a part of the tx, so its size affects the fee
+	exactFee := calculateFee(buildTx(1, nil))
+	for calculateFee(buildTx(1, exactFee)).Cmp(exactFee) != 0 {
+		exactFee = calculateFee(buildTx(1, exactFee))
+	}
+	require.True(exactFee.Sign() > 0)
+	lowMaxFee := new(big.Int).Sub(exactFee, big.NewInt(1))
+	require.Equal(exactFee, calculateFee(buildTx(1, lowMaxFee)))
+
+	require.Equal(validation.BigFee, validation.ValidateTx(appState, buildTx(1, lowMaxFee), fee2.MinFeePerByte, validation.InBlockTx, chain.config.Consensus))
+	require.Nil(validation.ValidateTx(appState, buildTx(1, exactFee), fee2.MinFeePerByte, validation.InBlockTx, chain.config.Consensus))
+	require.Nil(validation.ValidateTx(appState, buildTx(1, nil), fee2.MinFeePerByte, validation.InBlockTx, chain.config.Consensus))
+
+	_, err := chain.ApplyTxOnState(appState, buildTx(1, lowMaxFee), nil)
+	require.Equal(ErrFeeExceedsMaxFee, err)
+	require.Zero(appState.State.GetNonce(sender))
+
+	appliedFee, err := chain.ApplyTxOnState(appState, buildTx(1, exactFee), nil)
+	require.NoError(err)
+	require.Equal(exactFee, appliedFee)
+
+	appState.State.SetFeePerByte(new(big.Int).Mul(fee2.MinFeePerByte, big.NewInt(1000)))
+	_, err = chain.ApplyTxOnState(appState, buildTx(2, nil), nil)
+	require.NoError(err)
+
+	// nil max fee was zero before the fork and max fee wasn't checked on applying
+	chain.config.Consensus.ForkHeight = config.ForkNotScheduled
+	require.Equal(validation.InvalidMaxFee, validation.ValidateTx(appState, buildTx(3, nil), fee2.MinFeePerByte, validation.InBlockTx, chain.config.Consensus))
+	_, err = chain.ApplyTxOnState(appState, buildTx(3, lowMaxFee), nil)
+	require.NoError(err)
 }
 
 func Test_ApplyKillInviteeTx(t *testing.T) {
@@ -239,7 +518,7 @@ func Test_ApplyKillInviteeTx(t *testing.T) {
 	signedTx, _ := types.SignTx(tx, inviterKey)
 
 	chain.appState.State.SetFeePerByte(new(big.Int).Div(big.NewInt(1e+18), big.NewInt(1000)))
-	fee := fee2.CalculateFee(chain.appState.ValidatorsCache.NetworkSize(), chain.appState.State.FeePerByte(), tx)
+	fee := fee2.CalculateFee(chain.appState.ValidatorsCache.NetworkSize(), chain.appState.State.FeePerByte(), tx, true)
 
 	chain.ApplyTxOnState(chain.appState, signedTx, nil)
 
@@ -254,6 +533,74 @@ func Test_ApplyKillInviteeTx(t *testing.T) {
 	require.Nil(t, appState.State.GetInviter(invitee))
 }
 
+func Test_ApplyKillInviteeTxForInvite(t *testing.T) {
+	chain, appState, _, _ := NewTestBlockchain(true, nil)
+
+	inviterKey, _ := crypto.GenerateKey()
+	inviter := crypto.PubkeyToAddress(inviterKey.PublicKey)
+	invitee := tests.GetRandAddr()
+
+	appState.State.SetInviter(invitee, inviter, common.Hash{})
+	appState.State.AddInvitee(inviter, invitee, common.Hash{})
+	appState.State.SetState(inviter, state.Killed)
+	appState.State.SetState(invitee, state.Invite)
+
+	appState.State.GetOrNewAccountObject(inviter).SetBalance(new(big.Int).Mul(big.NewInt(50), common.DnaBase))
+	appState.State.GetOrNewAccountObject(invitee).SetBalance(new(big.Int).Mul(big.NewInt(5), common.DnaBase))
+	// coins sent to the invitee by others are not refunded
+	appState.State.SetInviteAmount(invitee, new(big.Int).Mul(big.NewInt(3), common.DnaBase))
+
+	tx := &types.Transaction{
+		Type:         types.KillInviteeTx,
+		AccountNonce: 1,
+		To:           &invitee,
+	}
+	signedTx, _ := types.SignTx(tx, inviterKey)
+
+	chain.appState.State.SetFeePerByte(new(big.Int).Div(big.NewInt(1e+18), big.NewInt(1000)))
+	require.Zero(t, fee2.CalculateFee(chain.appState.ValidatorsCache.NetworkSize(), chain.appState.State.FeePerByte(), tx, true).Sign())
+
+	_, err := chain.ApplyTxOnState(chain.appState, signedTx, nil)
+	require.Nil(t, err)
+
+	require.Equal(t, new(big.Int).Mul(big.NewInt(53), common.DnaBase), appState.State.GetBalance(inviter))
+	require.Equal(t, new(big.Int).Mul(big.NewInt(2), common.DnaBase), appState.State.GetBalance(invitee))
+	require.Equal(t, state.Killed, appState.State.GetIdentityState(invitee))
+	require.Equal(t, uint8(0), appState.State.GetInvites(inviter))
+}
+
+func Test_ApplyKillInviteeTxForInviteBeforeFork(t *testing.T) {
+	chain, appState, _, _ := NewTestBlockchain(true, nil)
+	chain.config.Consensus.ForkHeight = config.ForkNotScheduled
+
+	inviterKey, _ := crypto.GenerateKey()
+	inviter := crypto.PubkeyToAddress(inviterKey.PublicKey)
+	invitee := tests.GetRandAddr()
+
+	appState.State.SetInviter(invitee, inviter, common.Hash{})
+	appState.State.AddInvitee(inviter, invitee, common.Hash{})
+	appState.State.SetState(invitee, state.Invite)
+
+	appState.State.GetOrNewAccountObject(inviter).SetBalance(new(big.Int).Mul(big.NewInt(50), common.DnaBase))
+	appState.State.GetOrNewAccountObject(invitee).SetBalance(new(big.Int).Mul(big.NewInt(5), common.DnaBase))
+	appState.State.SetInviteAmount(invitee, new(big.Int).Mul(big.NewInt(3), common.DnaBase))
+
+	tx := &types.Transaction{
+		Type:         types.KillInviteeTx,
+		AccountNonce: 1,
+		To:           &invitee,
+	}
+	signedTx, _ := types.SignTx(tx, inviterKey)
+
+	_, err := chain.ApplyTxOnState(chain.appState, signedTx, nil)
+	require.Nil(t, err)
+
+	// invitations are not refunded before the fork
+	require.Equal(t, new(big.Int).Mul(big.NewInt(50), common.DnaBase), appState.State.GetBalance(inviter))
+	require.Equal(t, new(big.Int).Mul(big.NewInt(5), common.DnaBase), appState.State.GetBalance(invitee))
+	require.Equal(t, state.Killed, appState.State.GetIdentityState(invitee))
+}
+
 type testCase struct {
 	data     []*big.Int
 	expected []*big.Int
@@ -336,6 +683,75 @@ func Test_applyVrfProposerThreshold(t *testing.T) {
 	require.Equal(t, 0.5, chain.appState.State.VrfProposerThreshold())
 }
 
+func TestBlockchain_BroadcastTx(t *testing.T) {
+	require := require.New(t)
+	senderKey, _ := crypto.GenerateKey()
+	alloc := map[common.Address]config.GenesisAllocation{
+		crypto.PubkeyToAddress(senderKey.PublicKey): {Balance: new(big.Int).Mul(common.DnaBase, big.NewInt(1000))},
+	}
+	chain, _, pool, key := NewTestBlockchain(true, alloc)
+	to := tests.GetRandAddr()
+
+	buildTx := func() *types.Transaction {
+		return &types.Transaction{
+			AccountNonce: 1,
+			Type:         types.SendTx,
+			To:           &to,
+			Amount:       big.NewInt(1),
+			MaxFee:       new(big.Int).Mul(common.DnaBase, big.NewInt(100)),
+		}
+	}
+
+	_, err := chain.BroadcastTx(buildTx())
+	require.Equal(types.ErrInvalidTxSignature, err)
+
+	poorTx, _ := types.SignTx(buildTx(), key)
+	_, err = chain.BroadcastTx(poorTx)
+	require.Equal(validation.InsufficientFunds, err)
+	require.Nil(pool.GetTx(poorTx.Hash()))
+
+	signedTx, _ := types.SignTx(buildTx(), senderKey)
+	hash, err := chain.BroadcastTx(signedTx)
+	require.NoError(err)
+	require.Equal(signedTx.Hash(), hash)
+	require.Equal(signedTx, pool.GetTx(hash))
+}
+
+func Test_ComputeVRFThreshold(t *testing.T) {
+	require := require.New(t)
+	chain, _ := NewTestBlockchainWithBlocks(100, 0)
+	fullHeight := chain.GetCurrentHead().Height()
+
+	require.Zero(chain.appState.State.RecentEmptyBlocksCount())
+	require.Equal(0.5*1.05, chain.ComputeVRFThreshold(fullHeight+1))
+
+	chain.GenerateEmptyBlocks(2)
+	require.Equal(2, chain.appState.State.RecentEmptyBlocksCount())
+	require.Equal(0.5, chain.ComputeVRFThreshold(chain.GetCurrentHead().Height()+1))
+
+	chain.GenerateEmptyBlocks(8)
+	require.Equal(10, chain.appState.State.RecentEmptyBlocksCount())
+	require.Equal(0.5*0.9, chain.ComputeVRFThreshold(chain.GetCurrentHead().Height()+1))
+	require.Equal(0.5*1.05, chain.ComputeVRFThreshold(fullHeight+1))
+
+	// empty blocks leave the window after state.RecentBlocksWindow blocks
+	chain.GenerateBlocks(state.RecentBlocksWindow - 5)
+	require.Equal(5, chain.appState.State.RecentEmptyBlocksCount())
+	require.Equal(0.5, chain.ComputeVRFThreshold(chain.GetCurrentHead().Height()+1))
+	chain.GenerateBlocks(5)
+	require.Zero(chain.appState.State.RecentEmptyBlocksCount())
+
+	// the threshold is not adapted before the fork
+	chain.config.Consensus.ForkHeight = config.ForkNotScheduled
+	chain.GenerateEmptyBlocks(1)
+	require.Zero(chain.appState.State.RecentEmptyBlocksCount())
+	require.Equal(0.5, chain.ComputeVRFThreshold(chain.GetCurrentHead().Height()+1))
+
+	require.Equal(maxAdaptiveVrfThreshold, adaptVrfThreshold(0.99, 0))
+	require.Equal(minAdaptiveVrfThreshold, adaptVrfThreshold(0.001, 6))
+	require.Equal(0.7, adaptVrfThreshold(0.7, 3))
+}
+
 type txWithTimestamp struct {
 	tx        *types.Transaction
 	timestamp int64
@@ -408,7 +824,7 @@ func Test_ApplyBurnTx(t *testing.T) {
 
 	appState := chain.appState
 	appState.State.SetFeePerByte(new(big.Int).Div(common.DnaBase, big.NewInt(1000)))
-	fee := fee2.CalculateFee(appState.ValidatorsCache.NetworkSize(), appState.State.FeePerByte(), tx)
+	fee := fee2.CalculateFee(appState.ValidatorsCache.NetworkSize(), appState.State.FeePerByte(), tx, true)
 	expectedBalance := new(big.Int).Mul(big.NewInt(89), common.DnaBase)
 	expectedBalance.Sub(expectedBalance, fee)
 
@@ -446,7 +862,7 @@ func Test_DeleteFlipTx(t *testing.T) {
 	appState.State.AddFlip(sender, []byte{0x1, 0x2, 0x4}, 2)
 	appState.State.SetFeePerByte(big.NewInt(1))
 
-	fee := fee2.CalculateFee(appState.ValidatorsCache.NetworkSize(), appState.State.FeePerByte(), tx)
+	fee := fee2.CalculateFee(appState.ValidatorsCache.NetworkSize(), appState.State.FeePerByte(), tx, true)
 	expectedBalance := big.NewInt(999_990)
 	expectedBalance.Sub(expectedBalance, fee)
 
@@ -462,25 +878,13 @@ func Test_Blockchain_OnlineStatusSwitch(t *testing.T) {
 	require := require.New(t)
 	key, _ := crypto.GenerateKey()
 	addr := crypto.PubkeyToAddress(key.PublicKey)
-	consensusCfg := config.GetDefaultConsensusConfig()
-	consensusCfg.Automine = true
-	consensusCfg.StatusSwitchRange = 10
-	cfg := &config.Config{
-		Network:   0x99,
-		Consensus: consensusCfg,
-		GenesisConf: &config.GenesisConf{
-			Alloc: map[common.Address]config.GenesisAllocation{
-				addr: {
-					State:   uint8(state.Verified),
-					Balance: new(big.Int).Mul(big.NewInt(1e+18), big.NewInt(100)),
-				},
-			},
-			GodAddress:        addr,
-			FirstCeremonyTime: 4070908800, //01.01.2099
+	cfg := NewTestConfig(addr, map[common.Address]config.GenesisAllocation{
+		addr: {
+			State:   uint8(state.Verified),
+			Balance: new(big.Int).Mul(big.NewInt(1e+18), big.NewInt(100)),
 		},
-		Validation: &config.ValidationConfig{},
-		Blockchain: &config.BlockchainConfig{},
-	}
+	})
+	cfg.Consensus.StatusSwitchRange = 10
 	chain, state := NewCustomTestBlockchainWithConfig(5, 0, key, cfg)
 
 	//  add pending request to switch online
@@ -500,10 +904,10 @@ func Test_Blockchain_OnlineStatusSwitch(t *testing.T) {
 
 	// switch status to online
 	chain.GenerateBlocks(3)
-	require.Equal(uint64(10), chain.Head.Height())
+	require.Equal(uint64(10), chain.GetCurrentHead().Height())
 	require.Zero(len(state.State.StatusSwitchAddresses()))
 	require.True(state.IdentityState.IsOnline(addr))
-	require.True(chain.Head.Flags().HasFlag(types.IdentityUpdate))
+	require.True(chain.GetCurrentHead().Flags().HasFlag(types.IdentityUpdate))
 
 	// fail to switch online again
 	chain.GenerateBlocks(5)
@@ -519,10 +923,10 @@ func Test_Blockchain_OnlineStatusSwitch(t *testing.T) {
 
 	// switch status to offline
 	chain.GenerateBlocks(1)
-	require.Equal(uint64(20), chain.Head.Height())
+	require.Equal(uint64(20), chain.GetCurrentHead().Height())
 	require.Zero(len(state.State.StatusSwitchAddresses()))
 	require.False(state.IdentityState.IsOnline(addr))
-	require.True(chain.Head.Flags().HasFlag(types.IdentityUpdate))
+	require.True(chain.GetCurrentHead().Flags().HasFlag(types.IdentityUpdate))
 
 	// add pending request to switch offline
 	tx, _ = chain.secStore.SignTx(BuildTx(state, addr, nil, types.OnlineStatusTx, decimal.Zero, decimal.New(20, 0), decimal.Zero, 0, 0, attachments.CreateOnlineStatusAttachment(true)))
@@ -542,37 +946,27 @@ func Test_Blockchain_OnlineStatusSwitch(t *testing.T) {
 
 	// 30th block should not update identity statuses, no pending requests
 	chain.GenerateBlocks(8)
-	require.Equal(uint64(30), chain.Head.Height())
+	require.Equal(uint64(30), chain.GetCurrentHead().Height())
 	require.False(state.IdentityState.IsOnline(addr))
-	require.False(chain.Head.Flags().HasFlag(types.IdentityUpdate))
+	require.False(chain.GetCurrentHead().Flags().HasFlag(types.IdentityUpdate))
 
 	chain.GenerateBlocks(70)
-	require.Equal(uint64(100), chain.Head.Height())
+	require.Equal(uint64(100), chain.GetCurrentHead().Height())
 }
 
 func Test_ApplySubmitCeremonyTxs(t *testing.T) {
 	key, _ := crypto.GenerateKey()
 	addr := crypto.PubkeyToAddress(key.PublicKey)
-	consensusCfg := config.GetDefaultConsensusConfig()
-	consensusCfg.Automine = true
-	consensusCfg.StatusSwitchRange = 10
-	cfg := &config.Config{
-		Network:   0x99,
-		Consensus: consensusCfg,
-		GenesisConf: &config.GenesisConf{
-			Alloc: map[common.Address]config.GenesisAllocation{
-				addr: {
-					State: uint8(state.Verified),
-				},
-			},
-			GodAddress:        addr,
-			FirstCeremonyTime: 1999999999,
+	cfg := NewTestConfig(addr, map[common.Address]config.GenesisAllocation{
+		addr: {
+			State: uint8(state.Verified),
 		},
-		Validation: &config.ValidationConfig{
-			ShortSessionDuration: 1 * time.Second,
-			LongSessionDuration:  1 * time.Second,
-		},
-		Blockchain: &config.BlockchainConfig{},
+	})
+	cfg.Consensus.StatusSwitchRange = 10
+	cfg.GenesisConf.FirstCeremonyTime = 1999999999
+	cfg.Validation = &config.ValidationConfig{
+		ShortSessionDuration: 1 * time.Second,
+		LongSessionDuration:  1 * time.Second,
 	}
 	chain, app := NewCustomTestBlockchainWithConfig(0, 0, key, cfg)
 
@@ -580,7 +974,7 @@ func Test_ApplySubmitCeremonyTxs(t *testing.T) {
 	app.Commit(nil)
 
 	block := chain.GenerateEmptyBlock()
-	chain.Head = block.Header
+	chain.SetCurrentHead(block.Header)
 	chain.txpool.ResetTo(block)
 
 	tx := &types.Transaction{
@@ -756,3 +1150,2291 @@ func Test_ClearDustAccounts(t *testing.T) {
 	require.False(s.State.AccountExists(common.Address{0x7}))
 	require.True(s.State.AccountExists(common.Address{0x8}))
 }
+
+func Test_MaxBlockTransactions(t *testing.T) {
+	require := require.New(t)
+	conf := config.GetDefaultConsensusConfig()
+	conf.Automine = true
+	conf.MaxBlockTransactions = 2
+	conf.ForkHeight = 0
+	key, _ := crypto.GenerateKey()
+	addr := crypto.PubkeyToAddress(key.PublicKey)
+	alloc := map[common.Address]config.GenesisAllocation{
+		addr: {Balance: new(big.Int).Mul(common.DnaBase, big.NewInt(100))},
+	}
+	chain, appState, pool, _ := NewTestBlockchainWithConfig(true, conf, &config.ValidationConfig{}, alloc, -1, -1, 0, 0)
+
+	var txs []*types.Transaction
+	for i := 1; i <= 3; i++ {
+		tx, _ := types.SignTx(BuildTx(appState, addr, &addr, types.SendTx, decimal.New(1, 0), decimal.New(20, 0), decimal.Zero, uint32(i), 0, nil), key)
+		require.NoError(pool.Add(tx))
+		txs = append(txs, tx)
+	}
+
+	proposal := chain.ProposeBlock([]byte{})
+	require.Len(proposal.Block.Body.Transactions, 2)
+	require.NoError(chain.ValidateBlock(proposal.Block, nil))
+
+	block := proposal.Block
+	block.Body.Transactions = txs
+	require.Equal(ErrBlockTxCountExceeded, chain.ValidateBlock(block, nil))
+
+	// blocks of other nodes weren't limited before the fork, the block fails later checks since it was built after the fork
+	conf.ForkHeight = config.ForkNotScheduled
+	require.NotEqual(ErrBlockTxCountExceeded, chain.ValidateBlock(block, nil))
+}
+
+func Test_BlockTxCapacity(t *testing.T) {
+	require := require.New(t)
+	key, _ := crypto.GenerateKey()
+	addr := crypto.PubkeyToAddress(key.PublicKey)
+	alloc := map[common.Address]config.GenesisAllocation{
+		addr: {Balance: new(big.Int).Mul(common.DnaBase, big.NewInt(1000))},
+	}
+	chain, appState, pool, _ := NewTestBlockchain(true, alloc)
+	capacity := types.MaxTransactions(appState.ValidatorsCache.NetworkSize())
+	require.Equal(100, capacity)
+
+	var txs []*types.Transaction
+	for i := 1; i <= capacity+1; i++ {
+		tx, _ := types.SignTx(BuildTx(appState, addr, &addr, types.SendTx, decimal.New(1, 0), decimal.New(20, 0), decimal.Zero, uint32(i), 0, nil), key)
+		require.NoError(pool.Add(tx))
+		txs = append(txs, tx)
+	}
+
+	proposal := chain.ProposeBlock([]byte{})
+	require.Len(proposal.Block.Body.Transactions, capacity)
+	require.NoError(chain.ValidateBlock(proposal.Block, nil))
+
+	block := proposal.Block
+	block.Body.Transactions = txs
+	require.Equal(ErrBlockTxCapacityExceeded, chain.ValidateBlock(block, nil))
+
+	chain.config.Consensus.ForkHeight = block.Height() + 1
+	block.Header.ProposedHeader.TxHash = types.DeriveSha(types.Transactions(txs))
+	block.Header.ProposedHeader.TxBloom = calculateTxBloom(block)
+	block.Header.ProposedHeader.BodyRoot = common.Hash{}
+	require.NotEqual(ErrBlockTxCapacityExceeded, chain.ValidateBlock(block, nil))
+}
+
+func Test_GetTransactionByHash(t *testing.T) {
+	require := require.New(t)
+	key, _ := crypto.GenerateKey()
+	addr := crypto.PubkeyToAddress(key.PublicKey)
+	cfg := NewTestConfig(addr, map[common.Address]config.GenesisAllocation{
+		addr: {
+			State:   uint8(state.Verified),
+			Balance: new(big.Int).Mul(common.DnaBase, big.NewInt(100)),
+		},
+	})
+	chain, appState := NewCustomTestBlockchainWithConfig(0, 0, key, cfg)
+	pool := chain.txpool
+
+	var txs []*types.Transaction
+	for i := 1; i <= 2; i++ {
+		tx, _ := types.SignTx(BuildTx(appState, addr, &addr, types.SendTx, decimal.New(1, 0), decimal.New(20, 0), decimal.Zero, uint32(i), 0, nil), key)
+		require.NoError(pool.Add(tx))
+		txs = append(txs, tx)
+	}
+	chain.GenerateBlocks(1)
+
+	tx, block, idx, err := chain.GetTransactionByHash(txs[1].Hash())
+	require.NoError(err)
+	require.Equal(txs[1].Hash(), tx.Hash())
+	require.Equal(chain.GetCurrentHead().Hash(), block.Hash())
+	require.Equal(1, idx)
+
+	tx, block, idx, err = chain.GetTransactionByHash(common.Hash{0x1})
+	require.Equal(ErrTxNotFound, err)
+	require.Nil(tx)
+	require.Nil(block)
+	require.Equal(-1, idx)
+
+	chain.repo.WriteTxIndex(txs[0].Hash(), &types.TransactionIndex{BlockHash: common.Hash{0x2}})
+	_, _, _, err = chain.GetTransactionByHash(txs[0].Hash())
+	require.Equal(ErrTxNotFound, err)
+
+	head := chain.GetCurrentHead().Height()
+	_, err = chain.RebuildTransactionIndex(head, head-1)
+	require.Equal(ErrHeightOutOfRange, err)
+	_, err = chain.RebuildTransactionIndex(1, head+1)
+	require.Equal(ErrHeightOutOfRange, err)
+
+	cnt, err := chain.RebuildTransactionIndex(1, head-1)
+	require.NoError(err)
+	require.Zero(cnt)
+	_, _, _, err = chain.GetTransactionByHash(txs[0].Hash())
+	require.Equal(ErrTxNotFound, err)
+
+	cnt, err = chain.RebuildTransactionIndex(0, head)
+	require.NoError(err)
+	require.Equal(2, cnt)
+	_, _, idx, err = chain.GetTransactionByHash(txs[0].Hash())
+	require.NoError(err)
+	require.Equal(0, idx)
+}
+
+func TestBlockchain_GetBlockRange(t *testing.T) {
+	chain, _ := NewTestBlockchainWithBlocks(10, 5)
+
+	blocks, err := chain.GetBlockRange(3, 12)
+	require.NoError(t, err)
+	require.Len(t, blocks, 10)
+	for i, block := range blocks {
+		require.Equal(t, uint64(3+i), block.Height())
+	}
+
+	_, err = chain.GetBlockRange(5, 4)
+	require.Error(t, err)
+
+	_, err = chain.GetBlockRange(15, 17)
+	require.Error(t, err)
+
+	_, err = chain.GetBlockRange(1, MaxBlockRange+1)
+	require.Error(t, err)
+}
+
+func TestBlockchain_ValidateTxBatch(t *testing.T) {
+	require := require.New(t)
+	key1, _ := crypto.GenerateKey()
+	key2, _ := crypto.GenerateKey()
+	sender1 := crypto.PubkeyToAddress(key1.PublicKey)
+	sender2 := crypto.PubkeyToAddress(key2.PublicKey)
+	balance := new(big.Int).Mul(common.DnaBase, big.NewInt(100))
+	alloc := map[common.Address]config.GenesisAllocation{
+		sender1: {Balance: balance},
+		sender2: {Balance: balance},
+	}
+	chain, appState, _, _ := NewTestBlockchain(true, alloc)
+	to := tests.GetRandAddr()
+
+	buildTx := func(key *ecdsa.PrivateKey, nonce uint32, amount int64) *types.Transaction {
+		tx, _ := types.SignTx(BuildTx(appState, crypto.PubkeyToAddress(key.PublicKey), &to, types.SendTx, decimal.New(amount, 0),
+			decimal.New(20, 0), decimal.Zero, nonce, 0, nil), key)
+		return tx
+	}
+
+	errs, err := chain.ValidateTxBatch(nil)
+	require.NoError(err)
+	require.Empty(errs)
+
+	errs, err = chain.ValidateTxBatch([]*types.Transaction{buildTx(key1, 1, 1), buildTx(key1, 2, 1)})
+	require.NoError(err)
+	require.Equal([]error{nil, nil}, errs)
+
+	errs, err = chain.ValidateTxBatch([]*types.Transaction{buildTx(key1, 1, 1), buildTx(key1, 1, 2)})
+	require.NoError(err)
+	require.Len(errs, 2)
+	require.Nil(errs[0])
+	require.Equal(validation.InvalidNonce, errors.Cause(errs[1]))
+
+	// the second tx of the first sender can't be afforded after the first one, other sender is not affected
+	errs, err = chain.ValidateTxBatch([]*types.Transaction{buildTx(key1, 1, 50), buildTx(key1, 2, 60), buildTx(key2, 1, 50)})
+	require.NoError(err)
+	require.Len(errs, 3)
+	require.Nil(errs[0])
+	require.Equal(validation.InsufficientFunds, errs[1])
+	require.Nil(errs[2])
+
+	// batch validation doesn't change the chain state
+	require.Equal(balance, appState.State.GetBalance(sender1))
+	require.Zero(appState.State.GetNonce(sender1))
+}
+
+func TestBlockchain_SimulateBlock(t *testing.T) {
+	require := require.New(t)
+	key, _ := crypto.GenerateKey()
+	addr := crypto.PubkeyToAddress(key.PublicKey)
+	senderKey, _ := crypto.GenerateKey()
+	sender := crypto.PubkeyToAddress(senderKey.PublicKey)
+	cfg := NewTestConfig(addr, map[common.Address]config.GenesisAllocation{
+		addr:   {State: uint8(state.Verified)},
+		sender: {Balance: new(big.Int).Mul(common.DnaBase, big.NewInt(100))},
+	})
+	// the first block sets fee per byte of the network
+	chain, appState := NewCustomTestBlockchainWithConfig(1, 0, key, cfg)
+	pool := chain.txpool
+	require.True(appState.State.FeePerByte().Sign() > 0)
+	to := tests.GetRandAddr()
+
+	var txs []*types.Transaction
+	for nonce := uint32(1); nonce <= 2; nonce++ {
+		tx, _ := types.SignTx(BuildTx(appState, sender, &to, types.SendTx, decimal.New(1, 0), decimal.New(20, 0), decimal.New(1, 0), nonce, 0, nil), senderKey)
+		txs = append(txs, tx)
+	}
+	invalidTx, _ := types.SignTx(BuildTx(appState, sender, &to, types.SendTx, decimal.New(1000, 0), decimal.New(20, 0), decimal.Zero, 3, 0, nil), senderKey)
+
+	head := chain.GetCurrentHead()
+	_, burnt := appState.State.SupplyChange()
+	result, err := chain.SimulateBlock(append([]*types.Transaction{invalidTx}, txs...))
+	require.NoError(err)
+	require.Len(result.Transactions, 2)
+	require.True(result.TotalFee.Sign() > 0)
+	require.Equal(chain.calculateBurntFee(chain.GovernedParams(), result.TotalFee), result.TotalBurned)
+	expectedReward := new(big.Int).Add(chain.GetEffectiveBlockReward(head.Height()+1), new(big.Int).Sub(result.TotalFee, result.TotalBurned))
+	expectedReward.Add(expectedReward, common.DnaBase)
+	expectedReward.Add(expectedReward, common.DnaBase)
+	require.Equal(expectedReward, result.ProposerReward)
+
+	// simulation doesn't touch the chain
+	require.Equal(head.Hash(), chain.GetCurrentHead().Hash())
+	_, burntAfter := appState.State.SupplyChange()
+	require.Equal(burnt, burntAfter)
+	require.Zero(appState.State.GetNonce(sender))
+
+	for _, tx := range txs {
+		require.NoError(pool.Add(tx))
+	}
+	chain.GenerateBlocks(1)
+	require.Len(chain.GetBlock(chain.GetCurrentHead().Hash()).Body.Transactions, 2)
+	require.Equal(result.StateRoot, chain.GetCurrentHead().Root())
+}
+
+func TestBlockchain_GetStakeInfo(t *testing.T) {
+	require := require.New(t)
+	key, _ := crypto.GenerateKey()
+	coinbase := crypto.PubkeyToAddress(key.PublicKey)
+	senderKey, _ := crypto.GenerateKey()
+	sender := crypto.PubkeyToAddress(senderKey.PublicKey)
+	cfg := NewTestConfig(coinbase, map[common.Address]config.GenesisAllocation{
+		coinbase: {State: uint8(state.Verified)},
+		sender:   {Balance: new(big.Int).Mul(common.DnaBase, big.NewInt(100))},
+	})
+	chain, appState := NewCustomTestBlockchainWithConfig(1, 0, key, cfg)
+	to := tests.GetRandAddr()
+
+	tx, _ := types.SignTx(BuildTx(appState, sender, &to, types.SendTx, decimal.New(1, 0), decimal.New(20, 0), decimal.New(1, 0), 1, 0, nil), senderKey)
+	require.NoError(chain.txpool.Add(tx))
+	chain.GenerateBlocks(3)
+
+	info, err := chain.GetStakeInfo(coinbase)
+	require.NoError(err)
+	require.True(info.TotalStake.Sign() > 0)
+	require.True(info.FromBlockRewards.Sign() > 0)
+	require.True(info.FromFeeRewards.Sign() > 0)
+	require.Zero(info.FromInvites.Sign())
+	sum := new(big.Int).Add(info.FromBlockRewards, info.FromFeeRewards)
+	sum.Add(sum, info.FromCommitteeRewards)
+	sum.Add(sum, info.FromInvites)
+	require.Equal(info.TotalStake, sum)
+
+	// stake sources are not accounted before the fork
+	chain.config.Consensus.ForkHeight = config.ForkNotScheduled
+	chain.GenerateBlocks(1)
+	preForkInfo, err := chain.GetStakeInfo(coinbase)
+	require.NoError(err)
+	require.True(preForkInfo.TotalStake.Cmp(info.TotalStake) > 0)
+	require.Equal(info.FromBlockRewards, preForkInfo.FromBlockRewards)
+	require.Equal(info.FromFeeRewards, preForkInfo.FromFeeRewards)
+
+	info, err = chain.GetStakeInfo(tests.GetRandAddr())
+	require.NoError(err)
+	require.Zero(info.TotalStake.Sign())
+}
+
+func TestBlockchain_GetCertifiedBlocks(t *testing.T) {
+	require := require.New(t)
+	chain, _ := NewTestBlockchainWithBlocks(10, 0)
+
+	blocks, err := chain.GetCertifiedBlocks(1, 10)
+	require.NoError(err)
+	require.Empty(blocks)
+
+	for _, height := range []uint64{7, 3, 5} {
+		chain.repo.WriteFinalConsensus(chain.GetBlockHeaderByHeight(height).Hash())
+	}
+	blocks, err = chain.GetCertifiedBlocks(1, 10)
+	require.NoError(err)
+	require.Len(blocks, 3)
+	for i, height := range []uint64{3, 5, 7} {
+		require.Equal(height, blocks[i].Height())
+	}
+
+	blocks, err = chain.GetCertifiedBlocks(4, 6)
+	require.NoError(err)
+	require.Len(blocks, 1)
+	require.Equal(uint64(5), blocks[0].Height())
+
+	_, err = chain.GetCertifiedBlocks(5, 4)
+	require.Error(err)
+	_, err = chain.GetCertifiedBlocks(1, MaxBlockRange+1)
+	require.Error(err)
+}
+
+func TestBlockchain_PruneBlocksBefore(t *testing.T) {
+	require := require.New(t)
+	chain, _ := NewTestBlockchainWithBlocks(minPruneDepth+10, 5)
+	head := chain.GetCurrentHead().Height()
+	floor := head - minPruneDepth
+
+	cnt, err := chain.PruneBlocksBefore(head)
+	require.NoError(err)
+	require.Equal(int(floor-chain.Genesis().Height()-1), cnt)
+	require.Equal(floor, chain.repo.ReadPrunedHeight())
+
+	prunedHash := chain.GetBlockHeaderByHeight(floor).ParentHash()
+	block, err := chain.GetBlockByHeightWithError(floor - 1)
+	require.Equal(ErrBlockPruned, err)
+	require.Nil(block)
+	require.Nil(chain.GetBlockByHeight(floor - 1))
+	require.Nil(chain.GetBlockHeaderByHeight(floor - 1))
+	require.NotNil(chain.repo.ReadBlockHeader(prunedHash))
+
+	block, err = chain.GetBlockByHeightWithError(floor)
+	require.NoError(err)
+	require.Equal(floor, block.Height())
+
+	block, err = chain.GetBlockByHeightWithError(chain.Genesis().Height())
+	require.NoError(err)
+	require.NotNil(block)
+
+	_, err = chain.GetBlockAtTime(time.Unix(chain.repo.ReadBlockHeader(prunedHash).Time(), 0))
+	require.Equal(ErrBlockPruned, err)
+	block, err = chain.GetBlockAtTime(time.Unix(chain.GetBlockHeaderByHeight(floor).Time(), 0))
+	require.NoError(err)
+	require.True(block.Height() >= floor)
+
+	require.NotEmpty(chain.ReadBlockForForkedPeer([]common.Hash{prunedHash}))
+	require.Empty(chain.ReadBlockForForkedPeer([]common.Hash{chain.repo.ReadBlockHeader(prunedHash).ParentHash()}))
+
+	cnt, err = chain.PruneBlocksBefore(floor)
+	require.NoError(err)
+	require.Zero(cnt)
+}
+
+func TestBlockchain_GetIdentityAt(t *testing.T) {
+	require := require.New(t)
+	key, _ := crypto.GenerateKey()
+	addr := crypto.PubkeyToAddress(key.PublicKey)
+	cfg := NewTestConfig(addr, map[common.Address]config.GenesisAllocation{
+		addr: {
+			State: uint8(state.Newbie),
+		},
+	})
+	chain, _ := NewCustomTestBlockchainWithConfig(5, 0, key, cfg)
+
+	for height := chain.Genesis().Height(); height <= chain.GetCurrentHead().Height(); height++ {
+		identity, err := chain.GetIdentityAt(addr, height)
+		require.NoError(err)
+		require.Equal(state.Newbie, identity.State)
+	}
+
+	_, err := chain.GetIdentityAt(addr, chain.Genesis().Height()-1)
+	require.Equal(ErrHeightOutOfRange, err)
+	_, err = chain.GetIdentityAt(addr, chain.GetCurrentHead().Height()+1)
+	require.Equal(ErrHeightOutOfRange, err)
+}
+
+func TestBlockchain_CountUpgradeSignals(t *testing.T) {
+	require := require.New(t)
+	chain, _ := NewTestBlockchainWithBlocks(5, 0)
+
+	addBlock := func(extraData []byte) error {
+		block := chain.ProposeBlock([]byte{}).Block
+		block.Header.ProposedHeader.Time = chain.GetCurrentHead().Time() + 20
+		block.Header.ProposedHeader.ExtraData = extraData
+		if err := chain.AddBlock(block, nil, collector.NewStatsCollector()); err != nil {
+			return err
+		}
+		chain.addCert(block)
+		return nil
+	}
+	for _, extraData := range [][]byte{{0x7}, {0x8, 0x7}, {0x7, 0x1}, nil, {0x7}} {
+		require.NoError(addBlock(extraData))
+	}
+
+	require.Equal(3, chain.CountUpgradeSignals(5, 0x7))
+	require.Equal(1, chain.CountUpgradeSignals(2, 0x7))
+	require.Equal(3, chain.CountUpgradeSignals(1000, 0x7))
+	require.Equal(1, chain.CountUpgradeSignals(1000, 0x8))
+	require.Zero(chain.CountUpgradeSignals(0, 0x7))
+
+	require.Equal(ErrExtraDataTooLarge, addBlock(make([]byte, types.MaxHeaderExtraDataSize+1)))
+	require.NoError(addBlock(make([]byte, types.MaxHeaderExtraDataSize)))
+}
+
+func TestBlockchain_GetBlockAtTime(t *testing.T) {
+	require := require.New(t)
+	chain, _ := NewTestBlockchainWithBlocks(40, 10)
+	genesis, head := chain.Genesis().Height(), chain.GetCurrentHead().Height()
+
+	for height := genesis; height <= head; height++ {
+		blockTime := chain.GetBlockHeaderByHeight(height).Time()
+		next := chain.GetBlockHeaderByHeight(height + 1)
+		if next != nil && next.Time() == blockTime {
+			continue
+		}
+		block, err := chain.GetBlockAtTime(time.Unix(blockTime, 0))
+		require.NoError(err)
+		require.Equal(height, block.Height())
+		if next != nil && next.Time() > blockTime+1 {
+			block, err = chain.GetBlockAtTime(time.Unix(next.Time()-1, 0))
+			require.NoError(err)
+			require.Equal(height, block.Height())
+		}
+	}
+
+	_, err := chain.GetBlockAtTime(time.Unix(chain.Genesis().Time()-1, 0))
+	require.Equal(ErrBeforeGenesis, err)
+
+	metrics := chain.Metrics().Snapshot()
+	block, err := chain.GetBlockAtTime(time.Unix(chain.GetCurrentHead().Time()+1000, 0))
+	require.NoError(err)
+	require.Equal(head, block.Height())
+	lookups := chain.Metrics().Snapshot()
+	reads := lookups.BlockCacheHits + lookups.BlockCacheMisses - metrics.BlockCacheHits - metrics.BlockCacheMisses
+	require.True(reads <= 8, "reads: %v", reads)
+}
+
+func TestBlockchain_GetSeedAt(t *testing.T) {
+	require := require.New(t)
+	chain, _ := NewTestBlockchainWithBlocks(10, 5)
+	head := chain.GetCurrentHead().Height()
+
+	var empty, proposed int
+	for height := chain.Genesis().Height(); height <= head; height++ {
+		block := chain.GetBlockByHeight(height)
+		if block.IsEmpty() {
+			empty++
+		} else {
+			proposed++
+		}
+		indexed, ok := chain.repo.ReadBlockSeed(height)
+		require.True(ok)
+		require.Equal(block.Seed(), indexed)
+		seed, err := chain.GetSeedAt(height)
+		require.NoError(err)
+		require.Equal(block.Seed(), seed)
+	}
+	require.NotZero(empty)
+	require.NotZero(proposed)
+
+	_, err := chain.GetSeedAt(0)
+	require.Equal(ErrHeightOutOfRange, err)
+	_, err = chain.GetSeedAt(head + 1)
+	require.Equal(ErrHeightOutOfRange, err)
+
+	chain.repo.RemoveBlockSeed(head)
+	seed, err := chain.GetSeedAt(head)
+	require.NoError(err)
+	require.Equal(chain.GetCurrentHead().Seed(), seed)
+	_, ok := chain.repo.ReadBlockSeed(head)
+	require.True(ok)
+
+	require.NoError(chain.ResetTo(head - 1))
+	_, ok = chain.repo.ReadBlockSeed(head)
+	require.False(ok)
+}
+
+func TestBlockchain_GetRecentEmptyBlocks(t *testing.T) {
+	require := require.New(t)
+	chain, _ := NewTestBlockchainWithBlocks(2, 0)
+	var emptyHeights []uint64
+	generateEmpty := func(count int) {
+		for i := 0; i < count; i++ {
+			chain.GenerateEmptyBlocks(1)
+			emptyHeights = append([]uint64{chain.GetCurrentHead().Height()}, emptyHeights...)
+		}
+	}
+	generateEmpty(1)
+	chain.GenerateBlocks(2)
+	generateEmpty(2)
+	chain.GenerateBlocks(1)
+	generateEmpty(1)
+	chain.GenerateBlocks(1)
+
+	blocks, err := chain.GetRecentEmptyBlocks(3)
+	require.NoError(err)
+	require.Len(blocks, 3)
+	for i, block := range blocks {
+		require.True(block.IsEmpty())
+		require.Equal(emptyHeights[i], block.Height())
+	}
+
+	blocks, err = chain.GetRecentEmptyBlocks(100)
+	require.NoError(err)
+	require.Len(blocks, len(emptyHeights))
+	for i, block := range blocks {
+		require.Equal(emptyHeights[i], block.Height())
+	}
+
+	blocks, err = chain.GetRecentEmptyBlocks(0)
+	require.NoError(err)
+	require.Empty(blocks)
+}
+
+func TestBlockchain_GetSeedHistory(t *testing.T) {
+	require := require.New(t)
+	chain, _ := NewTestBlockchainWithBlocks(5, 3)
+	head := chain.GetCurrentHead().Height()
+
+	records, err := chain.GetSeedHistory(4)
+	require.NoError(err)
+	require.Len(records, 4)
+	require.Equal(head, records[0].Height)
+	for i, record := range records {
+		if i > 0 {
+			require.Equal(records[i-1].Height-1, record.Height)
+		}
+		seed, err := chain.GetSeedAt(record.Height)
+		require.NoError(err)
+		require.Equal(seed, record.Seed)
+	}
+
+	available := int(head - chain.Genesis().Height() + 1)
+	records, err = chain.GetSeedHistory(available + 100)
+	require.NoError(err)
+	require.Len(records, available)
+	require.Equal(chain.Genesis().Height(), records[len(records)-1].Height)
+
+	records, err = chain.GetSeedHistory(0)
+	require.NoError(err)
+	require.Empty(records)
+}
+
+func TestBlockchain_SubscribeToNewBlocks(t *testing.T) {
+	require := require.New(t)
+	chain, _ := NewTestBlockchainWithBlocks(4, 0)
+	require.Equal(uint64(5), chain.GetCurrentHead().Height())
+
+	ch := make(chan *types.Block, 10)
+	chain.SubscribeToNewBlocks(ch)
+
+	chain.GenerateBlocks(2).GenerateEmptyBlocks(1)
+	require.Len(ch, 3)
+	for height := uint64(6); height <= 8; height++ {
+		block := <-ch
+		require.Equal(height, block.Height())
+	}
+
+	chain.Unsubscribe(ch)
+	chain.GenerateBlocks(1)
+	require.Len(ch, 0)
+
+	fullCh := make(chan *types.Block, 1)
+	chain.SubscribeToNewBlocks(fullCh)
+	chain.GenerateEmptyBlocks(2)
+	require.Len(fullCh, 1)
+	require.Equal(uint64(10), (<-fullCh).Height())
+}
+
+func TestBlockchain_EstimateFee(t *testing.T) {
+	senderKey, _ := crypto.GenerateKey()
+	sender := crypto.PubkeyToAddress(senderKey.PublicKey)
+	balance := new(big.Int).Mul(common.DnaBase, big.NewInt(100))
+	alloc := map[common.Address]config.GenesisAllocation{
+		sender: {Balance: balance},
+	}
+	chain, appState, _, _ := NewTestBlockchain(true, alloc)
+	appState.State.SetFeePerByte(new(big.Int).Div(common.DnaBase, big.NewInt(1000)))
+
+	to := tests.GetRandAddr()
+	tx := &types.Transaction{
+		Type:         types.SendTx,
+		AccountNonce: 1,
+		To:           &to,
+		Amount:       new(big.Int).Mul(common.DnaBase, big.NewInt(10)),
+		MaxFee:       new(big.Int).Mul(common.DnaBase, big.NewInt(1)),
+	}
+	signedTx, _ := types.SignTx(tx, senderKey)
+
+	estimatedFee := chain.EstimateFee(signedTx, 0)
+	require.Equal(t, 1, estimatedFee.Sign())
+	require.Equal(t, estimatedFee, chain.EstimateFee(signedTx, appState.ValidatorsCache.NetworkSize()))
+
+	appliedFee, err := chain.ApplyTxOnState(appState, signedTx, nil)
+	require.NoError(t, err)
+	require.Equal(t, estimatedFee, appliedFee)
+
+	expectedBalance := new(big.Int).Sub(balance, tx.Amount)
+	expectedBalance.Sub(expectedBalance, estimatedFee)
+	require.Equal(t, expectedBalance, appState.State.GetBalance(sender))
+
+	require.Zero(t, chain.EstimateFee(&types.Transaction{Type: types.InviteTx}, 0).Sign())
+}
+
+func Test_ApplyDelegateTx(t *testing.T) {
+	require := require.New(t)
+	senderKey, _ := crypto.GenerateKey()
+	sender := crypto.PubkeyToAddress(senderKey.PublicKey)
+	delegatee := tests.GetRandAddr()
+	alloc := map[common.Address]config.GenesisAllocation{
+		sender: {
+			State:   uint8(state.Verified),
+			Balance: new(big.Int).Mul(common.DnaBase, big.NewInt(10)),
+		},
+		delegatee: {
+			State: uint8(state.Verified),
+		},
+	}
+	chain, appState, _, _ := NewTestBlockchain(false, alloc)
+
+	tx := &types.Transaction{
+		Type:         types.DelegateTx,
+		AccountNonce: 1,
+		To:           &delegatee,
+		MaxFee:       new(big.Int).Mul(common.DnaBase, big.NewInt(1)),
+	}
+	signedTx, _ := types.SignTx(tx, senderKey)
+
+	_, err := chain.ApplyTxOnState(appState, signedTx, nil)
+	require.NoError(err)
+	require.Equal(&delegatee, appState.State.GetDelegatee(sender))
+	require.Equal(&delegatee, appState.IdentityState.Delegatee(sender))
+	require.True(appState.State.IsDelegatee(delegatee))
+	require.False(isDelegatedSeat(appState, sender))
+
+	// the offline delegator is credited for the committee seat its online delegatee votes for
+	appState.IdentityState.SetOnline(delegatee, true)
+	appState.IdentityState.Commit(false)
+	appState.ValidatorsCache.Load()
+	require.True(isDelegatedSeat(appState, sender))
+
+	clearDelegations(appState)
+	require.Nil(appState.State.GetDelegatee(sender))
+	require.Nil(appState.IdentityState.Delegatee(sender))
+	require.False(appState.State.IsDelegatee(delegatee))
+}
+
+func Test_ApplyParamChangeTx(t *testing.T) {
+	require := require.New(t)
+	key, _ := crypto.GenerateKey()
+	sender := crypto.PubkeyToAddress(key.PublicKey)
+	voter1, voter2 := tests.GetRandAddr(), tests.GetRandAddr()
+	alloc := map[common.Address]config.GenesisAllocation{
+		sender: {State: uint8(state.Verified), Balance: new(big.Int).Mul(common.DnaBase, big.NewInt(10))},
+		voter1: {State: uint8(state.Verified)},
+		voter2: {State: uint8(state.Verified)},
+	}
+	chain, appState, _, _ := NewTestBlockchain(false, alloc)
+	require.Equal(3, appState.ValidatorsCache.NetworkSize())
+	defaultParams := chain.config.Consensus.GovernedParams()
+
+	applyTx := func(nonce uint32, param string, value float64) {
+		tx := &types.Transaction{
+			Type:         types.ParamChangeTx,
+			AccountNonce: nonce,
+			MaxFee:       new(big.Int).Mul(common.DnaBase, big.NewInt(1)),
+			Payload:      attachments.CreateParamChangeAttachment(param, config.EncodeParamValue(value)),
+		}
+		signedTx, _ := types.SignTx(tx, key)
+		_, err := chain.ApplyTxOnState(appState, signedTx, nil)
+		require.NoError(err)
+	}
+	applyTx(1, config.FeeBurnRateParam, 0.5)
+	applyTx(2, config.CommitteePercentParam, 0.4)
+	applyTx(3, config.FeeBurnRateParam, 0.6)
+
+	// the second vote of the sender replaces the first one
+	votes := appState.State.ConsensusParamVotes()
+	require.Len(votes, 2)
+	require.Equal(config.CommitteePercentParam, votes[0].Key)
+	require.Equal(config.FeeBurnRateParam, votes[1].Key)
+	require.Equal(config.EncodeParamValue(0.6), votes[1].Value)
+
+	// a single vote is not a quorum
+	chain.applyParamChanges(appState)
+	require.Empty(appState.State.ConsensusParams())
+
+	appState.State.SetConsensusParamVote(config.FeeBurnRateParam, config.EncodeParamValue(0.6), voter1)
+	appState.State.SetConsensusParamVote(config.FeeBurnRateParam, config.EncodeParamValue(0.6), voter2)
+	appState.State.SetConsensusParamVote(config.CommitteePercentParam, config.EncodeParamValue(0.5), voter1)
+	chain.applyParamChanges(appState)
+	params := appState.State.ConsensusParams()
+	require.Len(params, 1)
+	require.Equal(config.FeeBurnRateParam, params[0].Key)
+	require.Len(appState.State.ConsensusParamVotes(), 2)
+
+	head := chain.GetCurrentHead().Height()
+	require.NoError(appState.Commit(nil))
+	chain.refreshGovernedParams()
+	require.Equal(float32(0.6), chain.GovernedParams().FeeBurnRate)
+	require.Equal(defaultParams.CommitteePercent, chain.GovernedParams().CommitteePercent)
+	require.Equal(defaultParams, chain.config.Consensus.GovernedParams())
+
+	// blocks are checked against params stored at their height
+	historic, err := chain.governedParamsAt(head)
+	require.NoError(err)
+	require.Equal(defaultParams, historic)
+
+	require.NoError(chain.ResetTo(head))
+	require.Equal(defaultParams, chain.GovernedParams())
+
+	appState.State.ClearAllConsensusParamVotes()
+	require.Empty(appState.State.ConsensusParamVotes())
+}
+
+func Test_ApplyUnstakeTx(t *testing.T) {
+	require := require.New(t)
+	senderKey, _ := crypto.GenerateKey()
+	sender := crypto.PubkeyToAddress(senderKey.PublicKey)
+	alloc := map[common.Address]config.GenesisAllocation{
+		sender: {
+			State: uint8(state.Verified),
+			Stake: new(big.Int).Mul(common.DnaBase, big.NewInt(10)),
+		},
+	}
+	chain, appState, _, _ := NewTestBlockchain(false, alloc)
+	chain.config.Consensus.UnstakeLockupBlocks = 5
+	height := chain.GetCurrentHead().Height()
+
+	tx := &types.Transaction{
+		Type:         types.UnstakeTx,
+		AccountNonce: 1,
+		Amount:       new(big.Int).Mul(common.DnaBase, big.NewInt(4)),
+	}
+	signedTx, _ := types.SignTx(tx, senderKey)
+
+	fee, err := chain.ApplyTxOnState(appState, signedTx, nil)
+	require.NoError(err)
+	require.Zero(fee.Sign())
+	require.Equal(new(big.Int).Mul(common.DnaBase, big.NewInt(6)), appState.State.GetStakeBalance(sender))
+	require.True(appState.State.HasPendingWithdrawal(sender))
+	require.Equal(height+6, appState.State.PendingWithdrawals()[0].UnlockBlock)
+
+	block := func(height uint64) *types.Block {
+		return &types.Block{Header: &types.Header{EmptyBlockHeader: &types.EmptyBlockHeader{Height: height}}, Body: &types.Body{}}
+	}
+	chain.applyPendingWithdrawals(appState, block(height+5))
+	require.Zero(appState.State.GetBalance(sender).Sign())
+
+	chain.applyPendingWithdrawals(appState, block(height+6))
+	require.Equal(tx.Amount, appState.State.GetBalance(sender))
+	require.False(appState.State.HasPendingWithdrawal(sender))
+
+	// lockup starts at the height of the block including tx
+	tx = &types.Transaction{
+		Type:         types.UnstakeTx,
+		AccountNonce: 2,
+		Amount:       common.DnaBase,
+	}
+	signedTx, _ = types.SignTx(tx, senderKey)
+	_, err = chain.applyTxOnState(appState, signedTx, height+10, nil)
+	require.NoError(err)
+	require.Equal(height+15, appState.State.PendingWithdrawals()[0].UnlockBlock)
+}
+
+func Test_ApplyClaimRewardsTx(t *testing.T) {
+	require := require.New(t)
+	chain, appState, _, _ := NewTestBlockchain(false, nil)
+	senderKey, _ := crypto.GenerateKey()
+	sender := crypto.PubkeyToAddress(senderKey.PublicKey)
+	other := tests.GetRandAddr()
+
+	unclaimed, err := chain.GetUnclaimedRewards(sender)
+	require.NoError(err)
+	require.Zero(unclaimed.Sign())
+
+	tx := &types.Transaction{
+		Type:         types.ClaimRewardsTx,
+		AccountNonce: 1,
+	}
+	signedTx, _ := types.SignTx(tx, senderKey)
+	require.Equal(validation.NoPendingRewards, validation.ValidateTx(appState, signedTx, fee2.MinFeePerByte, validation.InBlockTx, chain.config.Consensus))
+
+	epoch := appState.State.Epoch()
+	appState.State.AddPendingReward(sender, big.NewInt(100), epoch)
+	appState.State.AddPendingReward(sender, big.NewInt(50), epoch)
+	appState.State.AddPendingReward(other, big.NewInt(10), epoch)
+	require.Equal(big.NewInt(150), appState.State.GetPendingReward(sender))
+	require.Equal(big.NewInt(10), appState.State.GetPendingReward(other))
+	require.Nil(validation.ValidateTx(appState, signedTx, fee2.MinFeePerByte, validation.InBlockTx, chain.config.Consensus))
+
+	mintedCoins, _ := appState.State.SupplyChange()
+	fee, err := chain.ApplyTxOnState(appState, signedTx, nil)
+	require.NoError(err)
+	require.Zero(fee.Sign())
+	require.Equal(big.NewInt(150), appState.State.GetBalance(sender))
+	require.Zero(appState.State.GetPendingReward(sender).Sign())
+	mintedAfter, _ := appState.State.SupplyChange()
+	require.Equal(new(big.Int).Add(mintedCoins, big.NewInt(150)), mintedAfter)
+
+	appState.State.AddPendingReward(sender, big.NewInt(20), epoch+1)
+	appState.State.IncEpoch()
+	expirePendingRewards(appState)
+	require.Zero(appState.State.GetPendingReward(other).Sign())
+	require.Equal(big.NewInt(20), appState.State.GetPendingReward(sender))
+	require.Equal(big.NewInt(10), appState.State.CommitteeRewardPool())
+}
+
+func TestBlockchain_ApplyGenesisPatch(t *testing.T) {
+	require := require.New(t)
+	chain, appState := NewTestBlockchainWithBlocks(5, 0)
+
+	var keys []*ecdsa.PrivateKey
+	for i := 0; i < 3; i++ {
+		key, _ := crypto.GenerateKey()
+		keys = append(keys, key)
+		chain.config.GenesisConf.TrustedKeys = append(chain.config.GenesisConf.TrustedKeys, crypto.FromECDSAPub(&key.PublicKey))
+	}
+	chain.config.GenesisConf.TrustedKeysThreshold = 2
+
+	addr := tests.GetRandAddr()
+	patch := &GenesisPatch{
+		Version: 1,
+		Height:  chain.GetCurrentHead().Height() + 2,
+		Corrections: []StateDelta{
+			{Address: addr, Balance: big.NewInt(1000)},
+		},
+	}
+	hash := patch.Hash()
+	sign := func(key *ecdsa.PrivateKey) []byte {
+		sig, _ := crypto.Sign(hash[:], key)
+		return sig
+	}
+	untrustedKey, _ := crypto.GenerateKey()
+
+	require.Equal(ErrInsufficientPatchSigs, chain.ApplyGenesisPatch(patch, [][]byte{sign(keys[0])}))
+	require.Equal(ErrInsufficientPatchSigs, chain.ApplyGenesisPatch(patch, [][]byte{sign(keys[0]), sign(keys[0])}))
+	require.Equal(ErrInsufficientPatchSigs, chain.ApplyGenesisPatch(patch, [][]byte{sign(keys[0]), sign(untrustedKey)}))
+
+	sigs := [][]byte{sign(keys[0]), sign(keys[2])}
+	// the height is signed with the corrections
+	stalePatch := *patch
+	stalePatch.Height = chain.GetCurrentHead().Height()
+	require.Equal(ErrInsufficientPatchSigs, chain.ApplyGenesisPatch(&stalePatch, sigs))
+
+	require.NoError(chain.ApplyGenesisPatch(patch, sigs))
+
+	// the scheduled patch survives the restart
+	chain.genesisPatch.Store((*GenesisPatch)(nil))
+	chain.loadGenesisPatch()
+	require.Equal(hash, chain.genesisPatch.Load().(*GenesisPatch).Hash())
+
+	chain.GenerateBlocks(1)
+	require.Zero(appState.State.GetBalance(addr).Sign())
+	require.Zero(appState.State.PatchVersion())
+
+	prevRoot := chain.GetCurrentHead().Root()
+	chain.GenerateBlocks(1)
+	require.Equal(patch.Height, chain.GetCurrentHead().Height())
+	require.NotEqual(prevRoot, chain.GetCurrentHead().Root())
+	require.Equal(big.NewInt(1000), appState.State.GetBalance(addr))
+	require.Equal(uint32(1), appState.State.PatchVersion())
+
+	mintedByBlock := func() *big.Int {
+		height := chain.GetCurrentHead().Height()
+		minted, _ := chain.supplyTotals(height)
+		prevMinted, _ := chain.supplyTotals(height - 1)
+		return minted.Sub(minted, prevMinted)
+	}
+	patchMinted := mintedByBlock()
+	require.NoError(chain.ApplyGenesisPatch(patch, sigs))
+	chain.GenerateBlocks(1)
+	require.Equal(big.NewInt(1000), appState.State.GetBalance(addr))
+	require.Equal(uint32(1), appState.State.PatchVersion())
+	// the already applied patch doesn't mint coins again
+	patchRepeatMinted := mintedByBlock()
+	require.Equal(new(big.Int).Sub(patchMinted, big.NewInt(1000)), patchRepeatMinted)
+
+	nextPatch := &GenesisPatch{
+		Version: 2,
+		Height:  chain.GetCurrentHead().Height(),
+	}
+	hash = nextPatch.Hash()
+	require.Equal(ErrInvalidPatchHeight, chain.ApplyGenesisPatch(nextPatch, [][]byte{sign(keys[0]), sign(keys[1])}))
+}
+
+func Test_ApplySubmitFlipKeyTx(t *testing.T) {
+	require := require.New(t)
+	key, _ := crypto.GenerateKey()
+	addr := crypto.PubkeyToAddress(key.PublicKey)
+	cfg := NewTestConfig(addr, map[common.Address]config.GenesisAllocation{
+		addr: {State: uint8(state.Verified)},
+	})
+	cfg.Consensus.MaxEmptyBlocksPerEpoch = 1
+	chain, appState := NewCustomTestBlockchainWithConfig(1, 0, key, cfg)
+	chain.applyNewEpochFn = func(height uint64, appState *appstate.AppState, collector collector.StatsCollector) (int, *types.ValidationResults, bool) {
+		return appState.ValidatorsCache.NetworkSize(), &types.ValidationResults{}, true
+	}
+	flipKeyHash := crypto.Keccak256([]byte("flip key"))
+
+	otherKey, _ := crypto.GenerateKey()
+	other := crypto.PubkeyToAddress(otherKey.PublicKey)
+	otherTx, _ := types.SignTx(BuildTx(appState, other, nil, types.SubmitFlipKeyTx, decimal.Zero, decimal.New(2, 0), decimal.Zero, 0, 0, flipKeyHash), otherKey)
+	require.Equal(validation.NotVerified, validation.ValidateTx(appState, otherTx, fee2.MinFeePerByte, validation.InBlockTx, chain.config.Consensus))
+
+	tx, _ := chain.secStore.SignTx(BuildTx(appState, addr, nil, types.SubmitFlipKeyTx, decimal.Zero, decimal.New(2, 0), decimal.Zero, 0, 0, flipKeyHash))
+	require.NoError(chain.txpool.Add(tx))
+	chain.GenerateBlocks(1)
+	require.Equal(flipKeyHash, appState.State.GetFlipKeyHash(addr))
+
+	tx, _ = chain.secStore.SignTx(BuildTx(appState, addr, nil, types.SubmitFlipKeyTx, decimal.Zero, decimal.New(2, 0), decimal.Zero, 0, 0, flipKeyHash))
+	require.Equal(validation.DuplicatedFlipKey, validation.ValidateTx(appState, tx, fee2.MinFeePerByte, validation.InBlockTx, chain.config.Consensus))
+	require.Error(chain.txpool.Add(tx))
+
+	epoch := appState.State.Epoch()
+	chain.GenerateEmptyBlocks(2)
+	require.Equal(epoch+1, appState.State.Epoch())
+	require.Empty(appState.State.GetFlipKeyHash(addr))
+	tx, _ = chain.secStore.SignTx(BuildTx(appState, addr, nil, types.SubmitFlipKeyTx, decimal.Zero, decimal.New(2, 0), decimal.Zero, 0, 0, flipKeyHash))
+	require.Nil(validation.ValidateTx(appState, tx, fee2.MinFeePerByte, validation.InBlockTx, chain.config.Consensus))
+}
+
+func Test_validateBlockParentHashErrorCodes(t *testing.T) {
+	require := require.New(t)
+	prevBlock := &types.Header{EmptyBlockHeader: &types.EmptyBlockHeader{Height: 5}}
+
+	err := validateBlockParentHash(&types.Header{EmptyBlockHeader: &types.EmptyBlockHeader{Height: 7}}, prevBlock)
+	validationErr, ok := err.(*BlockValidationError)
+	require.True(ok)
+	require.Equal(ErrInvalidParentHash, validationErr.Code)
+
+	err = validateBlockParentHash(&types.Header{EmptyBlockHeader: &types.EmptyBlockHeader{Height: 6}}, prevBlock)
+	require.Equal(ParentHashIsInvalid, err)
+	require.Equal(ErrInvalidParentHash, err.(*BlockValidationError).Code)
+}
+
+func TestBlockchain_GetEpochInfo(t *testing.T) {
+	require := require.New(t)
+	key, _ := crypto.GenerateKey()
+	addr := crypto.PubkeyToAddress(key.PublicKey)
+	cfg := NewTestConfig(addr, map[common.Address]config.GenesisAllocation{
+		addr:                {State: uint8(state.Verified)},
+		tests.GetRandAddr(): {State: uint8(state.Candidate)},
+	})
+	chain, _ := NewCustomTestBlockchainWithConfig(2, 0, key, cfg)
+
+	info, err := chain.GetEpochInfo()
+	require.NoError(err)
+	require.Equal(uint16(0), info.Epoch)
+	require.Equal(1, info.VerifiedCount)
+	require.Equal(1, info.CandidateCount)
+	require.Equal(chain.GetCurrentHead().Height()+info.BlocksRemaining, info.NextEpochBlock)
+
+	for i := uint64(1); i <= 3; i++ {
+		chain.GenerateBlocks(1)
+		next, err := chain.GetEpochInfo()
+		require.NoError(err)
+		require.Equal(info.BlocksRemaining-i, next.BlocksRemaining)
+		require.Equal(info.NextEpochBlock, next.NextEpochBlock)
+	}
+}
+
+func TestBlockchain_GetFeeHistory(t *testing.T) {
+	require := require.New(t)
+	key, _ := crypto.GenerateKey()
+	addr := crypto.PubkeyToAddress(key.PublicKey)
+	cfg := NewTestConfig(addr, map[common.Address]config.GenesisAllocation{
+		addr: {
+			State:   uint8(state.Verified),
+			Balance: new(big.Int).Mul(common.DnaBase, big.NewInt(100)),
+		},
+	})
+	chain, appState := NewCustomTestBlockchainWithConfig(1, 0, key, cfg)
+
+	for i := 1; i <= 2; i++ {
+		tx, _ := types.SignTx(BuildTx(appState, addr, &addr, types.SendTx, decimal.New(1, 0), decimal.New(20, 0), decimal.Zero, uint32(i), 0, nil), key)
+		require.NoError(chain.txpool.Add(tx))
+	}
+	chain.GenerateBlocks(1).GenerateEmptyBlocks(1)
+	head := chain.GetCurrentHead().Height()
+
+	records, err := chain.GetFeeHistory(head-2, head)
+	require.NoError(err)
+	require.Len(records, 3)
+	require.Zero(records[0].TxCount)
+	require.True(common.ZeroOrNil(records[0].TotalFee))
+
+	withTxs := records[1]
+	require.Equal(head-1, withTxs.Height)
+	require.Equal(2, withTxs.TxCount)
+	require.Equal(1, withTxs.TotalFee.Sign())
+	require.Equal(chain.calculateBurntFee(chain.GovernedParams(), withTxs.TotalFee), withTxs.BurnedFee)
+	require.Zero(records[2].TxCount)
+
+	_, err = chain.GetFeeHistory(head, head-1)
+	require.Error(err)
+}
+
+func TestBlockchain_ValidateBodyRoot(t *testing.T) {
+	require := require.New(t)
+	chain, _, _, _ := NewTestBlockchain(true, nil)
+
+	block := chain.ProposeBlock([]byte{}).Block
+	require.Equal(block.Body.MerkleRoot(block.Seed()), block.Header.ProposedHeader.BodyRoot)
+	require.NoError(chain.ValidateBlock(block, nil))
+
+	block.Header.ProposedHeader.BodyRoot = common.Hash{0x1}
+	err := chain.ValidateBlock(block, nil)
+	require.Error(err)
+	require.Equal(ErrInvalidBodyRoot, err.(*BlockValidationError).Code)
+
+	block.Header.ProposedHeader.BodyRoot = common.Hash{}
+	require.Equal(ErrInvalidBodyRoot, chain.ValidateBlock(block, nil).(*BlockValidationError).Code)
+
+	chain.config.Consensus.ForkHeight = block.Height() + 1
+	block = chain.ProposeBlock([]byte{}).Block
+	require.Equal(common.Hash{}, block.Header.ProposedHeader.BodyRoot)
+	require.NoError(chain.ValidateBlock(block, nil))
+	block.Header.ProposedHeader.BodyRoot = common.Hash{0x1}
+	require.Equal(ErrInvalidBodyRoot, chain.ValidateBlock(block, nil).(*BlockValidationError).Code)
+}
+
+func TestBlockchain_AddProposedBlock(t *testing.T) {
+	require := require.New(t)
+	chain, _, _, _ := NewTestBlockchain(true, nil)
+
+	block := chain.ProposeBlock([]byte{}).Block
+	block.Header.ProposedHeader.TxBloom = []byte{0x1}
+	require.Error(chain.AddProposedBlock(NewPrevalidatedBlock(block, false), collector.NewStatsCollector()))
+
+	block = chain.ProposeBlock([]byte{}).Block
+	block.Header.ProposedHeader.ParentHash = common.Hash{0x1}
+	require.Equal(ParentHashIsInvalid, chain.AddProposedBlock(NewPrevalidatedBlock(block, true), collector.NewStatsCollector()))
+
+	block = chain.ProposeBlock([]byte{}).Block
+	require.NoError(chain.AddProposedBlock(NewPrevalidatedBlock(block, true), collector.NewStatsCollector()))
+	require.Equal(block.Hash(), chain.GetCurrentHead().Hash())
+}
+
+func TestBlockchain_CalculateAddressBalance(t *testing.T) {
+	require := require.New(t)
+	addr := tests.GetRandAddr()
+	alloc := map[common.Address]config.GenesisAllocation{
+		addr: {
+			Balance: big.NewInt(100),
+			Stake:   big.NewInt(10),
+		},
+	}
+	chain, _, _, _ := NewTestBlockchain(false, alloc)
+
+	balance, stake, err := chain.CalculateAddressBalance(addr)
+	require.NoError(err)
+	require.Equal(big.NewInt(100), balance)
+	require.Equal(big.NewInt(10), stake)
+
+	balance, stake, err = chain.CalculateAddressBalance(tests.GetRandAddr())
+	require.NoError(err)
+	require.Zero(balance.Sign())
+	require.Zero(stake.Sign())
+
+	balance, stake, err = chain.CalculateAddressBalance(common.Address{})
+	require.NoError(err)
+	require.Zero(balance.Sign())
+	require.Zero(stake.Sign())
+}
+
+func TestBlockchain_ScanBlocks(t *testing.T) {
+	require := require.New(t)
+	chain, _ := NewTestBlockchainWithBlocks(3, 2)
+	chain.GenerateBlocks(2)
+
+	nonEmpty := func(block *types.Block) bool {
+		return !block.IsEmpty()
+	}
+	ch, cancel := chain.ScanBlocks(2, nonEmpty)
+	defer cancel()
+	var heights []uint64
+	for block := range ch {
+		heights = append(heights, block.Height())
+	}
+	require.Equal([]uint64{2, 3, 4, 7, 8}, heights)
+
+	ch, cancel = chain.ScanBlocks(1, func(*types.Block) bool { return true })
+	<-ch
+	cancel()
+	received := 0
+	timeout := time.After(time.Second)
+	for closed := false; !closed; {
+		select {
+		case _, ok := <-ch:
+			closed = !ok
+			if ok {
+				received++
+			}
+		case <-timeout:
+			require.Fail("scan is not stopped")
+			return
+		}
+	}
+	require.True(received <= 1)
+}
+
+func Test_validateBlockTimestamp(t *testing.T) {
+	require := require.New(t)
+	now := time.Now().UTC().Unix()
+	header := func(timestamp int64) *types.Header {
+		return &types.Header{EmptyBlockHeader: &types.EmptyBlockHeader{Time: timestamp}}
+	}
+	const maxDrift = time.Second * 15
+	const minBlockDistance = time.Second * 20
+
+	require.NoError(validateBlockTimestamp(header(now+10), header(now-10), maxDrift, minBlockDistance, 0))
+	require.Equal(ErrFutureBlock, validateBlockTimestamp(header(now+60), header(now-10), maxDrift, minBlockDistance, 0))
+
+	err := validateBlockTimestamp(header(now-20), header(now-10), maxDrift, minBlockDistance, 0)
+	require.Error(err)
+	require.Equal(ErrInvalidTimestamp, err.(*BlockValidationError).Code)
+
+	// slightly early block is accepted within the skew tolerance
+	require.NoError(validateBlockTimestamp(header(now), header(now-11), maxDrift, minBlockDistance, 0))
+	require.NoError(validateBlockTimestamp(header(now), header(now-10), maxDrift, minBlockDistance, 0))
+	err = validateBlockTimestamp(header(now), header(now-9), maxDrift, minBlockDistance, 0)
+	require.Error(err)
+	require.Equal(ErrInvalidTimestamp, err.(*BlockValidationError).Code)
+
+	// min block interval is not relaxed, a block exactly at the minimum is accepted
+	const minBlockInterval = time.Second * 12
+	require.NoError(validateBlockTimestamp(header(now), header(now-12), maxDrift, minBlockDistance, minBlockInterval))
+	require.Equal(ErrBlockTooFrequent, validateBlockTimestamp(header(now), header(now-11), maxDrift, minBlockDistance, minBlockInterval))
+}
+
+func TestBlockchain_newBlockTime(t *testing.T) {
+	require := require.New(t)
+	chain, _ := NewTestBlockchainWithBlocks(0, 0)
+	chain.config.Consensus.MinBlockDistance = time.Second * 20
+
+	// fast proposer doesn't wait but uses the earliest time accepted by validators
+	prevBlockTime := time.Now().UTC().Unix()
+	started := time.Now()
+	blockTime := chain.newBlockTime(prevBlockTime)
+	require.Equal(prevBlockTime+10, blockTime)
+	require.True(time.Since(started) < time.Second)
+	require.NoError(validateBlockTimestamp(&types.Header{EmptyBlockHeader: &types.EmptyBlockHeader{Time: blockTime}},
+		&types.Header{EmptyBlockHeader: &types.EmptyBlockHeader{Time: prevBlockTime}}, chain.config.Consensus.MaxBlockTimeDrift,
+		chain.config.Consensus.MinBlockDistance, 0))
+
+	// late proposer uses local time
+	prevBlockTime = time.Now().UTC().Unix() - 100
+	require.True(chain.newBlockTime(prevBlockTime) >= time.Now().UTC().Unix()-1)
+}
+
+func Test_compoundStakes(t *testing.T) {
+	require := require.New(t)
+	verified, newbie, noStake := tests.GetRandAddr(), tests.GetRandAddr(), tests.GetRandAddr()
+	stake := new(big.Int).Mul(common.DnaBase, big.NewInt(1000))
+	alloc := map[common.Address]config.GenesisAllocation{
+		verified: {State: uint8(state.Verified), Stake: stake},
+		newbie:   {State: uint8(state.Newbie), Stake: stake},
+		noStake:  {State: uint8(state.Verified)},
+	}
+	chain, appState, _, _ := NewTestBlockchain(false, alloc)
+	height := chain.GetCurrentHead().Height() + 1
+
+	const rate = 0.05
+	conf := *chain.config.Consensus
+	conf.StakeInterestRate = rate
+
+	// stakes don't grow before the fork
+	conf.ForkHeight = height + 1
+	compoundStakes(appState, &conf, height)
+	require.Equal(stake, appState.State.GetStakeBalance(verified))
+
+	conf.ForkHeight = height
+	for i := 0; i < 10; i++ {
+		compoundStakes(appState, &conf, height)
+	}
+
+	expected := math.ToInt(decimal.NewFromBigInt(stake, 0).Mul(decimal.NewFromFloat(1 + rate).Pow(decimal.New(10, 0))))
+	diff := new(big.Int).Sub(expected, appState.State.GetStakeBalance(verified))
+	require.True(diff.CmpAbs(common.DnaBase) < 0)
+	require.Equal(stake, appState.State.GetStakeBalance(newbie))
+	require.True(common.ZeroOrNil(appState.State.GetStakeBalance(noStake)))
+}
+
+func TestBlockchain_processBlockResetsStateOnFailure(t *testing.T) {
+	require := require.New(t)
+	addr := tests.GetRandAddr()
+	chain, appState, _, _ := NewTestBlockchain(false, nil)
+	root := appState.State.Root()
+	version := appState.State.Version()
+
+	chain.applyNewEpochFn = func(height uint64, appState *appstate.AppState, collector collector.StatsCollector) (int, *types.ValidationResults, bool) {
+		appState.State.SetBalance(addr, big.NewInt(100))
+		return 0, &types.ValidationResults{}, true
+	}
+	block := chain.GenerateEmptyBlock()
+	block.Header.EmptyBlockHeader.Flags = types.ValidationFinished
+	block.Header.EmptyBlockHeader.Root = common.Hash{0x1}
+
+	_, err := chain.processBlock(block, collector.NewStatsCollector())
+	require.Error(err)
+	require.Equal(root, appState.State.Root())
+	require.Equal(version, appState.State.Version())
+	require.True(common.ZeroOrNil(appState.State.GetBalance(addr)))
+}
+
+type countingVrfSigner struct {
+	vrf.PrivateKey
+	calls int
+}
+
+func (s *countingVrfSigner) Evaluate(m []byte) (index [32]byte, proof []byte) {
+	s.calls++
+	return s.PrivateKey.Evaluate(m)
+}
+
+func TestNewBlockchain_Options(t *testing.T) {
+	require := require.New(t)
+	key, _ := crypto.GenerateKey()
+	addr := crypto.PubkeyToAddress(key.PublicKey)
+	cfg := NewTestConfig(addr, map[common.Address]config.GenesisAllocation{
+		addr: {State: uint8(state.Verified)},
+	})
+	cfg.OfflineDetection = config.GetDefaultOfflineDetectionConfig()
+	db := dbm.NewMemDB()
+	bus := eventbus.New()
+	appState := appstate.NewAppState(db, bus)
+	secStore := secstore.NewSecStore()
+	secStore.AddKey(crypto.FromECDSA(key))
+	txPool := mempool.NewTxPool(appState, bus, config.GetDefaultMempoolConfig(), cfg.Consensus)
+	offline := NewOfflineDetector(cfg, db, appState, secStore, bus)
+	keyStore := keystore.NewKeyStore("./testdata", keystore.StandardScryptN, keystore.StandardScryptP)
+
+	_, err := NewBlockchain(cfg, db, WithTxPool(txPool), WithAppState(appState))
+	require.Error(err)
+
+	p256Signer, err := p256.NewVRFSigner(key)
+	require.NoError(err)
+	signer := &countingVrfSigner{PrivateKey: p256Signer}
+	chain, err := NewBlockchain(cfg, db, WithRequiredDefaults(txPool, appState, ipfs.NewMemoryIpfsProxy(), secStore, bus, offline, keyStore),
+		WithVRFSigner(signer))
+	require.NoError(err)
+	require.NoError(chain.InitializeChain())
+	require.NoError(appState.Initialize(chain.GetCurrentHead().Height()))
+
+	block := chain.ProposeEmptyBlock()
+	require.Equal(1, signer.calls)
+	_, err = verifySeedProof(crypto.FromECDSAPub(&key.PublicKey), block.Header.EmptyBlockHeader.SeedProof, chain.GetCurrentHead())
+	require.NoError(err)
+	require.NoError(chain.ValidateBlock(block, nil))
+}
+
+func TestBlockchain_ProposeEmptyBlock(t *testing.T) {
+	require := require.New(t)
+	chain, _, _, _ := NewTestBlockchain(true, nil)
+
+	block := chain.ProposeEmptyBlock()
+	require.True(block.IsEmpty())
+	require.Equal(chain.pubKey, block.Header.EmptyBlockHeader.ProposerPubKey)
+	require.NotEqual(chain.GenerateEmptyBlock().Hash(), block.Hash())
+	// the proposer can't choose the seed of the empty block
+	require.Equal(chain.GenerateEmptyBlock().Seed(), block.Seed())
+
+	forged := chain.ProposeEmptyBlock()
+	forged.Header.EmptyBlockHeader.BlockSeed, _ = chain.vrfEvaluate(getSeedData(chain.GetCurrentHead()))
+	err := chain.ValidateBlock(forged, nil)
+	require.Error(err)
+	require.Equal(ErrInvalidSeed, err.(*BlockValidationError).Code)
+
+	forged = chain.ProposeEmptyBlock()
+	forged.Header.EmptyBlockHeader.SeedProof = forged.Header.EmptyBlockHeader.SeedProof[1:]
+	require.Error(chain.ValidateBlock(forged, nil))
+
+	require.NoError(chain.AddBlock(block, nil, collector.NewStatsCollector()))
+	require.Equal(block.Hash(), chain.GetCurrentHead().Hash())
+	require.Equal(chain.coinBaseAddress, block.Header.ProposerAddress())
+	require.Equal(common.Address{}, block.Header.Coinbase())
+	require.Equal(common.Address{}, chain.GenerateEmptyBlock().Header.ProposerAddress())
+
+	history, err := chain.GetProposerHistory(1)
+	require.NoError(err)
+	require.Equal(chain.coinBaseAddress, history[0].ProposerAddress)
+}
+
+func TestBlockchain_GetCommitteeByHeight(t *testing.T) {
+	require := require.New(t)
+	chain, appState := NewTestBlockchainWithBlocks(2, 0)
+	prevBlock := chain.GetCurrentHead()
+	expected := appState.ValidatorsCache.GetOnlineValidators(prevBlock.Seed(), prevBlock.Height()+1, types.Final,
+		chain.GetCommitteeSize(appState.ValidatorsCache, true))
+	chain.GenerateBlocks(3)
+
+	committee, err := chain.GetCommitteeByHeight(prevBlock.Height() + 1)
+	require.NoError(err)
+	require.Len(committee, expected.Cardinality())
+	for _, addr := range committee {
+		require.True(expected.Contains(addr))
+	}
+
+	_, err = chain.GetCommitteeByHeight(chain.genesis.Height())
+	require.Equal(ErrHeightOutOfRange, err)
+	_, err = chain.GetCommitteeByHeight(chain.GetCurrentHead().Height() + 1)
+	require.Equal(ErrHeightOutOfRange, err)
+}
+
+func TestOnlyCommitteeSelectionSeed_GetCommitteeByHeight(t *testing.T) {
+	require := require.New(t)
+	key, _ := crypto.GenerateKey()
+	cfg := NewTestConfig(crypto.PubkeyToAddress(key.PublicKey), nil)
+	cfg.Consensus.TestOnlyCommitteeSelectionSeed = &types.Seed{0x1}
+	chain, appState := NewCustomTestBlockchainWithConfig(3, 0, key, cfg)
+	height := chain.GetCurrentHead().Height()
+	expected := appState.ValidatorsCache.GetOnlineValidators(types.Seed{0x1}, height, types.Final,
+		chain.GetCommitteeSize(appState.ValidatorsCache, true))
+
+	committee, err := chain.GetCommitteeByHeight(height)
+	require.NoError(err)
+	require.Len(committee, expected.Cardinality())
+	for _, addr := range committee {
+		require.True(expected.Contains(addr))
+	}
+}
+
+func TestBlockchain_GetCommitteeMembership(t *testing.T) {
+	require := require.New(t)
+	chain, appState := NewTestBlockchainWithBlocks(2, 0)
+	prevBlock := chain.GetCurrentHead()
+	expected := appState.ValidatorsCache.GetOnlineValidators(prevBlock.Seed(), prevBlock.Height()+1, types.Final,
+		chain.GetCommitteeSize(appState.ValidatorsCache, true))
+	chain.GenerateBlocks(1)
+
+	_, err := chain.GetCommitteeMembership(chain.GetCurrentHead().Height())
+	require.Equal(ErrCommitteeNotFound, err)
+
+	require.Error(chain.WriteFinalConsensus(chain.GetCurrentHead().Hash(), &types.BlockCert{}))
+	_, err = chain.GetCommitteeMembership(chain.GetCurrentHead().Height())
+	require.Equal(ErrCommitteeNotFound, err)
+
+	vote := &types.Vote{
+		Header: &types.VoteHeader{
+			Round:      chain.GetCurrentHead().Height(),
+			Step:       types.Final,
+			ParentHash: chain.GetCurrentHead().ParentHash(),
+			VotedHash:  chain.GetCurrentHead().Hash(),
+		},
+	}
+	hash := crypto.SignatureHash(vote)
+	vote.Signature = chain.secStore.Sign(hash[:])
+	finalCert := types.FullBlockCert{Votes: []*types.Vote{vote}}
+	require.NoError(chain.WriteFinalConsensus(chain.GetCurrentHead().Hash(), finalCert.Compress()))
+	committee, err := chain.GetCommitteeMembership(chain.GetCurrentHead().Height())
+	require.NoError(err)
+	require.True(committee.Cardinality() > 0)
+	require.True(expected.Equal(committee))
+
+	_, err = chain.GetCommitteeMembership(chain.GetCurrentHead().Height() + 1)
+	require.Equal(ErrCommitteeNotFound, err)
+}
+
+func TestBlockchain_VerifyBlockCert(t *testing.T) {
+	require := require.New(t)
+	chain, _ := NewTestBlockchainWithBlocks(5, 0)
+	head := chain.GetCurrentHead().Height()
+
+	for height := chain.Genesis().Height() + 1; height <= head; height++ {
+		require.NoError(chain.VerifyBlockCert(chain.GetBlockHeaderByHeight(height).Hash()))
+	}
+	require.Empty(chain.ScanAndVerifyCerts(0, head+10))
+	require.Equal(ErrCertNotFound, chain.VerifyBlockCert(common.Hash{0x1}))
+
+	corrupted := chain.GetBlockHeaderByHeight(head - 2).Hash()
+	cert := chain.GetCertificate(corrupted)
+	cert.Signatures[0].Signature[10] ^= 0x1
+	chain.WriteCertificate(corrupted, cert, true)
+
+	require.Error(chain.VerifyBlockCert(corrupted))
+	errs := chain.ScanAndVerifyCerts(0, head)
+	require.Len(errs, 1)
+	require.Contains(errs[0].Error(), fmt.Sprintf("height: %v", head-2))
+}
+
+func TestBlockchain_GetProposerHistory(t *testing.T) {
+	require := require.New(t)
+	chain, _ := NewTestBlockchainWithBlocks(1190, 10)
+	require.True(chain.GetCurrentHead().Height() >= 1200)
+
+	history, err := chain.GetProposerHistory(2000)
+	require.NoError(err)
+	require.Len(history, config.DefaultProposerHistorySize)
+
+	head := chain.GetCurrentHead().Height()
+	for i, record := range history {
+		height := head - uint64(i)
+		header := chain.GetBlockHeaderByHeight(height)
+		require.Equal(height, record.BlockHeight)
+		require.Equal(header.Hash(), record.BlockHash)
+		require.Equal(header.Time(), record.Timestamp.Unix())
+		if header.EmptyBlockHeader != nil {
+			require.Equal(common.Address{}, record.ProposerAddress)
+		} else {
+			require.Equal(header.Coinbase(), record.ProposerAddress)
+			require.NotEqual(common.Address{}, record.ProposerAddress)
+		}
+	}
+	require.True(history[0].ProposerAddress == common.Address{})
+	require.True(history[len(history)-1].ProposerAddress != common.Address{})
+
+	history, err = chain.GetProposerHistory(3)
+	require.NoError(err)
+	require.Len(history, 3)
+	require.Equal(head, history[0].BlockHeight)
+}
+
+func TestBlockchain_MaxEmptyBlocksPerEpoch(t *testing.T) {
+	require := require.New(t)
+	key, _ := crypto.GenerateKey()
+	addr := crypto.PubkeyToAddress(key.PublicKey)
+	cfg := NewTestConfig(addr, map[common.Address]config.GenesisAllocation{
+		addr: {State: uint8(state.Verified)},
+	})
+	cfg.Consensus.MaxEmptyBlocksPerEpoch = 3
+	chain, appState := NewCustomTestBlockchainWithConfig(0, 0, key, cfg)
+	chain.applyNewEpochFn = func(height uint64, appState *appstate.AppState, collector collector.StatsCollector) (int, *types.ValidationResults, bool) {
+		return appState.ValidatorsCache.NetworkSize(), &types.ValidationResults{}, true
+	}
+	epoch := appState.State.Epoch()
+
+	chain.GenerateEmptyBlocks(2).GenerateBlocks(1)
+	require.Zero(appState.State.ConsecutiveEmptyBlocks())
+
+	chain.GenerateEmptyBlocks(3)
+	require.Equal(uint32(3), appState.State.ConsecutiveEmptyBlocks())
+	require.Equal(epoch, appState.State.Epoch())
+
+	chain.GenerateEmptyBlocks(1)
+	require.True(chain.GetCurrentHead().Flags().HasFlag(types.ValidationFinished))
+	require.Equal(epoch+1, appState.State.Epoch())
+	require.Equal(chain.GetCurrentHead().Height(), appState.State.EpochBlock())
+	require.Zero(appState.State.ConsecutiveEmptyBlocks())
+}
+
+func TestBlockchain_MaxEmptyBlocksPerEpochBeforeFork(t *testing.T) {
+	require := require.New(t)
+	key, _ := crypto.GenerateKey()
+	addr := crypto.PubkeyToAddress(key.PublicKey)
+	cfg := NewTestConfig(addr, map[common.Address]config.GenesisAllocation{
+		addr: {State: uint8(state.Verified)},
+	})
+	cfg.Consensus.MaxEmptyBlocksPerEpoch = 1
+	cfg.Consensus.ForkHeight = config.ForkNotScheduled
+	chain, appState := NewCustomTestBlockchainWithConfig(0, 0, key, cfg)
+	cfg.Consensus.ForkHeight = chain.GetCurrentHead().Height() + 6
+	epoch := appState.State.Epoch()
+
+	chain.GenerateEmptyBlocks(5)
+	require.Zero(appState.State.ConsecutiveEmptyBlocks())
+	require.Equal(epoch, appState.State.Epoch())
+
+	chain.GenerateEmptyBlocks(1)
+	require.Equal(uint32(1), appState.State.ConsecutiveEmptyBlocks())
+	require.Equal(epoch, appState.State.Epoch())
+}
+
+// run with -race to check that head access is synchronized
+func TestBlockchain_GetCurrentHead(t *testing.T) {
+	require := require.New(t)
+	chain, _ := NewTestBlockchainWithBlocks(1, 0)
+	height := chain.GetCurrentHead().Height()
+
+	done := make(chan bool)
+	go func() {
+		headsFound := true
+		for i := 0; i < 100; i++ {
+			headsFound = headsFound && chain.GetCurrentHead() != nil
+			chain.Round()
+		}
+		done <- headsFound
+	}()
+	chain.GenerateBlocks(5)
+	require.True(<-done)
+
+	require.Equal(height+5, chain.GetCurrentHead().Height())
+	require.Equal(chain.GetCurrentHead().Height()+1, chain.Round())
+}
+
+func TestBlockchain_GetBlockCert(t *testing.T) {
+	require := require.New(t)
+	chain, _ := NewTestBlockchainWithBlocks(1, 0)
+	hash := common.Hash{0x1}
+
+	_, err := chain.GetBlockCert(hash)
+	require.Equal(ErrCertNotFound, err)
+
+	cert := &types.BlockCert{
+		Round:     10,
+		Step:      3,
+		VotedHash: common.Hash{0x2},
+		Signatures: []*types.BlockCertSignature{
+			{Signature: []byte{0x1, 0x2, 0x3}},
+			{TurnOffline: true, Upgrade: 2, Signature: []byte{0x4, 0x5}},
+		},
+	}
+	chain.WriteCertificate(hash, cert, true)
+
+	stored, err := chain.GetBlockCert(hash)
+	require.NoError(err)
+	require.Equal(cert, stored)
+}
+
+func signedTestTxs(count int) ([]*types.Transaction, []common.Address) {
+	var txs []*types.Transaction
+	var senders []common.Address
+	for i := 0; i < count; i++ {
+		key, _ := crypto.GenerateKey()
+		to := tests.GetRandAddr()
+		tx, _ := types.SignTx(&types.Transaction{
+			AccountNonce: 1,
+			Type:         types.SendTx,
+			To:           &to,
+			Amount:       big.NewInt(int64(i)),
+		}, key)
+		txs = append(txs, tx)
+		senders = append(senders, crypto.PubkeyToAddress(key.PublicKey))
+	}
+	return txs, senders
+}
+
+// copyTxs returns transactions without cached senders
+func copyTxs(txs []*types.Transaction) []*types.Transaction {
+	result := make([]*types.Transaction, 0, len(txs))
+	for _, tx := range txs {
+		result = append(result, &types.Transaction{
+			AccountNonce: tx.AccountNonce,
+			Type:         tx.Type,
+			To:           tx.To,
+			Amount:       tx.Amount,
+			Signature:    tx.Signature,
+		})
+	}
+	return result
+}
+
+func Test_recoverSenders(t *testing.T) {
+	txs, senders := signedTestTxs(50)
+	txs = append(txs, &types.Transaction{Signature: []byte{0x1}})
+	recoverSenders(txs)
+	for i, sender := range senders {
+		addr, err := types.Sender(txs[i])
+		require.NoError(t, err)
+		require.Equal(t, sender, addr)
+	}
+	_, err := types.Sender(txs[len(txs)-1])
+	require.Error(t, err)
+}
+
+func Benchmark_recoverSenders(b *testing.B) {
+	txs, _ := signedTestTxs(3000)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		batch := copyTxs(txs)
+		b.StartTimer()
+		recoverSenders(batch)
+	}
+}
+
+func Benchmark_recoverSendersSequential(b *testing.B) {
+	txs, _ := signedTestTxs(3000)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		batch := copyTxs(txs)
+		b.StartTimer()
+		for _, tx := range batch {
+			types.Sender(tx)
+		}
+	}
+}
+
+func TestBlockchain_ReplayBlock(t *testing.T) {
+	require := require.New(t)
+	chain, appState := NewTestBlockchainWithBlocks(5, 5)
+	require.True(chain.GetCurrentHead().Height() > 10)
+
+	for height := chain.genesis.Height() + 1; height <= chain.genesis.Height()+10; height++ {
+		block := chain.GetBlockByHeight(height)
+		startState, err := appState.ForCheck(height - 1)
+		require.NoError(err)
+		replayed, err := chain.ReplayBlock(block, startState)
+		require.NoError(err)
+		require.Equal(block.Root(), replayed.State.Root())
+		require.Equal(block.IdentityRoot(), replayed.IdentityState.Root())
+	}
+	require.Equal(int64(chain.GetCurrentHead().Height()), appState.State.Version())
+
+	block := chain.GetBlockByHeight(chain.GetCurrentHead().Height())
+	startState, err := appState.ForCheck(block.Height() - 1)
+	require.NoError(err)
+	state, err := chain.ReplayBlock(block, startState)
+	require.NoError(err)
+	state.State.SetBalance(tests.GetRandAddr(), big.NewInt(1))
+	require.Equal(chain.GetCurrentHead().Root(), appState.State.Root())
+
+	// uncommitted changes of the start state are replayed too, the start state itself is not modified
+	addr := tests.GetRandAddr()
+	startState.State.SetBalance(addr, big.NewInt(1))
+	state, err = chain.ReplayBlock(block, startState)
+	require.Error(err)
+	require.Equal(big.NewInt(1), state.State.GetBalance(addr))
+	require.Equal(chain.GetBlockHeaderByHeight(block.Height()-1).Root(), startState.State.Root())
+
+	startState.State.Reset()
+	_, err = chain.ReplayBlock(block, startState)
+	require.NoError(err)
+
+	forged := chain.GetBlockByHeight(chain.genesis.Height() + 1)
+	forged.Header.ProposedHeader.Root = common.Hash{0x1}
+	startState, err = appState.ForCheck(forged.Height() - 1)
+	require.NoError(err)
+	state, err = chain.ReplayBlock(forged, startState)
+	require.Error(err)
+	require.NotNil(state)
+}
+
+func TestBlockchain_GetNetworkStats(t *testing.T) {
+	require := require.New(t)
+	key, _ := crypto.GenerateKey()
+	addr := crypto.PubkeyToAddress(key.PublicKey)
+	cfg := NewTestConfig(addr, map[common.Address]config.GenesisAllocation{
+		addr: {
+			State:   uint8(state.Verified),
+			Balance: new(big.Int).Mul(common.DnaBase, big.NewInt(100)),
+		},
+		tests.GetRandAddr(): {State: uint8(state.Candidate)},
+	})
+	chain, appState := NewCustomTestBlockchainWithConfig(2, 0, key, cfg)
+
+	stats, err := chain.GetNetworkStats()
+	require.NoError(err)
+	require.Equal(chain.GetCurrentHead().Height(), stats.CurrentHeight)
+	require.Equal(chain.genesis.Hash(), stats.GenesisHash)
+	require.Equal(types.Network(0x99), stats.Network)
+	require.Equal(uint16(0), stats.EpochNumber)
+	require.Equal(1, stats.ValidatorCount)
+	require.Equal(1, stats.CandidateCount)
+	require.Zero(stats.PendingTxCount)
+	require.Equal(chain.GetCurrentHead().Time(), stats.LastBlockTime.Unix())
+
+	data, err := json.Marshal(stats)
+	require.NoError(err)
+	decoded := new(NetworkStats)
+	require.NoError(json.Unmarshal(data, decoded))
+	require.Equal(stats, decoded)
+
+	for i := 1; i <= 2; i++ {
+		tx, _ := types.SignTx(BuildTx(appState, addr, &addr, types.SendTx, decimal.New(1, 0), decimal.New(20, 0), decimal.Zero, uint32(i), 0, nil), key)
+		require.NoError(chain.txpool.Add(tx))
+	}
+	stats, err = chain.GetNetworkStats()
+	require.NoError(err)
+	require.Equal(2, stats.PendingTxCount)
+}
+
+func TestBlockchain_GenesisTimestamp(t *testing.T) {
+	require := require.New(t)
+	key, _ := crypto.GenerateKey()
+	const genesisTimestamp = int64(1600000000)
+	cfg := NewTestConfig(crypto.PubkeyToAddress(key.PublicKey), nil)
+	cfg.GenesisConf.GenesisTimestamp = genesisTimestamp
+	chain, _ := NewCustomTestBlockchainWithConfig(3, 0, key, cfg)
+
+	require.Equal(genesisTimestamp, chain.genesis.Time())
+	require.Equal(genesisTimestamp, chain.GetBlockHeaderByHeight(chain.genesis.Height()).Time())
+
+	_, err := chain.GetBlockAtTime(time.Unix(genesisTimestamp-1, 0))
+	require.Equal(ErrBeforeGenesis, err)
+	block, err := chain.GetBlockAtTime(time.Unix(genesisTimestamp, 0))
+	require.NoError(err)
+	require.Equal(chain.genesis.Hash(), block.Hash())
+}
+
+func TestBlockchain_GetUnusedInvites(t *testing.T) {
+	require := require.New(t)
+	key, _ := crypto.GenerateKey()
+	inviterKey, _ := crypto.GenerateKey()
+	addr := crypto.PubkeyToAddress(inviterKey.PublicKey)
+	// the god address proposes blocks while there are no online identities, its invites are not counted
+	cfg := NewTestConfig(crypto.PubkeyToAddress(key.PublicKey), map[common.Address]config.GenesisAllocation{
+		addr: {State: uint8(state.Verified), Balance: new(big.Int).Mul(common.DnaBase, big.NewInt(100))},
+	})
+	cfg.Consensus.MaxEmptyBlocksPerEpoch = 1
+	chain, appState := NewCustomTestBlockchainWithConfig(1, 0, key, cfg)
+
+	invites, err := chain.GetUnusedInvites()
+	require.NoError(err)
+	require.Empty(invites)
+
+	expected := map[common.Address]uint8{
+		addr:                2,
+		tests.GetRandAddr(): 1,
+		tests.GetRandAddr(): 3,
+		tests.GetRandAddr(): 4,
+	}
+	withoutInvites := tests.GetRandAddr()
+	// validation ceremony is emulated by granting invites
+	chain.applyNewEpochFn = func(height uint64, appState *appstate.AppState, collector collector.StatsCollector) (int, *types.ValidationResults, bool) {
+		for identity, count := range expected {
+			appState.State.SetState(identity, state.Verified)
+			appState.State.SetInvites(identity, count)
+		}
+		appState.State.SetState(withoutInvites, state.Verified)
+		return appState.ValidatorsCache.NetworkSize(), &types.ValidationResults{}, true
+	}
+	chain.GenerateEmptyBlocks(2)
+
+	invites, err = chain.GetUnusedInvites()
+	require.NoError(err)
+	require.Equal(expected, invites)
+	require.Equal(10, chain.GetTotalUnusedInvites())
+
+	// returned map is a copy
+	invites[addr] = 0
+	require.Equal(10, chain.GetTotalUnusedInvites())
+
+	invitee := tests.GetRandAddr()
+	inviteTx, _ := types.SignTx(BuildTx(appState, addr, &invitee, types.InviteTx, decimal.Zero, decimal.New(2, 0), decimal.Zero, 0, 0, nil), inviterKey)
+	require.NoError(chain.txpool.Add(inviteTx))
+	chain.GenerateBlocks(1)
+
+	invites, err = chain.GetUnusedInvites()
+	require.NoError(err)
+	require.Equal(uint8(1), invites[addr])
+	require.NotContains(invites, invitee)
+	require.Equal(9, chain.GetTotalUnusedInvites())
+}
+
+func TestBlockchain_GetIdentityHistory(t *testing.T) {
+	require := require.New(t)
+	key, _ := crypto.GenerateKey()
+	addr := crypto.PubkeyToAddress(key.PublicKey)
+	cfg := NewTestConfig(addr, map[common.Address]config.GenesisAllocation{
+		addr: {State: uint8(state.Verified)},
+	})
+	cfg.Consensus.MaxEmptyBlocksPerEpoch = 1
+	chain, appState := NewCustomTestBlockchainWithConfig(1, 0, key, cfg)
+
+	inviteKey, _ := crypto.GenerateKey()
+	inviteAddr := crypto.PubkeyToAddress(inviteKey.PublicKey)
+	candidateKey, _ := crypto.GenerateKey()
+	candidate := crypto.PubkeyToAddress(candidateKey.PublicKey)
+
+	// validation ceremony is emulated by verifying the candidate
+	chain.applyNewEpochFn = func(height uint64, appState *appstate.AppState, collector collector.StatsCollector) (int, *types.ValidationResults, bool) {
+		appState.State.SetState(candidate, state.Verified)
+		appState.State.AddStake(candidate, new(big.Int).Mul(common.DnaBase, big.NewInt(100)))
+		return appState.ValidatorsCache.NetworkSize(), &types.ValidationResults{}, true
+	}
+
+	inviteTx, _ := chain.secStore.SignTx(BuildTx(appState, addr, &inviteAddr, types.InviteTx, decimal.Zero, decimal.New(2, 0), decimal.Zero, 0, 0, nil))
+	require.NoError(chain.txpool.Add(inviteTx))
+	chain.GenerateBlocks(1)
+	inviteHeight := chain.GetCurrentHead().Height()
+
+	activationTx, _ := types.SignTx(BuildTx(appState, inviteAddr, &candidate, types.ActivationTx, decimal.Zero, decimal.Zero, decimal.Zero, 0, 0,
+		crypto.FromECDSAPub(&candidateKey.PublicKey)), inviteKey)
+	require.NoError(chain.txpool.Add(activationTx))
+	chain.GenerateBlocks(1)
+	activationHeight := chain.GetCurrentHead().Height()
+
+	chain.GenerateEmptyBlocks(2)
+	require.Equal(state.Verified, appState.State.GetIdentityState(candidate))
+	validationHeight := chain.GetCurrentHead().Height()
+
+	killTx, _ := types.SignTx(BuildTx(appState, candidate, &addr, types.KillTx, decimal.Zero, decimal.New(20, 0), decimal.Zero, 0, 0, nil), candidateKey)
+	require.NoError(chain.txpool.Add(killTx))
+	chain.GenerateBlocks(1)
+	killHeight := chain.GetCurrentHead().Height()
+
+	history, err := chain.GetIdentityHistory(candidate)
+	require.NoError(err)
+	require.Equal([]IdentityStateChange{
+		{Block: activationHeight, OldState: state.Undefined, NewState: state.Candidate, TxHash: activationTx.Hash()},
+		{Block: validationHeight, OldState: state.Candidate, NewState: state.Verified},
+		{Block: killHeight, OldState: state.Verified, NewState: state.Killed, TxHash: killTx.Hash()},
+	}, history)
+
+	history, err = chain.GetIdentityHistory(inviteAddr)
+	require.NoError(err)
+	require.Equal([]IdentityStateChange{
+		{Block: inviteHeight, OldState: state.Undefined, NewState: state.Invite, TxHash: inviteTx.Hash()},
+		{Block: activationHeight, OldState: state.Invite, NewState: state.Killed, TxHash: activationTx.Hash()},
+	}, history)
+
+	history, err = chain.GetIdentityHistory(tests.GetRandAddr())
+	require.NoError(err)
+	require.Empty(history)
+}
+
+func TestBlockchain_ExportState(t *testing.T) {
+	require := require.New(t)
+	key, _ := crypto.GenerateKey()
+	addr := crypto.PubkeyToAddress(key.PublicKey)
+	alloc := make(map[common.Address]config.GenesisAllocation)
+	for i := 0; i < 50; i++ {
+		alloc[tests.GetRandAddr()] = config.GenesisAllocation{
+			Balance: big.NewInt(int64(i + 1)),
+			State:   uint8(state.Verified),
+		}
+	}
+	alloc[addr] = config.GenesisAllocation{State: uint8(state.Verified)}
+	cfg := NewTestConfig(addr, alloc)
+	chain, appState := NewCustomTestBlockchainWithConfig(5, 2, key, cfg)
+
+	buf := new(bytes.Buffer)
+	require.NoError(chain.ExportState(buf))
+	snapshotHash := crypto.Keccak256Hash(buf.Bytes())
+
+	db := dbm.NewMemDB()
+	bus := eventbus.New()
+	importedAppState := appstate.NewAppState(db, bus)
+	secStore := secstore.NewSecStore()
+	secStore.AddKey(crypto.FromECDSA(key))
+	cfg.OfflineDetection = config.GetDefaultOfflineDetectionConfig()
+	txPool := mempool.NewTxPool(importedAppState, bus, config.GetDefaultMempoolConfig(), cfg.Consensus)
+	offline := NewOfflineDetector(cfg, db, importedAppState, secStore, bus)
+	keyStore := keystore.NewKeyStore("./testdata", keystore.StandardScryptN, keystore.StandardScryptP)
+	imported, err := NewBlockchain(cfg, db, WithRequiredDefaults(txPool, importedAppState, ipfs.NewMemoryIpfsProxy(), secStore, bus, offline, keyStore))
+	require.NoError(err)
+
+	// snapshot not matching the exported roots is rejected
+	header := new(stateExportHeader)
+	require.NoError(rlp.NewStream(bytes.NewReader(buf.Bytes()), 0).Decode(header))
+	headerBytes, _ := rlp.EncodeToBytes(header)
+	tamperedHeader := *header
+	tamperedHeader.Root = common.Hash{0x1}
+	tampered, _ := rlp.EncodeToBytes(&tamperedHeader)
+	tampered = append(tampered, buf.Bytes()[len(headerBytes):]...)
+	require.Error(imported.ImportState(bytes.NewReader(tampered)))
+	require.Nil(imported.repo.ReadHead())
+
+	require.NoError(imported.ImportState(bytes.NewReader(buf.Bytes())))
+	require.NoError(imported.InitializeChain())
+	require.NoError(importedAppState.Initialize(imported.GetCurrentHead().Height()))
+	txPool.Initialize(imported.GetCurrentHead(), secStore.GetAddress())
+
+	require.Equal(chain.GetCurrentHead().Height(), imported.GetCurrentHead().Height())
+	require.Equal(imported.GetCurrentHead().Hash(), imported.Genesis().Hash())
+	require.Equal(snapshotHash, imported.GetCurrentHead().ParentHash())
+	require.Equal(header.Root, imported.GetCurrentHead().Root())
+	require.Equal(header.IdentityRoot, imported.GetCurrentHead().IdentityRoot())
+	require.Equal(chain.GetCurrentHead().Seed(), imported.GetCurrentHead().Seed())
+	require.Equal(appState.State.Epoch(), importedAppState.State.Epoch())
+	require.Equal(appState.State.EpochBlock(), importedAppState.State.EpochBlock())
+	require.Equal(appState.State.NextValidationTime(), importedAppState.State.NextValidationTime())
+	require.Equal(appState.State.GodAddress(), importedAppState.State.GodAddress())
+	for address, item := range alloc {
+		require.Equal(appState.State.GetBalance(address), importedAppState.State.GetBalance(address))
+		require.Equal(state.IdentityState(item.State), importedAppState.State.GetIdentityState(address))
+		require.True(importedAppState.IdentityState.IsApproved(address))
+	}
+	require.Equal(appState.ValidatorsCache.NetworkSize(), importedAppState.ValidatorsCache.NetworkSize())
+
+	importedChain := &TestBlockchain{db, imported}
+	importedChain.GenerateBlocks(2)
+	require.Equal(chain.GetCurrentHead().Height()+2, imported.GetCurrentHead().Height())
+
+	require.Error(imported.ImportState(bytes.NewReader(buf.Bytes())))
+}
+
+func TestTxPool_PersistAcrossRestart(t *testing.T) {
+	require := require.New(t)
+	key, _ := crypto.GenerateKey()
+	addr := crypto.PubkeyToAddress(key.PublicKey)
+	cfg := NewTestConfig(addr, map[common.Address]config.GenesisAllocation{
+		addr: {State: uint8(state.Verified), Balance: new(big.Int).Mul(common.DnaBase, big.NewInt(100))},
+	})
+	cfg.Consensus.MaxEmptyBlocksPerEpoch = 1
+	chain, appState := NewCustomTestBlockchainWithConfig(1, 0, key, cfg)
+	chain.applyNewEpochFn = func(height uint64, appState *appstate.AppState, collector collector.StatsCollector) (int, *types.ValidationResults, bool) {
+		return appState.ValidatorsCache.NetworkSize(), &types.ValidationResults{}, true
+	}
+	db := dbm.NewMemDB()
+	restart := func() *mempool.TxPool {
+		pool := mempool.NewTxPool(appState, eventbus.New(), config.GetDefaultMempoolConfig(), cfg.Consensus)
+		pool.Initialize(chain.GetCurrentHead(), addr)
+		require.NoError(pool.LoadPersisted(db))
+		return pool
+	}
+
+	to := tests.GetRandAddr()
+	var txs []*types.Transaction
+	for i := 0; i < 3; i++ {
+		tx, _ := chain.secStore.SignTx(BuildTx(appState, addr, &to, types.SendTx, decimal.New(1, 0), decimal.New(20, 0), decimal.Zero, 0, 0, nil))
+		require.NoError(chain.txpool.Add(tx))
+		txs = append(txs, tx)
+	}
+	require.NoError(chain.txpool.Persist(db))
+
+	pool := restart()
+	require.Len(pool.GetPendingTransaction(), len(txs))
+	for _, tx := range txs {
+		require.NotNil(pool.GetTx(tx.Hash()))
+	}
+	require.Len(pool.BuildBlockTransactions(), len(txs))
+
+	// persisted data is consumed by loading
+	require.Empty(restart().GetPendingTransaction())
+
+	require.NoError(pool.Persist(db))
+	epoch := appState.State.Epoch()
+	chain.GenerateEmptyBlocks(2)
+	require.Equal(epoch+1, appState.State.Epoch())
+
+	// txs of the previous epoch are stale after the restart
+	require.Empty(restart().GetPendingTransaction())
+}
+
+func TestBlockchain_BlockCache(t *testing.T) {
+	require := require.New(t)
+	chain, _ := NewTestBlockchainWithBlocks(50, 10)
+	head := chain.GetCurrentHead().Height()
+
+	require.True(chain.blockCache.Contains(chain.GetCurrentHead().Hash()))
+	require.Equal(chain.GetCurrentHead().Hash(), chain.GetHead().Hash())
+	// cached headers are returned as copies
+	cachedHead := chain.GetHead()
+	cachedHead.EmptyBlockHeader, cachedHead.ProposedHeader = nil, nil
+	require.Equal(chain.GetCurrentHead().Hash(), chain.GetHead().Hash())
+	require.True(chain.blockCache.Contains(chain.GetBlockHeaderByHeight(head - blockCacheDepth + 1).Hash()))
+	deepHash := chain.GetBlockHeaderByHeight(head - blockCacheDepth).Hash()
+	require.False(chain.blockCache.Contains(deepHash))
+
+	block := chain.GetBlockByHeight(head - blockCacheDepth)
+	require.Equal(deepHash, block.Hash())
+	require.True(chain.blockCache.Contains(deepHash))
+	require.Equal(deepHash, chain.GetBlock(deepHash).Hash())
+
+	headHash := chain.GetCurrentHead().Hash()
+	require.NoError(chain.ResetTo(head - 1))
+	require.False(chain.blockCache.Contains(headHash))
+	require.Nil(chain.GetBlock(headHash))
+}
+
+func TestBlockchain_CacheHitRate(t *testing.T) {
+	require := require.New(t)
+	chain, _ := NewTestBlockchainWithBlocks(20, 0)
+	chain.metrics = new(ChainMetrics)
+	require.Zero(chain.CacheHitRate())
+
+	chain.blockCache.Purge()
+	chain.GetBlockByHeight(5)
+	require.Zero(chain.CacheHitRate())
+	chain.GetBlockByHeight(5)
+	require.Equal(0.5, chain.CacheHitRate())
+	require.Equal(chain.GetBlockByHeight(5).Header, chain.GetBlockHeaderByHeight(5))
+	require.Equal(0.75, chain.CacheHitRate())
+	require.Equal(uint64(3), chain.Metrics().Snapshot().BlockCacheHits)
+}
+
+func BenchmarkBlockchain_GetBlock(b *testing.B) {
+	chain, _ := NewTestBlockchainWithBlocks(90, 10)
+	hashes := chain.GetTopBlockHashes(100)
+	for _, hash := range hashes {
+		chain.GetBlock(hash)
+	}
+	b.Run("uncached", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			chain.readBlock(hashes[i%len(hashes)])
+		}
+	})
+	b.Run("cached", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			chain.GetBlock(hashes[i%len(hashes)])
+		}
+	})
+}
+
+// BenchmarkBlockchain_Replay reads 500 canonical blocks in a row together with their parents as sync does
+func BenchmarkBlockchain_Replay(b *testing.B) {
+	chain, _ := NewTestBlockchainWithBlocks(500, 0)
+	head := chain.GetCurrentHead().Height()
+	replay := func(getBlock func(height uint64) *types.Block) {
+		for height := head - 499; height <= head; height++ {
+			if getBlock(height) == nil || getBlock(height-1) == nil {
+				b.Fatal("block is not found")
+			}
+		}
+	}
+	b.Run("uncached", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			replay(func(height uint64) *types.Block {
+				return chain.readBlock(chain.repo.ReadCanonicalHash(height))
+			})
+		}
+	})
+	b.Run("cached", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			chain.blockCache.Purge()
+			replay(chain.GetBlockByHeight)
+		}
+	})
+}
+
+func TestBlockchain_ForkAtHeight(t *testing.T) {
+	require := require.New(t)
+	key, _ := crypto.GenerateKey()
+	addr := crypto.PubkeyToAddress(key.PublicKey)
+	cfg := NewTestConfig(addr, map[common.Address]config.GenesisAllocation{
+		addr: {State: uint8(state.Verified), Balance: new(big.Int).Mul(common.DnaBase, big.NewInt(100))},
+	})
+	chain, appState := NewCustomTestBlockchainWithConfig(8, 0, key, cfg)
+
+	_, err := chain.ForkAtHeight(chain.GetCurrentHead().Height() + 1)
+	require.Error(err)
+
+	fork, err := chain.ForkAtHeight(5)
+	require.NoError(err)
+	require.Equal(uint64(5), fork.GetCurrentHead().Height())
+	require.Equal(chain.GetBlockHeaderByHeight(5).Hash(), fork.GetCurrentHead().Hash())
+	require.Nil(fork.GetBlockHeaderByHeight(6))
+	require.Equal(chain.Genesis(), fork.Genesis())
+
+	forkChain := &TestBlockchain{Blockchain: fork}
+	to := tests.GetRandAddr()
+	tx, _ := fork.secStore.SignTx(BuildTx(fork.appState, addr, &to, types.SendTx, decimal.New(1, 0), decimal.New(20, 0), decimal.Zero, 0, 0, nil))
+	require.NoError(fork.txpool.Add(tx))
+	forkChain.GenerateBlocks(5)
+	chain.GenerateBlocks(1)
+
+	require.Equal(uint64(10), fork.GetCurrentHead().Height())
+	require.Equal(uint64(10), chain.GetCurrentHead().Height())
+	require.Equal(chain.GetBlockHeaderByHeight(5).Hash(), fork.GetBlockHeaderByHeight(5).Hash())
+	require.NotEqual(chain.GetBlockHeaderByHeight(6).Hash(), fork.GetBlockHeaderByHeight(6).Hash())
+	require.Nil(chain.GetTxIndex(tx.Hash()))
+	require.NotNil(fork.GetTxIndex(tx.Hash()))
+	require.Equal(0, appState.State.GetBalance(to).Sign())
+	require.Equal(common.DnaBase, fork.appState.State.GetBalance(to))
+}
+
+func TestBlockchain_CanonicalTxOrder(t *testing.T) {
+	require := require.New(t)
+	key, _ := crypto.GenerateKey()
+	addr := crypto.PubkeyToAddress(key.PublicKey)
+	var senders []*ecdsa.PrivateKey
+	alloc := map[common.Address]config.GenesisAllocation{
+		addr: {State: uint8(state.Verified)},
+	}
+	for i := 0; i < 3; i++ {
+		senderKey, _ := crypto.GenerateKey()
+		senders = append(senders, senderKey)
+		alloc[crypto.PubkeyToAddress(senderKey.PublicKey)] = config.GenesisAllocation{Balance: new(big.Int).Mul(common.DnaBase, big.NewInt(100))}
+	}
+	cfg := NewTestConfig(addr, alloc)
+	chain, appState := NewCustomTestBlockchainWithConfig(1, 0, key, cfg)
+	chain2, _ := chain.Copy()
+	chain2.txpool.Initialize(chain2.GetCurrentHead(), addr)
+
+	to := tests.GetRandAddr()
+	var txs []*types.Transaction
+	for i, senderKey := range senders {
+		sender := crypto.PubkeyToAddress(senderKey.PublicKey)
+		for nonce := uint32(1); nonce <= 2; nonce++ {
+			// payload makes fees of senders differ
+			tx, _ := types.SignTx(BuildTx(appState, sender, &to, types.SendTx, decimal.New(1, 0), decimal.New(20, 0), decimal.Zero, nonce, 0,
+				make([]byte, (len(senders)-i)*10*int(nonce))), senderKey)
+			txs = append(txs, tx)
+		}
+	}
+	for i := range txs {
+		require.NoError(chain.txpool.Add(txs[i]))
+		require.NoError(chain2.txpool.Add(txs[len(txs)-1-i]))
+	}
+
+	block := chain.ProposeBlock([]byte{}).Block
+	block2 := chain2.ProposeBlock([]byte{}).Block
+	require.Len(block.Body.Transactions, len(txs))
+	require.Equal(block.Header.ProposedHeader.TxHash, block2.Header.ProposedHeader.TxHash)
+	require.NoError(chain.ValidateBlock(block, nil))
+
+	blockTxs := block.Body.Transactions
+	blockTxs[0], blockTxs[1] = blockTxs[1], blockTxs[0]
+	block.Header.ProposedHeader.TxHash = types.DeriveSha(types.Transactions(blockTxs))
+	require.Equal(ErrTxOutOfOrder, chain.ValidateBlock(block, nil))
+
+	// blocks built in mempool order were valid before the fork, the block fails later checks since it was built after the fork
+	cfg.Consensus.ForkHeight = config.ForkNotScheduled
+	require.NotEqual(ErrTxOutOfOrder, chain.ValidateBlock(block, nil))
+}
+
+func TestBlockchain_GetCommitteeSizeHistory(t *testing.T) {
+	require := require.New(t)
+	chain, appState := NewTestBlockchainWithBlocks(5, 5)
+
+	history, err := chain.GetCommitteeSizeHistory(20)
+	require.NoError(err)
+	require.Len(history, int(chain.GetCurrentHead().Height())-1)
+	for i, record := range history {
+		require.Equal(chain.GetCurrentHead().Height()-uint64(i), record.BlockHeight)
+		require.Equal(appState.ValidatorsCache.NetworkSize(), record.NetworkSize)
+		require.Equal(chain.GetCommitteeSize(appState.ValidatorsCache, true), record.CommitteeSize)
+	}
+	history, err = chain.GetCommitteeSizeHistory(3)
+	require.NoError(err)
+	require.Len(history, 3)
+	_, err = chain.GetCommitteeSizeHistory(-1)
+	require.Error(err)
+
+	for _, tc := range []struct {
+		online   int
+		expected int
+	}{{0, 0}, {1, 1}, {8, 8}, {9, 6}, {100, 70}, {200, 100}} {
+		identityState := state.NewLazyIdentityState(dbm.NewMemDB())
+		for i := 0; i < tc.online; i++ {
+			addr := tests.GetRandAddr()
+			identityState.GetOrNewIdentityObject(addr).SetState(true)
+			identityState.SetOnline(addr, true)
+		}
+		identityState.Commit(false)
+		vc := validators.NewValidatorsCache(identityState, common.Address{})
+		vc.Load()
+		require.Equal(tc.online, vc.OnlineSize())
+		require.Equal(tc.expected, chain.GetCommitteeSize(vc, true), "online: %v", tc.online)
+	}
+}
+
+func TestBlockchain_VerifySupplyInvariant(t *testing.T) {
+	require := require.New(t)
+	key, _ := crypto.GenerateKey()
+	addr := crypto.PubkeyToAddress(key.PublicKey)
+	senderKey, _ := crypto.GenerateKey()
+	sender := crypto.PubkeyToAddress(senderKey.PublicKey)
+	cfg := NewTestConfig(addr, map[common.Address]config.GenesisAllocation{
+		addr:   {State: uint8(state.Verified), Balance: new(big.Int).Mul(common.DnaBase, big.NewInt(100)), Stake: new(big.Int).Mul(common.DnaBase, big.NewInt(10))},
+		sender: {Balance: new(big.Int).Mul(common.DnaBase, big.NewInt(100))},
+	})
+	cfg.Consensus.MaxEmptyBlocksPerEpoch = 1
+	cfg.Consensus.StakeInterestRate = 0.1
+	chain, appState := NewCustomTestBlockchainWithConfig(1, 0, key, cfg)
+	require.NoError(chain.VerifySupplyInvariant())
+
+	inviteKey, _ := crypto.GenerateKey()
+	inviteAddr := crypto.PubkeyToAddress(inviteKey.PublicKey)
+	candidateKey, _ := crypto.GenerateKey()
+	candidate := crypto.PubkeyToAddress(candidateKey.PublicKey)
+
+	chain.applyNewEpochFn = func(height uint64, appState *appstate.AppState, collector collector.StatsCollector) (int, *types.ValidationResults, bool) {
+		return appState.ValidatorsCache.NetworkSize(), &types.ValidationResults{}, true
+	}
+
+	sendTx, _ := types.SignTx(BuildTx(appState, sender, &addr, types.SendTx, decimal.New(5, 0), decimal.New(20, 0), decimal.New(1, 0), 1, 0, nil), senderKey)
+	burnTx, _ := types.SignTx(BuildTx(appState, sender, nil, types.BurnTx, decimal.New(3, 0), decimal.New(20, 0), decimal.Zero, 2, 0, attachments.CreateBurnAttachment("key")), senderKey)
+	inviteTx, _ := chain.secStore.SignTx(BuildTx(appState, addr, &inviteAddr, types.InviteTx, decimal.New(1, 0), decimal.New(20, 0), decimal.Zero, 0, 0, nil))
+	require.NoError(chain.txpool.Add(sendTx))
+	require.NoError(chain.txpool.Add(burnTx))
+	require.NoError(chain.txpool.Add(inviteTx))
+	chain.GenerateBlocks(1)
+	require.Len(chain.GetBlock(chain.GetCurrentHead().Hash()).Body.Transactions, 3)
+	require.NoError(chain.VerifySupplyInvariant())
+
+	activationTx, _ := types.SignTx(BuildTx(appState, inviteAddr, &candidate, types.ActivationTx, decimal.Zero, decimal.Zero, decimal.Zero, 0, 0,
+		crypto.FromECDSAPub(&candidateKey.PublicKey)), inviteKey)
+	require.NoError(chain.txpool.Add(activationTx))
+	chain.GenerateBlocks(1)
+	require.Equal(state.Candidate, appState.State.GetIdentityState(candidate))
+	require.NoError(chain.VerifySupplyInvariant())
+
+	epoch := appState.State.Epoch()
+	chain.GenerateEmptyBlocks(2)
+	require.Equal(epoch+1, appState.State.Epoch())
+	minted, burnt := chain.supplyTotals(chain.GetCurrentHead().Height())
+	require.True(minted.Cmp(cfg.Consensus.BlockReward) > 0)
+	require.True(burnt.Sign() > 0)
+	require.Nil(chain.repo.ReadSupplyRecord(chain.GetCurrentHead().Height() + 1))
+	require.NoError(chain.VerifySupplyInvariant())
+
+	// coins credited without being accounted as minted break the invariant
+	chain.applyNewEpochFn = func(height uint64, appState *appstate.AppState, collector collector.StatsCollector) (int, *types.ValidationResults, bool) {
+		appState.State.AddBalance(tests.GetRandAddr(), common.DnaBase)
+		return appState.ValidatorsCache.NetworkSize(), &types.ValidationResults{}, true
+	}
+	chain.GenerateEmptyBlocks(2)
+	require.Error(chain.VerifySupplyInvariant())
+}
+
+func TestBlockchain_MaxBlockSize(t *testing.T) {
+	require := require.New(t)
+	key, _ := crypto.GenerateKey()
+	addr := crypto.PubkeyToAddress(key.PublicKey)
+	senderKey, _ := crypto.GenerateKey()
+	sender := crypto.PubkeyToAddress(senderKey.PublicKey)
+	cfg := NewTestConfig(addr, map[common.Address]config.GenesisAllocation{
+		addr:   {State: uint8(state.Verified)},
+		sender: {Balance: new(big.Int).Mul(common.DnaBase, big.NewInt(1000))},
+	})
+	chain, appState := NewCustomTestBlockchainWithConfig(1, 0, key, cfg)
+
+	to := tests.GetRandAddr()
+	var txs []*types.Transaction
+	for nonce := uint32(1); nonce <= 5; nonce++ {
+		tx, _ := types.SignTx(BuildTx(appState, sender, &to, types.SendTx, decimal.New(1, 0), decimal.New(100, 0), decimal.Zero, nonce, 0,
+			make([]byte, validation.MaxTxDataSize)), senderKey)
+		require.NoError(chain.txpool.Add(tx))
+		txs = append(txs, tx)
+	}
+	limit := blockHeaderSizeReserve + txs[0].SizeInBlock()*5/2
+
+	cfg.Consensus.MaxBlockSize = limit
+	block := chain.ProposeBlock([]byte{}).Block
+	require.Len(block.Body.Transactions, 2)
+	require.True(block.Size() <= limit)
+	require.NoError(chain.ValidateBlock(block, nil))
+
+	cfg.Consensus.MaxBlockSize = config.GetDefaultConsensusConfig().MaxBlockSize
+	block = chain.ProposeBlock([]byte{}).Block
+	require.Len(block.Body.Transactions, len(txs))
+	require.NoError(chain.ValidateBlock(block, nil))
+
+	cfg.Consensus.MaxBlockSize = block.Size() - 1
+	require.Equal(ErrBlockTooLarge, chain.ValidateBlock(block, nil))
+
+	// blocks of other nodes weren't limited before the fork, the block fails later checks since it was built after the fork
+	cfg.Consensus.ForkHeight = config.ForkNotScheduled
+	require.NotEqual(ErrBlockTooLarge, chain.ValidateBlock(block, nil))
+}
+
+func TestBlockchain_EstimateBlockTime(t *testing.T) {
+	require := require.New(t)
+	chain, _ := NewTestBlockchainWithBlocks(0, 0)
+
+	var intervals []time.Duration
+	current := time.Unix(1600000000, 0)
+	chain.blockIntervals = newBlockIntervals(blockIntervalsSize, func() time.Time {
+		return current
+	})
+	require.Equal(chain.config.Consensus.MinBlockDistance, chain.EstimateBlockTime())
+
+	for i := 0; i < 60; i++ {
+		interval := time.Duration(1+(i*7)%13) * time.Second
+		intervals = append(intervals, interval)
+		current = current.Add(interval)
+		chain.GenerateBlocks(1)
+		if i < blockIntervalsSize {
+			require.Equal(chain.config.Consensus.MinBlockDistance, chain.EstimateBlockTime())
+		}
+	}
+
+	// the first interval is not recorded since there is no previous insertion time for the first block
+	last := append([]time.Duration{}, intervals[len(intervals)-blockIntervalsSize:]...)
+	sort.Slice(last, func(i, j int) bool {
+		return last[i] < last[j]
+	})
+	expected := (last[blockIntervalsSize/2-1] + last[blockIntervalsSize/2]) / 2
+	require.InDelta(float64(expected), float64(chain.EstimateBlockTime()), float64(100*time.Millisecond))
+}
+
+func TestBlockchain_Metrics(t *testing.T) {
+	require := require.New(t)
+	key, _ := crypto.GenerateKey()
+	addr := crypto.PubkeyToAddress(key.PublicKey)
+	senderKey, _ := crypto.GenerateKey()
+	sender := crypto.PubkeyToAddress(senderKey.PublicKey)
+	cfg := NewTestConfig(addr, map[common.Address]config.GenesisAllocation{
+		addr:   {State: uint8(state.Verified)},
+		sender: {Balance: new(big.Int).Mul(common.DnaBase, big.NewInt(100))},
+	})
+	chain, appState := NewCustomTestBlockchainWithConfig(0, 0, key, cfg)
+	metrics := chain.Metrics()
+	require.Equal(ChainMetrics{}, metrics.Snapshot())
+
+	to := tests.GetRandAddr()
+	tx, _ := types.SignTx(BuildTx(appState, sender, &to, types.SendTx, decimal.Zero, decimal.New(20, 0), decimal.Zero, 0, 0, nil), senderKey)
+	require.NoError(chain.txpool.Add(tx))
+	chain.GenerateBlocks(2)
+	chain.GenerateEmptyBlocks(1)
+
+	invalid := chain.ProposeBlock([]byte{}).Block
+	invalid.Header.ProposedHeader.Time = chain.GetCurrentHead().Time() + 20
+	invalid.Header.ProposedHeader.Root = common.Hash{0x1}
+	require.Error(chain.AddBlock(invalid, nil, collector.NewStatsCollector()))
+
+	snapshot := metrics.Snapshot()
+	require.Equal(uint64(3), atomic.LoadUint64(&metrics.BlocksProcessed))
+	require.Equal(uint64(3), snapshot.BlocksProcessed)
+	require.Equal(uint64(1), snapshot.TxsProcessed)
+	require.Equal(uint64(1), snapshot.EmptyBlocks)
+	require.Equal(uint64(1), snapshot.ValidationErrors)
+	require.True(snapshot.LastBlockDuration > 0)
+	require.True(snapshot.PeakBlockDuration >= snapshot.LastBlockDuration)
+}
@@ -0,0 +1,86 @@
+package blockchain
+
+import (
+	"github.com/idena-network/idena-go/config"
+	"github.com/idena-network/idena-go/core/appstate"
+	"github.com/idena-network/idena-go/core/state"
+)
+
+// paramChangeQuorum is the share of the network which has to vote by ParamChangeTx for the same value to change a param
+const paramChangeQuorum = 2.0 / 3
+
+type headGovernedParams struct {
+	height uint64
+	params config.GovernedParams
+}
+
+// governedParams returns governable consensus params stored in the state, params never changed by governance keep
+// values of the config
+func (chain *Blockchain) governedParams(stateDB *state.StateDB) config.GovernedParams {
+	params := chain.config.Consensus.GovernedParams()
+	for _, param := range stateDB.ConsensusParams() {
+		if err := params.ApplyParam(param.Key, param.Value); err != nil {
+			chain.log.Warn("Failed to apply consensus param", "key", param.Key, "err", err)
+		}
+	}
+	return params
+}
+
+// refreshGovernedParams caches governable params of the head state, it is called whenever the head state changes
+func (chain *Blockchain) refreshGovernedParams() {
+	chain.headParams.Store(&headGovernedParams{
+		height: uint64(chain.appState.State.Version()),
+		params: chain.governedParams(chain.appState.State),
+	})
+}
+
+// GovernedParams returns governable consensus params in force after the current head
+func (chain *Blockchain) GovernedParams() config.GovernedParams {
+	head, ok := chain.headParams.Load().(*headGovernedParams)
+	if !ok {
+		return chain.config.Consensus.GovernedParams()
+	}
+	return head.params
+}
+
+// governedParamsAt returns governable consensus params in force after the block at the height
+func (chain *Blockchain) governedParamsAt(height uint64) (config.GovernedParams, error) {
+	if head, ok := chain.headParams.Load().(*headGovernedParams); ok && head.height == height {
+		return head.params, nil
+	}
+	appState, err := chain.appState.Readonly(height)
+	if err != nil {
+		return config.GovernedParams{}, err
+	}
+	return chain.governedParams(appState.State), nil
+}
+
+// applyParamChanges changes consensus params which got votes of the quorum of the network for the same value,
+// votes for the changed param are dropped and the new value is in force from the next block
+func (chain *Blockchain) applyParamChanges(appState *appstate.AppState) {
+	networkSize := appState.ValidatorsCache.NetworkSize()
+	if networkSize == 0 {
+		return
+	}
+	counts := make(map[string]map[string]int)
+	var keys []string
+	for _, vote := range appState.State.ConsensusParamVotes() {
+		if !appState.ValidatorsCache.Contains(vote.Voter) {
+			continue
+		}
+		if _, ok := counts[vote.Key]; !ok {
+			counts[vote.Key] = make(map[string]int)
+			keys = append(keys, vote.Key)
+		}
+		counts[vote.Key][string(vote.Value)]++
+	}
+	for _, key := range keys {
+		for value, count := range counts[key] {
+			if float64(count) > float64(networkSize)*paramChangeQuorum {
+				appState.State.SetConsensusParam(key, []byte(value))
+				appState.State.ClearConsensusParamVotes(key)
+				break
+			}
+		}
+	}
+}
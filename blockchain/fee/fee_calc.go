@@ -5,7 +5,9 @@ import (
 	"github.com/idena-network/idena-go/blockchain/types"
 	"github.com/idena-network/idena-go/common"
 	"github.com/idena-network/idena-go/common/math"
+	"github.com/idena-network/idena-go/config"
 	"github.com/shopspring/decimal"
+	math2 "math"
 	"math/big"
 )
 
@@ -36,8 +38,10 @@ func GetFeePerByteForNetwork(networkSize int) *big.Int {
 	return minFeePerByte
 }
 
-func CalculateFee(networkSize int, feePerByte *big.Int, tx *types.Transaction) *big.Int {
-	txFeePerByte := getFeePerByteForTx(networkSize, feePerByte, tx)
+// CalculateFee returns fee per byte multiplied by the estimated tx size (see tx.EstimatedSize()), the size includes the payload (see tx.DataSize()),
+// so large payloads are already charged proportionally
+func CalculateFee(networkSize int, feePerByte *big.Int, tx *types.Transaction, forkActivated bool) *big.Int {
+	txFeePerByte := getFeePerByteForTx(networkSize, feePerByte, tx, forkActivated)
 	if txFeePerByte.Sign() == 0 {
 		return big.NewInt(0)
 	}
@@ -45,13 +49,18 @@ func CalculateFee(networkSize int, feePerByte *big.Int, tx *types.Transaction) *
 	return new(big.Int).Mul(txFeePerByte, big.NewInt(int64(size)))
 }
 
-func getFeePerByteForTx(networkSize int, feePerByte *big.Int, tx *types.Transaction) *big.Int {
+func getFeePerByteForTx(networkSize int, feePerByte *big.Int, tx *types.Transaction, forkActivated bool) *big.Int {
 	if networkSize == 0 || common.ZeroOrNil(feePerByte) {
 		return big.NewInt(0)
 	}
 	if tx.Type == types.SubmitFlipTx || tx.Type == types.SubmitAnswersHashTx || tx.Type == types.SubmitShortAnswersTx ||
 		tx.Type == types.SubmitLongAnswersTx || tx.Type == types.EvidenceTx || tx.Type == types.ActivationTx ||
-		tx.Type == types.InviteTx {
+		tx.Type == types.InviteTx || tx.Type == types.UnstakeTx ||
+		tx.Type == types.ClaimRewardsTx || tx.Type == types.SubmitFlipKeyTx {
+		return big.NewInt(0)
+	}
+	// inviters aren't charged for cleaning up unused invites after the fork
+	if tx.Type == types.KillInviteeTx && forkActivated {
 		return big.NewInt(0)
 	}
 	if tx.Type == types.OnlineStatusTx {
@@ -61,11 +70,14 @@ func getFeePerByteForTx(networkSize int, feePerByte *big.Int, tx *types.Transact
 		}
 		return big.NewInt(0)
 	}
+	if tx.Type == types.ChangeProfileTx && forkActivated {
+		return new(big.Int).Mul(big.NewInt(2), feePerByte)
+	}
 	return feePerByte
 }
 
 func getTxSizeForFee(tx *types.Transaction) int {
-	size := tx.Size()
+	size := tx.EstimatedSize()
 	if tx.Signature == nil {
 		size += SignatureAdditionalSize
 	}
@@ -75,14 +87,35 @@ func getTxSizeForFee(tx *types.Transaction) int {
 	return size
 }
 
-func CalculateCost(networkSize int, feePerByte *big.Int, tx *types.Transaction) *big.Int {
+// CalculateInvitationCost returns InviteBaseCoef * exp(-InviteDecayRate * epoch) DNA, zero coef disables invitation cost
+func CalculateInvitationCost(epoch uint16, networkSize int, cfg *config.ConsensusConf) *big.Int {
+	if networkSize == 0 || cfg.InviteBaseCoef <= 0 {
+		return big.NewInt(0)
+	}
+	cost := decimal.NewFromFloat(cfg.InviteBaseCoef).
+		Mul(decimal.NewFromFloat(math2.Exp(-cfg.InviteDecayRate * float64(epoch)))).
+		Mul(decimal.NewFromBigInt(common.DnaBase, 0))
+	return math.ToInt(cost)
+}
+
+// CalculateCost returns coins subtracted from the sender balance by tx, InviteTx also costs the invitation cost of the epoch
+func CalculateCost(networkSize int, feePerByte *big.Int, tx *types.Transaction, epoch uint16, cfg *config.ConsensusConf, forkActivated bool) *big.Int {
 	result := big.NewInt(0)
 
+	// unstaked coins are taken from stake, not from balance
+	if tx.Type == types.UnstakeTx {
+		return result
+	}
+
 	result.Add(result, tx.AmountOrZero())
 	result.Add(result, tx.TipsOrZero())
 
-	fee := CalculateFee(networkSize, feePerByte, tx)
+	fee := CalculateFee(networkSize, feePerByte, tx, forkActivated)
 	result.Add(result, fee)
 
+	if tx.Type == types.InviteTx {
+		result.Add(result, CalculateInvitationCost(epoch, networkSize, cfg))
+	}
+
 	return result
 }
@@ -3,6 +3,7 @@ package fee
 import (
 	"github.com/idena-network/idena-go/blockchain/types"
 	"github.com/idena-network/idena-go/common"
+	"github.com/idena-network/idena-go/config"
 	"github.com/idena-network/idena-go/crypto"
 	"github.com/stretchr/testify/require"
 	"math/big"
@@ -17,15 +18,15 @@ func TestCalculateFee(t *testing.T) {
 	fee2 := big.NewInt(345e+15)
 
 	// not signed
-	require.Equal(t, 0, fee1.Cmp(CalculateFee(1, new(big.Int).Div(common.DnaBase, big.NewInt(1000)), tx)))
-	require.Equal(t, 0, fee2.Cmp(CalculateFee(200, new(big.Int).Div(common.DnaBase, big.NewInt(200)), tx)))
+	require.Equal(t, 0, fee1.Cmp(CalculateFee(1, new(big.Int).Div(common.DnaBase, big.NewInt(1000)), tx, true)))
+	require.Equal(t, 0, fee2.Cmp(CalculateFee(200, new(big.Int).Div(common.DnaBase, big.NewInt(200)), tx, true)))
 
 	key, _ := crypto.GenerateKey()
 	signed, _ := types.SignTx(tx, key)
 
 	// signed
-	require.Equal(t, 0, fee1.Cmp(CalculateFee(1, new(big.Int).Div(common.DnaBase, big.NewInt(1000)), signed)))
-	require.Equal(t, 0, fee2.Cmp(CalculateFee(200, new(big.Int).Div(common.DnaBase, big.NewInt(200)), signed)))
+	require.Equal(t, 0, fee1.Cmp(CalculateFee(1, new(big.Int).Div(common.DnaBase, big.NewInt(1000)), signed, true)))
+	require.Equal(t, 0, fee2.Cmp(CalculateFee(200, new(big.Int).Div(common.DnaBase, big.NewInt(200)), signed, true)))
 }
 
 func TestCalculateCost(t *testing.T) {
@@ -36,7 +37,7 @@ func TestCalculateCost(t *testing.T) {
 	}
 	cost := new(big.Int).Add(big.NewInt(89e+16), tx.AmountOrZero())
 	cost.Add(cost, tx.TipsOrZero())
-	require.Equal(t, 0, cost.Cmp(CalculateCost(100, new(big.Int).Div(common.DnaBase, big.NewInt(100)), tx)))
+	require.Equal(t, 0, cost.Cmp(CalculateCost(100, new(big.Int).Div(common.DnaBase, big.NewInt(100)), tx, 0, &config.ConsensusConf{}, true)))
 }
 
 func TestCalculateCostForInvitation(t *testing.T) {
@@ -47,7 +48,12 @@ func TestCalculateCostForInvitation(t *testing.T) {
 	}
 	const networkSize = 100
 
-	require.Equal(t, 0, tx.AmountOrZero().Cmp(CalculateCost(networkSize, new(big.Int).Div(common.DnaBase, big.NewInt(100)), tx)))
+	require.Equal(t, 0, tx.AmountOrZero().Cmp(CalculateCost(networkSize, new(big.Int).Div(common.DnaBase, big.NewInt(100)), tx, 0, &config.ConsensusConf{}, true)))
+
+	// invitation cost of the epoch is added to the amount
+	cfg := &config.ConsensusConf{InviteBaseCoef: 10, InviteDecayRate: 0.1}
+	cost := new(big.Int).Add(tx.AmountOrZero(), CalculateInvitationCost(3, networkSize, cfg))
+	require.Equal(t, 0, cost.Cmp(CalculateCost(networkSize, new(big.Int).Div(common.DnaBase, big.NewInt(100)), tx, 3, cfg, true)))
 }
 
 func Test_GetFeePerByteForNetwork(t *testing.T) {
@@ -67,3 +73,54 @@ func Test_GetFeePerByteForNetwork(t *testing.T) {
 
 	require.Zero(big.NewInt(1e+2).Cmp(GetFeePerByteForNetwork(1e+18)))
 }
+
+func TestCalculateFeeForChangeProfile(t *testing.T) {
+	feePerByte := new(big.Int).Div(common.DnaBase, big.NewInt(100))
+	changeProfile := &types.Transaction{Type: types.ChangeProfileTx}
+
+	expected := new(big.Int).Mul(big.NewInt(2), feePerByte)
+	expected.Mul(expected, big.NewInt(int64(changeProfile.Size()+SignatureAdditionalSize)))
+	require.Zero(t, expected.Cmp(CalculateFee(100, feePerByte, changeProfile, true)))
+
+	expected.Div(expected, big.NewInt(2))
+	require.Zero(t, expected.Cmp(CalculateFee(100, feePerByte, changeProfile, false)))
+}
+
+func TestCalculateFeeForKillInvitee(t *testing.T) {
+	feePerByte := new(big.Int).Div(common.DnaBase, big.NewInt(100))
+	killInvitee := &types.Transaction{Type: types.KillInviteeTx}
+
+	expected := new(big.Int).Mul(feePerByte, big.NewInt(int64(killInvitee.Size()+SignatureAdditionalSize)))
+	require.Zero(t, expected.Cmp(CalculateFee(100, feePerByte, killInvitee, false)))
+	require.Zero(t, CalculateFee(100, feePerByte, killInvitee, true).Sign())
+}
+
+func TestCalculateInvitationCost(t *testing.T) {
+	cfg := &config.ConsensusConf{
+		InviteBaseCoef:  10,
+		InviteDecayRate: 0.1,
+	}
+	cases := []struct {
+		epoch       uint16
+		networkSize int
+		expected    *big.Int
+	}{
+		{0, 0, big.NewInt(0)},
+		{0, 1, new(big.Int).Mul(big.NewInt(10), common.DnaBase)},
+		{0, 5000, new(big.Int).Mul(big.NewInt(10), common.DnaBase)},
+		{10, 100, big.NewInt(3678794411714423300)},
+	}
+	for _, c := range cases {
+		require.Equal(t, c.expected.String(), CalculateInvitationCost(c.epoch, c.networkSize, cfg).String())
+	}
+
+	prev := CalculateInvitationCost(0, 100, cfg)
+	for epoch := uint16(1); epoch < 50; epoch++ {
+		cost := CalculateInvitationCost(epoch, 100, cfg)
+		require.Equal(t, -1, cost.Cmp(prev))
+		require.Equal(t, 0, cost.Cmp(CalculateInvitationCost(epoch, 10000, cfg)))
+		prev = cost
+	}
+
+	require.Zero(t, CalculateInvitationCost(5, 100, &config.ConsensusConf{}).Sign())
+}
@@ -0,0 +1,203 @@
+package blockchain
+
+import (
+	"bytes"
+	"github.com/idena-network/idena-go/blockchain/types"
+	"github.com/idena-network/idena-go/common"
+	"github.com/idena-network/idena-go/core/appstate"
+	"github.com/idena-network/idena-go/crypto"
+	"github.com/idena-network/idena-go/rlp"
+	"github.com/pkg/errors"
+	"math/big"
+)
+
+var (
+	ErrNoTrustedKeys         = errors.New("trusted keys are not configured")
+	ErrInsufficientPatchSigs = errors.New("insufficient number of valid patch signatures")
+	ErrInvalidPatchVersion   = errors.New("invalid patch version")
+	ErrInvalidPatchHeight    = errors.New("patch height is not above the current head")
+)
+
+// StateDelta overrides balance and stake of a single account, nil values are left unchanged
+type StateDelta struct {
+	Address common.Address
+	Balance *big.Int
+	Stake   *big.Int
+}
+
+// GenesisPatch is a set of emergency state corrections signed by trusted keys from the genesis config.
+// Version must be exactly one greater than the patch version stored in the global state,
+// corrections are applied during the state transition of the block at Height.
+type GenesisPatch struct {
+	Version     uint32
+	Height      uint64
+	Corrections []StateDelta
+}
+
+type rlpStateDelta struct {
+	Address    common.Address
+	HasBalance bool
+	Balance    []byte
+	HasStake   bool
+	Stake      []byte
+}
+
+type rlpGenesisPatch struct {
+	Version     uint32
+	Height      uint64
+	Corrections []rlpStateDelta
+}
+
+func (p *GenesisPatch) toRlp() rlpGenesisPatch {
+	corrections := make([]rlpStateDelta, 0, len(p.Corrections))
+	for _, delta := range p.Corrections {
+		corrections = append(corrections, rlpStateDelta{
+			Address:    delta.Address,
+			HasBalance: delta.Balance != nil,
+			Balance:    common.BigIntBytesOrNil(delta.Balance),
+			HasStake:   delta.Stake != nil,
+			Stake:      common.BigIntBytesOrNil(delta.Stake),
+		})
+	}
+	return rlpGenesisPatch{
+		Version:     p.Version,
+		Height:      p.Height,
+		Corrections: corrections,
+	}
+}
+
+func (p *GenesisPatch) Hash() common.Hash {
+	data, _ := p.ToBytes()
+	return crypto.Hash(data)
+}
+
+func (p *GenesisPatch) ToBytes() ([]byte, error) {
+	return rlp.EncodeToBytes(p.toRlp())
+}
+
+func (p *GenesisPatch) FromBytes(data []byte) error {
+	encoded := new(rlpGenesisPatch)
+	if err := rlp.DecodeBytes(data, encoded); err != nil {
+		return err
+	}
+	p.Version = encoded.Version
+	p.Height = encoded.Height
+	p.Corrections = nil
+	for _, delta := range encoded.Corrections {
+		item := StateDelta{Address: delta.Address}
+		if delta.HasBalance {
+			item.Balance = new(big.Int).SetBytes(delta.Balance)
+		}
+		if delta.HasStake {
+			item.Stake = new(big.Int).SetBytes(delta.Stake)
+		}
+		p.Corrections = append(p.Corrections, item)
+	}
+	return nil
+}
+
+func (chain *Blockchain) verifyGenesisPatchSigs(patch *GenesisPatch, authSigs [][]byte) error {
+	conf := chain.config.GenesisConf
+	if len(conf.TrustedKeys) == 0 || conf.TrustedKeysThreshold <= 0 {
+		return ErrNoTrustedKeys
+	}
+	hash := patch.Hash()
+	signed := make(map[int]struct{})
+	for _, sig := range authSigs {
+		pubKey, err := crypto.Ecrecover(hash[:], sig)
+		if err != nil {
+			continue
+		}
+		for i, trustedKey := range conf.TrustedKeys {
+			if bytes.Equal(pubKey, trustedKey) {
+				signed[i] = struct{}{}
+				break
+			}
+		}
+	}
+	if len(signed) < conf.TrustedKeysThreshold {
+		return ErrInsufficientPatchSigs
+	}
+	return nil
+}
+
+// ApplyGenesisPatch schedules emergency state corrections signed by the trusted keys.
+// The patch is persisted and applied during the state transition of the block at the patch height, so every node
+// scheduling the same patch before that height stays in consensus. Applying an already applied or scheduled patch is a no-op.
+func (chain *Blockchain) ApplyGenesisPatch(patch *GenesisPatch, authSigs [][]byte) error {
+	if patch == nil {
+		return ErrInvalidPatchVersion
+	}
+	if err := chain.verifyGenesisPatchSigs(patch, authSigs); err != nil {
+		return err
+	}
+	currentVersion := chain.appState.State.PatchVersion()
+	if patch.Version <= currentVersion {
+		return nil
+	}
+	if pending, ok := chain.genesisPatch.Load().(*GenesisPatch); ok && pending != nil && pending.Version == patch.Version {
+		return nil
+	}
+	if patch.Version != currentVersion+1 {
+		return ErrInvalidPatchVersion
+	}
+	if patch.Height <= chain.GetCurrentHead().Height() {
+		return ErrInvalidPatchHeight
+	}
+	data, err := patch.ToBytes()
+	if err != nil {
+		return err
+	}
+	chain.repo.WriteGenesisPatch(data)
+	chain.genesisPatch.Store(patch)
+	chain.log.Warn("Genesis patch scheduled", "version", patch.Version, "height", patch.Height, "corrections", len(patch.Corrections))
+	return nil
+}
+
+// loadGenesisPatch restores the patch scheduled before the node restart
+func (chain *Blockchain) loadGenesisPatch() {
+	data := chain.repo.ReadGenesisPatch()
+	if data == nil {
+		return
+	}
+	patch := new(GenesisPatch)
+	if err := patch.FromBytes(data); err != nil {
+		chain.log.Error("invalid genesis patch", "err", err)
+		return
+	}
+	chain.genesisPatch.Store(patch)
+}
+
+func (chain *Blockchain) applyGenesisPatch(appState *appstate.AppState, block *types.Block) {
+	patch, ok := chain.genesisPatch.Load().(*GenesisPatch)
+	if !ok || patch == nil || patch.Height != block.Height() || patch.Version != appState.State.PatchVersion()+1 {
+		return
+	}
+	for _, delta := range patch.Corrections {
+		if delta.Balance != nil {
+			adjustCoins(appState, appState.State.GetBalance(delta.Address), delta.Balance)
+			appState.State.SetBalance(delta.Address, delta.Balance)
+		}
+		if delta.Stake != nil {
+			current := appState.State.GetStakeBalance(delta.Address)
+			adjustCoins(appState, current, delta.Stake)
+			switch diff := new(big.Int).Sub(delta.Stake, current); diff.Sign() {
+			case 1:
+				appState.State.AddStake(delta.Address, diff)
+			case -1:
+				appState.State.SubStake(delta.Address, diff.Neg(diff))
+			}
+		}
+	}
+	appState.State.SetPatchVersion(patch.Version)
+}
+
+// adjustCoins keeps total supply statistics consistent with the overridden value
+func adjustCoins(appState *appstate.AppState, current, target *big.Int) {
+	switch diff := new(big.Int).Sub(target, current); diff.Sign() {
+	case 1:
+		appState.State.AddMintedCoins(diff)
+	case -1:
+		appState.State.AddBurntCoins(diff.Neg(diff))
+	}
+}
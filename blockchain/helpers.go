@@ -6,6 +6,7 @@ import (
 	"github.com/idena-network/idena-go/common/math"
 	"github.com/idena-network/idena-go/config"
 	"github.com/idena-network/idena-go/core/appstate"
+	"github.com/idena-network/idena-go/core/state"
 	"github.com/shopspring/decimal"
 	"math/big"
 )
@@ -71,3 +72,34 @@ func splitReward(totalReward *big.Int, isNewbie bool, conf *config.ConsensusConf
 	reward = reward.Sub(totalReward, stake)
 	return reward, stake
 }
+
+// addStakeWithSource adds stake to the identity, stake sources are accounted in the identity state only after the fork
+// to keep state roots of earlier blocks
+func addStakeWithSource(appState *appstate.AppState, conf *config.ConsensusConf, height uint64, addr common.Address,
+	amount *big.Int, source state.StakeSource) {
+	if !conf.IsForkActivated(height) {
+		appState.State.AddStake(addr, amount)
+		return
+	}
+	appState.State.AddStakeWithSource(addr, amount, source)
+}
+
+// effectiveBlockReward returns BlockReward * HalvingCoef^floor(height/HalvingInterval) but not less than 1,
+// zero HalvingInterval disables halving
+func effectiveBlockReward(conf *config.ConsensusConf, height uint64) *big.Int {
+	if conf.HalvingInterval == 0 || conf.HalvingCoef >= 1 {
+		return new(big.Int).Set(conf.BlockReward)
+	}
+	one := decimal.New(1, 0)
+	coef := decimal.NewFromFloat(conf.HalvingCoef)
+	rewardD := decimal.NewFromBigInt(conf.BlockReward, 0)
+	// once the reward drops to the minimal unit further halvings change nothing
+	for halvings := height / conf.HalvingInterval; halvings > 0 && rewardD.GreaterThan(one); halvings-- {
+		rewardD = rewardD.Mul(coef)
+	}
+	reward := math.ToInt(rewardD)
+	if reward.Sign() <= 0 {
+		return big.NewInt(1)
+	}
+	return reward
+}
@@ -0,0 +1,97 @@
+package blockchain
+
+import (
+	"github.com/idena-network/idena-go/blockchain/types"
+	"github.com/idena-network/idena-go/common"
+	"github.com/idena-network/idena-go/core/state"
+	"sync"
+	"sync/atomic"
+)
+
+// unusedInvitesCache keeps invite counts of the current epoch. Transactions changing invites only mark the cache,
+// it is invalidated after the block is inserted so a result computed from the previous head can't outlive the block.
+type unusedInvitesCache struct {
+	mutex      sync.Mutex
+	invites    map[common.Address]uint8
+	epoch      uint16
+	generation uint64
+	changed    int32
+}
+
+func (c *unusedInvitesCache) markChanged() {
+	atomic.StoreInt32(&c.changed, 1)
+}
+
+func (c *unusedInvitesCache) onBlockInserted() {
+	if !atomic.CompareAndSwapInt32(&c.changed, 1, 0) {
+		return
+	}
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.invites = nil
+	c.generation++
+}
+
+func changesInvites(txType types.TxType) bool {
+	switch txType {
+	case types.InviteTx, types.KillInviteeTx, types.ActivationTx, types.KillTx:
+		return true
+	}
+	return false
+}
+
+// GetUnusedInvites returns invite counts of identities having unused invites at the current head,
+// the result is cached until the next epoch or the next block with transactions changing invites
+func (chain *Blockchain) GetUnusedInvites() (map[common.Address]uint8, error) {
+	appState, err := chain.appState.Readonly(chain.GetCurrentHead().Height())
+	if err != nil {
+		return nil, err
+	}
+	epoch := appState.State.Epoch()
+	cache := chain.unusedInvites
+
+	cache.mutex.Lock()
+	if cache.invites != nil && cache.epoch == epoch {
+		result := copyInvites(cache.invites)
+		cache.mutex.Unlock()
+		return result, nil
+	}
+	generation := cache.generation
+	cache.mutex.Unlock()
+
+	invites := make(map[common.Address]uint8)
+	appState.State.IterateOverIdentities(func(addr common.Address, identity state.Identity) {
+		if identity.Invites > 0 {
+			invites[addr] = identity.Invites
+		}
+	})
+
+	cache.mutex.Lock()
+	if cache.generation == generation {
+		cache.invites = invites
+		cache.epoch = epoch
+	}
+	cache.mutex.Unlock()
+	return copyInvites(invites), nil
+}
+
+// GetTotalUnusedInvites returns the sum of unused invites of all identities
+func (chain *Blockchain) GetTotalUnusedInvites() int {
+	invites, err := chain.GetUnusedInvites()
+	if err != nil {
+		return 0
+	}
+	total := 0
+	for _, count := range invites {
+		total += int(count)
+	}
+	return total
+}
+
+func copyInvites(invites map[common.Address]uint8) map[common.Address]uint8 {
+	result := make(map[common.Address]uint8, len(invites))
+	for addr, count := range invites {
+		result[addr] = count
+	}
+	return result
+}
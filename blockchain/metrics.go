@@ -0,0 +1,138 @@
+package blockchain
+
+import (
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const blockIntervalsSize = 50
+
+// ChainMetrics contains block processing counters, fields are updated atomically and should be read with atomic loads
+type ChainMetrics struct {
+	BlocksProcessed   uint64
+	TxsProcessed      uint64
+	EmptyBlocks       uint64
+	ValidationErrors  uint64
+	BlockCacheHits    uint64
+	BlockCacheMisses  uint64
+	LastBlockDuration time.Duration
+	PeakBlockDuration time.Duration
+}
+
+// Metrics returns live block processing counters of the chain
+func (chain *Blockchain) Metrics() *ChainMetrics {
+	return chain.metrics
+}
+
+// Snapshot returns a consistent per field copy of the counters
+func (m *ChainMetrics) Snapshot() ChainMetrics {
+	return ChainMetrics{
+		BlocksProcessed:   atomic.LoadUint64(&m.BlocksProcessed),
+		TxsProcessed:      atomic.LoadUint64(&m.TxsProcessed),
+		EmptyBlocks:       atomic.LoadUint64(&m.EmptyBlocks),
+		ValidationErrors:  atomic.LoadUint64(&m.ValidationErrors),
+		BlockCacheHits:    atomic.LoadUint64(&m.BlockCacheHits),
+		BlockCacheMisses:  atomic.LoadUint64(&m.BlockCacheMisses),
+		LastBlockDuration: time.Duration(atomic.LoadInt64((*int64)(&m.LastBlockDuration))),
+		PeakBlockDuration: time.Duration(atomic.LoadInt64((*int64)(&m.PeakBlockDuration))),
+	}
+}
+
+// CacheHitRate returns the share of block lookups served from the block cache
+func (chain *Blockchain) CacheHitRate() float64 {
+	hits := atomic.LoadUint64(&chain.metrics.BlockCacheHits)
+	misses := atomic.LoadUint64(&chain.metrics.BlockCacheMisses)
+	if hits+misses == 0 {
+		return 0
+	}
+	return float64(hits) / float64(hits+misses)
+}
+
+func (m *ChainMetrics) addBlockCacheLookup(hit bool) {
+	if hit {
+		atomic.AddUint64(&m.BlockCacheHits, 1)
+	} else {
+		atomic.AddUint64(&m.BlockCacheMisses, 1)
+	}
+}
+
+func (m *ChainMetrics) addValidationError() {
+	atomic.AddUint64(&m.ValidationErrors, 1)
+}
+
+func (m *ChainMetrics) addBlock(txs int, empty bool, duration time.Duration) {
+	atomic.AddUint64(&m.BlocksProcessed, 1)
+	atomic.AddUint64(&m.TxsProcessed, uint64(txs))
+	if empty {
+		atomic.AddUint64(&m.EmptyBlocks, 1)
+	}
+	atomic.StoreInt64((*int64)(&m.LastBlockDuration), int64(duration))
+	for {
+		peak := atomic.LoadInt64((*int64)(&m.PeakBlockDuration))
+		if int64(duration) <= peak || atomic.CompareAndSwapInt64((*int64)(&m.PeakBlockDuration), peak, int64(duration)) {
+			return
+		}
+	}
+}
+
+// blockIntervals keeps local durations between the latest inserted blocks in a circular buffer
+type blockIntervals struct {
+	mutex     sync.Mutex
+	intervals []time.Duration
+	next      int
+	filled    bool
+	lastTime  time.Time
+	now       func() time.Time
+}
+
+func newBlockIntervals(size int, now func() time.Time) *blockIntervals {
+	return &blockIntervals{
+		intervals: make([]time.Duration, size),
+		now:       now,
+	}
+}
+
+func (b *blockIntervals) add() {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	now := b.now()
+	if !b.lastTime.IsZero() {
+		b.intervals[b.next] = now.Sub(b.lastTime)
+		b.next = (b.next + 1) % len(b.intervals)
+		if b.next == 0 {
+			b.filled = true
+		}
+	}
+	b.lastTime = now
+}
+
+// median returns false until the buffer is full
+func (b *blockIntervals) median() (time.Duration, bool) {
+	b.mutex.Lock()
+	if !b.filled {
+		b.mutex.Unlock()
+		return 0, false
+	}
+	sorted := make([]time.Duration, len(b.intervals))
+	copy(sorted, b.intervals)
+	b.mutex.Unlock()
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i] < sorted[j]
+	})
+	middle := len(sorted) / 2
+	if len(sorted)%2 == 0 {
+		return (sorted[middle-1] + sorted[middle]) / 2, true
+	}
+	return sorted[middle], true
+}
+
+// EstimateBlockTime returns the median of local intervals between the last 50 inserted blocks,
+// MinBlockDistance is returned until enough blocks are inserted
+func (chain *Blockchain) EstimateBlockTime() time.Duration {
+	if median, ok := chain.blockIntervals.median(); ok {
+		return median
+	}
+	return chain.config.Consensus.MinBlockDistance
+}
@@ -0,0 +1,84 @@
+package blockchain
+
+import (
+	"github.com/idena-network/idena-go/common/eventbus"
+	"github.com/idena-network/idena-go/core/appstate"
+	"github.com/idena-network/idena-go/core/mempool"
+	"github.com/idena-network/idena-go/crypto/vrf"
+	"github.com/idena-network/idena-go/ipfs"
+	"github.com/idena-network/idena-go/keystore"
+	"github.com/idena-network/idena-go/log"
+	"github.com/idena-network/idena-go/secstore"
+	"github.com/pkg/errors"
+)
+
+// Option provides an optional dependency of the blockchain, options are applied by NewBlockchain in the given order
+type Option func(*Blockchain)
+
+func WithTxPool(pool *mempool.TxPool) Option {
+	return func(chain *Blockchain) {
+		chain.txpool = pool
+	}
+}
+
+func WithAppState(appState *appstate.AppState) Option {
+	return func(chain *Blockchain) {
+		chain.appState = appState
+	}
+}
+
+func WithLogger(logger log.Logger) Option {
+	return func(chain *Blockchain) {
+		chain.log = logger
+	}
+}
+
+// WithVRFSigner makes the chain evaluate VRF with the given signer instead of creating a signer from the secure store
+// key for every evaluation, the signer key must be the node key because peers verify proofs with the proposer public key
+func WithVRFSigner(signer vrf.PrivateKey) Option {
+	return func(chain *Blockchain) {
+		chain.vrfSigner = signer
+	}
+}
+
+// WithRequiredDefaults provides all dependencies the chain can't work without
+func WithRequiredDefaults(txpool *mempool.TxPool, appState *appstate.AppState, ipfs ipfs.Proxy, secStore *secstore.SecStore,
+	bus eventbus.Bus, offlineDetector *OfflineDetector, keyStore *keystore.KeyStore) Option {
+	return func(chain *Blockchain) {
+		chain.txpool = txpool
+		chain.appState = appState
+		chain.ipfs = ipfs
+		chain.secStore = secStore
+		chain.bus = bus
+		chain.offlineDetector = offlineDetector
+		chain.keyStore = keyStore
+	}
+}
+
+func (chain *Blockchain) checkRequiredDependencies() error {
+	dependencies := []struct {
+		name    string
+		missing bool
+	}{
+		{"txpool", chain.txpool == nil},
+		{"appState", chain.appState == nil},
+		{"ipfs", chain.ipfs == nil},
+		{"secStore", chain.secStore == nil},
+		{"bus", chain.bus == nil},
+		{"offlineDetector", chain.offlineDetector == nil},
+		{"keyStore", chain.keyStore == nil},
+	}
+	for _, dependency := range dependencies {
+		if dependency.missing {
+			return errors.Errorf("blockchain dependency %v is not provided", dependency.name)
+		}
+	}
+	return nil
+}
+
+func (chain *Blockchain) vrfEvaluate(data []byte) (index [32]byte, proof []byte) {
+	if chain.vrfSigner != nil {
+		return chain.vrfSigner.Evaluate(data)
+	}
+	return chain.secStore.VrfEvaluate(data)
+}
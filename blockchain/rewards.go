@@ -71,6 +71,8 @@ func addSuccessfulValidationReward(appState *appstate.AppState, config *config.C
 				collector.BeginEpochRewardBalanceUpdate(statsCollector, addr, appState)
 				appState.State.AddBalance(addr, reward)
 				appState.State.AddStake(addr, stake)
+				appState.State.AddMintedCoins(reward)
+				appState.State.AddMintedCoins(stake)
 				collector.CompleteBalanceUpdate(statsCollector, appState)
 				collector.AddMintedCoins(statsCollector, reward)
 				collector.AddMintedCoins(statsCollector, stake)
@@ -108,6 +110,8 @@ func addFlipReward(appState *appstate.AppState, config *config.ConsensusConf, va
 		collector.BeginEpochRewardBalanceUpdate(statsCollector, addr, appState)
 		appState.State.AddBalance(addr, reward)
 		appState.State.AddStake(addr, stake)
+		appState.State.AddMintedCoins(reward)
+		appState.State.AddMintedCoins(stake)
 		collector.CompleteBalanceUpdate(statsCollector, appState)
 		collector.AddMintedCoins(statsCollector, reward)
 		collector.AddMintedCoins(statsCollector, stake)
@@ -175,7 +179,9 @@ func addInvitationReward(appState *appstate.AppState, config *config.ConsensusCo
 		reward, stake := splitReward(math.ToInt(totalReward), isNewbie, config)
 		collector.BeginEpochRewardBalanceUpdate(statsCollector, addr, appState)
 		appState.State.AddBalance(addr, reward)
-		appState.State.AddStake(addr, stake)
+		addStakeWithSource(appState, config, uint64(appState.State.Version())+1, addr, stake, state.StakeFromInvites)
+		appState.State.AddMintedCoins(reward)
+		appState.State.AddMintedCoins(stake)
 		collector.CompleteBalanceUpdate(statsCollector, appState)
 		collector.AddMintedCoins(statsCollector, reward)
 		collector.AddMintedCoins(statsCollector, stake)
@@ -214,6 +220,7 @@ func addFoundationPayouts(appState *appstate.AppState, config *config.ConsensusC
 	godAddress := appState.State.GodAddress()
 	collector.BeginEpochRewardBalanceUpdate(statsCollector, godAddress, appState)
 	appState.State.AddBalance(godAddress, total)
+	appState.State.AddMintedCoins(total)
 	collector.CompleteBalanceUpdate(statsCollector, appState)
 	collector.AddMintedCoins(statsCollector, total)
 	collector.SetTotalFoundationPayouts(statsCollector, total)
@@ -227,6 +234,7 @@ func addZeroWalletFund(appState *appstate.AppState, config *config.ConsensusConf
 	zeroAddress := common.Address{}
 	collector.BeginEpochRewardBalanceUpdate(statsCollector, zeroAddress, appState)
 	appState.State.AddBalance(zeroAddress, total)
+	appState.State.AddMintedCoins(total)
 	collector.CompleteBalanceUpdate(statsCollector, appState)
 	collector.AddMintedCoins(statsCollector, total)
 	collector.SetTotalZeroWalletFund(statsCollector, total)
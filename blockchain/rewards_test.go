@@ -155,3 +155,26 @@ func Test_splitReward(t *testing.T) {
 	require.True(t, big.NewInt(20).Cmp(reward) == 0)
 	require.True(t, big.NewInt(80).Cmp(stake) == 0)
 }
+
+func Test_effectiveBlockReward(t *testing.T) {
+	require := require.New(t)
+	conf := config.GetDefaultConsensusConfig()
+	require.Equal(0, conf.BlockReward.Cmp(effectiveBlockReward(conf, 1_000_000)))
+
+	conf.HalvingInterval = 100
+	require.Equal(0, conf.BlockReward.Cmp(effectiveBlockReward(conf, 0)))
+	require.Equal(0, conf.BlockReward.Cmp(effectiveBlockReward(conf, 99)))
+	expected := new(big.Int).Set(conf.BlockReward)
+	for i := uint64(1); i <= 3; i++ {
+		expected.Div(expected, big.NewInt(2))
+		require.Equal(0, expected.Cmp(effectiveBlockReward(conf, i*100)), "halving %v", i)
+		require.Equal(0, expected.Cmp(effectiveBlockReward(conf, i*100+99)), "halving %v", i)
+	}
+
+	require.Equal(0, big.NewInt(1).Cmp(effectiveBlockReward(conf, 100*100)))
+	require.Equal(0, big.NewInt(1).Cmp(effectiveBlockReward(conf, ^uint64(0))))
+
+	conf.BlockReward = big.NewInt(3)
+	require.Equal(0, big.NewInt(1).Cmp(effectiveBlockReward(conf, 100)))
+	require.Equal(0, big.NewInt(1).Cmp(effectiveBlockReward(conf, 200)))
+}
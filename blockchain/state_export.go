@@ -0,0 +1,182 @@
+package blockchain
+
+import (
+	"github.com/idena-network/idena-go/blockchain/types"
+	"github.com/idena-network/idena-go/common"
+	"github.com/idena-network/idena-go/core/appstate"
+	"github.com/idena-network/idena-go/core/state"
+	"github.com/idena-network/idena-go/crypto/sha3"
+	"github.com/idena-network/idena-go/ipfs"
+	"github.com/idena-network/idena-go/log"
+	"github.com/idena-network/idena-go/rlp"
+	"github.com/pkg/errors"
+	dbm "github.com/tendermint/tm-db"
+	"io"
+)
+
+const (
+	exportedStateTree         = 0x0
+	exportedIdentityStateTree = 0x1
+)
+
+type stateExportHeader struct {
+	Height uint64
+	Seed   types.Seed
+	// roots of trees rebuilt from the exported entries at Height, see importedRoots
+	Root         common.Hash
+	IdentityRoot common.Hash
+}
+
+type stateExportEntry struct {
+	Tree  uint8
+	Key   []byte
+	Value []byte
+}
+
+// ExportState streams state of the head block to w as a sequence of rlp items: header followed by
+// raw entries of state and identity state trees, so accounts, identities and global values (epoch, epoch block etc.) are included
+func (chain *Blockchain) ExportState(w io.Writer) error {
+	head := chain.GetCurrentHead()
+	appState, err := chain.appState.Readonly(head.Height())
+	if err != nil {
+		return err
+	}
+	root, identityRoot, err := importedRoots(appState, head.Height())
+	if err != nil {
+		return err
+	}
+	if err := rlp.Encode(w, &stateExportHeader{
+		Height:       head.Height(),
+		Seed:         head.Seed(),
+		Root:         root,
+		IdentityRoot: identityRoot,
+	}); err != nil {
+		return err
+	}
+	writeEntries := func(tree uint8, iterate func(fn func(key []byte, value []byte) bool) bool) error {
+		var writeErr error
+		iterate(func(key []byte, value []byte) bool {
+			writeErr = rlp.Encode(w, &stateExportEntry{
+				Tree:  tree,
+				Key:   key,
+				Value: value,
+			})
+			return writeErr != nil
+		})
+		return writeErr
+	}
+	if err := writeEntries(exportedStateTree, appState.State.IterateAll); err != nil {
+		return err
+	}
+	return writeEntries(exportedIdentityStateTree, appState.IdentityState.IterateAll)
+}
+
+// importedRoots returns roots of the trees ImportState builds from the exported entries, they differ from roots of the
+// exported block since iavl hashes include versions of nodes and all imported nodes are created at the snapshot height
+func importedRoots(appState *appstate.AppState, height uint64) (root common.Hash, identityRoot common.Hash, err error) {
+	db := dbm.NewMemDB()
+	stateDB := state.NewLazy(db)
+	identityStateDB := state.NewLazyIdentityState(db)
+	if _, _, err := stateDB.CommitTree(int64(height - 1)); err != nil {
+		return common.Hash{}, common.Hash{}, err
+	}
+	if _, _, err := identityStateDB.CommitTree(int64(height - 1)); err != nil {
+		return common.Hash{}, common.Hash{}, err
+	}
+	appState.State.IterateAll(func(key []byte, value []byte) bool {
+		stateDB.SetRaw(key, value)
+		return false
+	})
+	appState.IdentityState.IterateAll(func(key []byte, value []byte) bool {
+		identityStateDB.SetRaw(key, value)
+		return false
+	})
+	if _, _, err := stateDB.Commit(true); err != nil {
+		return common.Hash{}, common.Hash{}, err
+	}
+	if _, _, _, err := identityStateDB.Commit(true); err != nil {
+		return common.Hash{}, common.Hash{}, err
+	}
+	return stateDB.Root(), identityStateDB.Root(), nil
+}
+
+// ImportState fills empty chain with the state written by ExportState and creates genesis block at the snapshot height,
+// parent hash of the genesis is the hash of the snapshot so chains started from different snapshots diverge at genesis.
+// Roots of the imported trees must match roots written by ExportState, otherwise the import is rolled back.
+func (chain *Blockchain) ImportState(r io.Reader) error {
+	if chain.repo.ReadHead() != nil {
+		return errors.New("state can be imported to empty chain only")
+	}
+	hasher := sha3.NewKeccak256()
+	stream := rlp.NewStream(io.TeeReader(r, hasher), 0)
+
+	header := new(stateExportHeader)
+	if err := stream.Decode(header); err != nil {
+		return errors.Wrap(err, "failed to read state header")
+	}
+	if header.Height == 0 {
+		return errors.New("invalid snapshot height")
+	}
+	if err := chain.appState.CommitAt(header.Height - 1); err != nil {
+		return err
+	}
+	entries := 0
+	for {
+		entry := new(stateExportEntry)
+		if err := stream.Decode(entry); err == io.EOF {
+			break
+		} else if err != nil {
+			chain.appState.Reset()
+			return errors.Wrap(err, "failed to read state entry")
+		}
+		switch entry.Tree {
+		case exportedStateTree:
+			chain.appState.State.SetRaw(entry.Key, entry.Value)
+		case exportedIdentityStateTree:
+			chain.appState.IdentityState.SetRaw(entry.Key, entry.Value)
+		default:
+			chain.appState.Reset()
+			return errors.Errorf("unknown state tree: %v", entry.Tree)
+		}
+		entries++
+	}
+	if err := chain.appState.Commit(nil); err != nil {
+		return err
+	}
+	if root, identityRoot := chain.appState.State.Root(), chain.appState.IdentityState.Root(); root != header.Root ||
+		identityRoot != header.IdentityRoot {
+		// validators cache isn't loaded before the chain is initialized, so trees are reset directly
+		if err := chain.appState.State.ResetTo(header.Height - 1); err != nil {
+			log.Error("Failed to roll back imported state", "err", err)
+		}
+		if err := chain.appState.IdentityState.ResetTo(header.Height - 1); err != nil {
+			log.Error("Failed to roll back imported identity state", "err", err)
+		}
+		return errors.Errorf("imported state doesn't match the snapshot, root: %v, expected: %v, identity root: %v, expected: %v",
+			root.Hex(), header.Root.Hex(), identityRoot.Hex(), header.IdentityRoot.Hex())
+	}
+
+	var snapshotHash common.Hash
+	hasher.Sum(snapshotHash[:0])
+
+	block := &types.Block{Header: &types.Header{
+		ProposedHeader: &types.ProposedHeader{
+			ParentHash:   snapshotHash,
+			Height:       header.Height,
+			Root:         chain.appState.State.Root(),
+			IdentityRoot: chain.appState.IdentityState.Root(),
+			BlockSeed:    header.Seed,
+			IpfsHash:     ipfs.EmptyCid.Bytes(),
+			FeePerByte:   chain.appState.State.FeePerByte(),
+		},
+	}, Body: &types.Body{}}
+
+	if err := chain.insertBlock(block, new(state.IdentityStateDiff)); err != nil {
+		return err
+	}
+	chain.repo.WriteImportedGenesisHeight(header.Height)
+	chain.genesis = block.Header
+	chain.refreshGovernedParams()
+	log.Info("State imported", "height", header.Height, "entries", entries, "snapshot", snapshotHash.Hex())
+	return nil
+}
@@ -25,6 +25,8 @@ func SignTx(tx *Transaction, prv *ecdsa.PrivateKey) (*Transaction, error) {
 		Payload:      tx.Payload,
 		To:           tx.To,
 		Type:         tx.Type,
+		MaxBlock:     tx.MaxBlock,
+		RefundTo:     tx.RefundTo,
 		Signature:    sig,
 	}, nil
 }
@@ -67,7 +69,7 @@ func SenderPubKey(tx *Transaction) ([]byte, error) {
 // It does not uniquely identify the transaction.
 // Deprecated: use crypto.SignatureHash instead of this
 func signatureHash(tx *Transaction) common.Hash {
-	return rlp.Hash([]interface{}{
+	fields := []interface{}{
 		tx.AccountNonce,
 		tx.Epoch,
 		tx.Type,
@@ -76,7 +78,15 @@ func signatureHash(tx *Transaction) common.Hash {
 		tx.MaxFee,
 		tx.Tips,
 		tx.Payload,
-	})
+	}
+	// appended only when set, so hashes of txs signed before RefundTo existed don't change
+	if tx.RefundTo != nil {
+		fields = append(fields, tx.RefundTo)
+	}
+	if tx.MaxBlock > 0 {
+		fields = append(fields, tx.MaxBlock)
+	}
+	return rlp.Hash(fields)
 }
 
 func recoverPlain(hash common.Hash, signature []byte) (common.Address, error) {
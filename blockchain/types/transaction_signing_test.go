@@ -2,6 +2,7 @@ package types
 
 import (
 	"github.com/idena-network/idena-go/crypto"
+	"github.com/stretchr/testify/require"
 	"math/big"
 	"testing"
 )
@@ -30,3 +31,39 @@ func TestSignTx(t *testing.T) {
 		t.Errorf("exected from and address to be equal. Got %x want %x", from, addr)
 	}
 }
+
+func TestTransaction_Verify(t *testing.T) {
+	require := require.New(t)
+	key, _ := crypto.GenerateKey()
+	addr := crypto.PubkeyToAddress(key.PublicKey)
+
+	sign := func(tx *Transaction) *Transaction {
+		signed, err := SignTx(tx, key)
+		require.NoError(err)
+		return signed
+	}
+
+	require.NoError(sign(&Transaction{Type: SendTx, To: &addr, Amount: big.NewInt(1)}).Verify())
+	require.Equal(ErrInvalidTxSignature, (&Transaction{Type: SendTx, To: &addr}).Verify())
+	require.Equal(ErrTxRecipientRequired, sign(&Transaction{Type: InviteTx}).Verify())
+	require.NoError(sign(&Transaction{Type: KillTx}).Verify())
+	require.Equal(ErrNegativeTxAmount, sign(&Transaction{Type: SendTx, To: &addr, Amount: big.NewInt(-1)}).Verify())
+	require.Equal(ErrTxDataTooLarge, sign(&Transaction{Type: SendTx, To: &addr, Payload: make([]byte, MaxTxDataSize+1)}).Verify())
+	require.NoError(sign(&Transaction{Type: SubmitLongAnswersTx, Payload: make([]byte, MaxTxDataSize+1)}).Verify())
+}
+
+func Test_signatureHash(t *testing.T) {
+	require := require.New(t)
+	tx := &Transaction{Type: SendTx, Amount: big.NewInt(1), UseRlp: true}
+	withoutMaxBlock := signatureHash(tx)
+
+	tx.MaxBlock = 100
+	withMaxBlock := signatureHash(tx)
+	require.NotEqual(withoutMaxBlock, withMaxBlock)
+
+	tx.MaxBlock = 101
+	require.NotEqual(withMaxBlock, signatureHash(tx))
+
+	tx.MaxBlock = 0
+	require.Equal(withoutMaxBlock, signatureHash(tx))
+}
@@ -0,0 +1,92 @@
+package types
+
+import (
+	"bytes"
+	"container/heap"
+	"github.com/idena-network/idena-go/common"
+	"math/big"
+	"sort"
+)
+
+// SortCanonically orders txs descending by fee with ties broken by ascending tx hash,
+// txs of the same sender keep their epoch and nonce order so the result stays applicable
+func (txs Transactions) SortCanonically(fee func(tx *Transaction) *big.Int) {
+	if len(txs) < 2 {
+		return
+	}
+	queue := &canonicalTxQueue{
+		fees:     make(map[common.Hash]*big.Int, len(txs)),
+		bySender: make(map[common.Address][]*Transaction),
+	}
+	for _, tx := range txs {
+		sender, _ := Sender(tx)
+		queue.fees[tx.Hash()] = fee(tx)
+		queue.bySender[sender] = append(queue.bySender[sender], tx)
+	}
+	for _, senderTxs := range queue.bySender {
+		sort.SliceStable(senderTxs, func(i, j int) bool {
+			if senderTxs[i].Epoch != senderTxs[j].Epoch {
+				return senderTxs[i].Epoch < senderTxs[j].Epoch
+			}
+			return senderTxs[i].AccountNonce < senderTxs[j].AccountNonce
+		})
+		queue.heads = append(queue.heads, senderTxs[0])
+	}
+	heap.Init(queue)
+	for i := range txs {
+		tx := queue.heads[0]
+		txs[i] = tx
+		sender, _ := Sender(tx)
+		if rest := queue.bySender[sender][1:]; len(rest) > 0 {
+			queue.bySender[sender] = rest
+			queue.heads[0] = rest[0]
+			heap.Fix(queue, 0)
+		} else {
+			heap.Pop(queue)
+		}
+	}
+}
+
+// IsSortedCanonically checks that txs are ordered as SortCanonically orders them
+func (txs Transactions) IsSortedCanonically(fee func(tx *Transaction) *big.Int) bool {
+	sorted := make(Transactions, len(txs))
+	copy(sorted, txs)
+	sorted.SortCanonically(fee)
+	for i := range txs {
+		if txs[i].Hash() != sorted[i].Hash() {
+			return false
+		}
+	}
+	return true
+}
+
+// canonicalTxQueue keeps the first tx of every sender ordered by fee and hash
+type canonicalTxQueue struct {
+	heads    []*Transaction
+	fees     map[common.Hash]*big.Int
+	bySender map[common.Address][]*Transaction
+}
+
+func (q *canonicalTxQueue) Len() int { return len(q.heads) }
+
+func (q *canonicalTxQueue) Less(i, j int) bool {
+	iHash, jHash := q.heads[i].Hash(), q.heads[j].Hash()
+	if cmp := q.fees[iHash].Cmp(q.fees[jHash]); cmp != 0 {
+		return cmp > 0
+	}
+	return bytes.Compare(iHash[:], jHash[:]) < 0
+}
+
+func (q *canonicalTxQueue) Swap(i, j int) { q.heads[i], q.heads[j] = q.heads[j], q.heads[i] }
+
+func (q *canonicalTxQueue) Push(x interface{}) {
+	q.heads = append(q.heads, x.(*Transaction))
+}
+
+func (q *canonicalTxQueue) Pop() interface{} {
+	old := q.heads
+	n := len(old)
+	x := old[n-1]
+	q.heads = old[0 : n-1]
+	return x
+}
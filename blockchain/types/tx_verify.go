@@ -0,0 +1,37 @@
+package types
+
+import (
+	"github.com/idena-network/idena-go/common"
+	"github.com/pkg/errors"
+)
+
+// MaxTxDataSize is the payload limit for non-ceremonial txs
+const MaxTxDataSize = 512
+
+var (
+	ErrInvalidTxSignature  = errors.New("invalid tx signature")
+	ErrTxRecipientRequired = errors.New("recipient is required")
+	ErrNegativeTxAmount    = errors.New("tx amount is negative")
+	ErrTxDataTooLarge      = errors.New("tx payload is too large")
+)
+
+// Verify checks the signature and structure of the tx without accessing state, so obviously invalid txs
+// can be dropped before the full validation
+func (tx *Transaction) Verify() error {
+	if sender, err := Sender(tx); err != nil || sender == (common.Address{}) {
+		return ErrInvalidTxSignature
+	}
+	switch tx.Type {
+	case SendTx, InviteTx, ActivationTx:
+		if tx.To == nil {
+			return ErrTxRecipientRequired
+		}
+	}
+	if tx.Amount != nil && tx.Amount.Sign() < 0 {
+		return ErrNegativeTxAmount
+	}
+	if _, ok := CeremonialTxs[tx.Type]; !ok && len(tx.Payload) > MaxTxDataSize {
+		return ErrTxDataTooLarge
+	}
+	return nil
+}
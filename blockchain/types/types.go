@@ -2,15 +2,23 @@ package types
 
 import (
 	"bytes"
+	mapset "github.com/deckarep/golang-set"
 	"github.com/golang/protobuf/proto"
 	"github.com/idena-network/idena-go/common"
 	"github.com/idena-network/idena-go/crypto"
 	models "github.com/idena-network/idena-go/protobuf"
+	"github.com/pkg/errors"
 	"math/big"
 	"sync/atomic"
 	"time"
 )
 
+var (
+	ErrInsufficientQuorum = errors.New("not enough votes")
+	ErrDuplicateSigner    = errors.New("duplicate signer")
+	ErrInvalidSignature   = errors.New("invalid voter")
+)
+
 const (
 	SendTx               uint16 = 0x0
 	ActivationTx         uint16 = 0x1
@@ -27,6 +35,19 @@ const (
 	BurnTx               uint16 = 0xC
 	ChangeProfileTx      uint16 = 0xD
 	DeleteFlipTx         uint16 = 0xE
+	DelegateTx           uint16 = 0xF
+	UnstakeTx            uint16 = 0x10
+	ParamChangeTx        uint16 = 0x11
+	ClaimRewardsTx       uint16 = 0x12
+	SubmitFlipKeyTx      uint16 = 0x13
+)
+
+// MaxHeaderExtraDataSize limits ExtraData of block headers
+const MaxHeaderExtraDataSize = 32
+
+const (
+	minBlockTransactions         = 100
+	blockTransactionsPerIdentity = 5
 )
 
 const (
@@ -79,6 +100,24 @@ func BytesToSeed(b []byte) Seed {
 	return a
 }
 
+// IsValid is a lightweight entropy check: every 8-byte segment of the seed must have a non-zero byte,
+// a VRF output fails it with negligible probability
+func (h Seed) IsValid() bool {
+	for i := 0; i < len(h); i += 8 {
+		segmentIsZero := true
+		for _, b := range h[i : i+8] {
+			if b != 0 {
+				segmentIsZero = false
+				break
+			}
+		}
+		if segmentIsZero {
+			return false
+		}
+	}
+	return true
+}
+
 type EmptyBlockHeader struct {
 	ParentHash   common.Hash
 	Height       uint64
@@ -87,6 +126,10 @@ type EmptyBlockHeader struct {
 	BlockSeed    Seed
 	Time         int64
 	Flags        BlockFlag
+	// set only for empty blocks proposed with VRF seed
+	ProposerPubKey []byte
+	SeedProof      []byte
+	ExtraData      []byte
 }
 
 type ProposedHeader struct {
@@ -105,6 +148,10 @@ type ProposedHeader struct {
 	FeePerByte     *big.Int
 	Upgrade        uint32
 	SeedProof      []byte
+	// up to MaxHeaderExtraDataSize bytes for versioning and soft-fork signaling, no meaning in the current protocol
+	ExtraData []byte
+	// commitment to the whole block body, see Body.MerkleRoot
+	BodyRoot common.Hash
 }
 
 type Header struct {
@@ -144,9 +191,12 @@ type Transaction struct {
 	Type         TxType
 	To           *common.Address `rlp:"nil"`
 	Amount       *big.Int        `json:"value"`
-	MaxFee       *big.Int
+	MaxFee       *big.Int        // upper bound of the paid fee, nil means no limit
 	Tips         *big.Int
 	Payload      []byte `rlp:"nil"       json:"input"`
+	MaxBlock     uint64 `rlp:"-"` // last block height the tx can be included in, zero means no expiry
+	// receiver of the remaining balance of ActivationTx instead of To, nil keeps the funds with activated identity
+	RefundTo *common.Address `rlp:"-"`
 
 	Signature []byte
 
@@ -386,6 +436,63 @@ func (s *BurntCoins) FromBytes(data []byte) error {
 	return nil
 }
 
+type FeeRecord struct {
+	Height    uint64
+	TotalFee  *big.Int
+	BurnedFee *big.Int
+	TxCount   int
+}
+
+func (r *FeeRecord) ToBytes() ([]byte, error) {
+	protoObj := &models.ProtoFeeRecord{
+		Height:    r.Height,
+		TotalFee:  common.BigIntBytesOrNil(r.TotalFee),
+		BurnedFee: common.BigIntBytesOrNil(r.BurnedFee),
+		TxCount:   uint32(r.TxCount),
+	}
+	return proto.Marshal(protoObj)
+}
+
+func (r *FeeRecord) FromBytes(data []byte) error {
+	protoObj := new(models.ProtoFeeRecord)
+	if err := proto.Unmarshal(data, protoObj); err != nil {
+		return err
+	}
+	r.Height = protoObj.Height
+	r.TotalFee = common.BigIntOrNil(protoObj.TotalFee)
+	r.BurnedFee = common.BigIntOrNil(protoObj.BurnedFee)
+	r.TxCount = int(protoObj.TxCount)
+	return nil
+}
+
+// SupplyRecord keeps totals of coins minted and burnt by the protocol since genesis up to the block
+type SupplyRecord struct {
+	Minted *big.Int
+	Burnt  *big.Int
+}
+
+// ProposerRecord is a stored entry of proposer history, Timestamp is unix time in seconds
+type ProposerRecord struct {
+	BlockHeight     uint64
+	ProposerAddress common.Address
+	BlockHash       common.Hash
+	Timestamp       uint64
+}
+
+// CommitteeSizeRecord is a stored entry of committee size history
+type CommitteeSizeRecord struct {
+	BlockHeight   uint64
+	NetworkSize   uint64
+	CommitteeSize uint64
+}
+
+type IdentityStateChange struct {
+	Height   uint64
+	OldState uint8
+	NewState uint8
+	TxHash   common.Hash
+}
+
 func (b *Block) Hash() common.Hash {
 	if hash := b.hash.Load(); hash != nil {
 		return hash.(common.Hash)
@@ -395,6 +502,24 @@ func (b *Block) Hash() common.Hash {
 	return v
 }
 
+// Clone returns a deep copy of the block, cached hashes are not kept so the copy can be modified
+func (b *Block) Clone() *Block {
+	clone := &Block{}
+	if b.Header != nil {
+		clone.Header = b.Header.Clone()
+	}
+	if b.Body != nil {
+		clone.Body = &Body{}
+		if b.Body.Transactions != nil {
+			clone.Body.Transactions = make([]*Transaction, len(b.Body.Transactions))
+			for i, tx := range b.Body.Transactions {
+				clone.Body.Transactions[i] = tx.Clone()
+			}
+		}
+	}
+	return clone
+}
+
 func (b *Block) Hash128() common.Hash128 {
 	if hash := b.hash128.Load(); hash != nil {
 		return hash.(common.Hash128)
@@ -448,6 +573,23 @@ func (b *Block) ToBytes() ([]byte, error) {
 	return proto.Marshal(protoObj)
 }
 
+// Size returns the length of ToBytes() output computed from the encoded header and estimated tx sizes (see tx.EstimatedSize()),
+// so the body is not encoded and the result is never less than the actual size
+func (b *Block) Size() int {
+	size := 0
+	if b.Header != nil {
+		size += protoFieldSize(proto.Size(b.Header.ToProto()))
+	}
+	if b.Body != nil {
+		bodySize := 0
+		for _, tx := range b.Body.Transactions {
+			bodySize += tx.SizeInBlock()
+		}
+		size += protoFieldSize(bodySize)
+	}
+	return size
+}
+
 func (b *Block) FromBytes(data []byte) error {
 	protoObj := new(models.ProtoBlock)
 	if err := proto.Unmarshal(data, protoObj); err != nil {
@@ -480,13 +622,16 @@ func (h *Header) ToBytes() ([]byte, error) {
 func (h *Header) FromProto(protoHeader *models.ProtoBlockHeader) *Header {
 	if protoHeader.EmptyHeader != nil {
 		empty := &EmptyBlockHeader{
-			ParentHash:   common.BytesToHash(protoHeader.EmptyHeader.ParentHash),
-			Height:       protoHeader.EmptyHeader.Height,
-			Root:         common.BytesToHash(protoHeader.EmptyHeader.Root),
-			IdentityRoot: common.BytesToHash(protoHeader.EmptyHeader.IdentityRoot),
-			BlockSeed:    BytesToSeed(protoHeader.EmptyHeader.BlockSeed),
-			Time:         protoHeader.EmptyHeader.Timestamp,
-			Flags:        BlockFlag(protoHeader.EmptyHeader.Flags),
+			ParentHash:     common.BytesToHash(protoHeader.EmptyHeader.ParentHash),
+			Height:         protoHeader.EmptyHeader.Height,
+			Root:           common.BytesToHash(protoHeader.EmptyHeader.Root),
+			IdentityRoot:   common.BytesToHash(protoHeader.EmptyHeader.IdentityRoot),
+			BlockSeed:      BytesToSeed(protoHeader.EmptyHeader.BlockSeed),
+			Time:           protoHeader.EmptyHeader.Timestamp,
+			Flags:          BlockFlag(protoHeader.EmptyHeader.Flags),
+			ProposerPubKey: protoHeader.EmptyHeader.ProposerPubKey,
+			SeedProof:      protoHeader.EmptyHeader.SeedProof,
+			ExtraData:      protoHeader.EmptyHeader.ExtraData,
 		}
 		h.EmptyBlockHeader = empty
 	}
@@ -506,6 +651,8 @@ func (h *Header) FromProto(protoHeader *models.ProtoBlockHeader) *Header {
 			FeePerByte:     common.BigIntOrNil(protoHeader.ProposedHeader.FeePerByte),
 			Upgrade:        protoHeader.ProposedHeader.Upgrade,
 			SeedProof:      protoHeader.ProposedHeader.SeedProof,
+			ExtraData:      protoHeader.ProposedHeader.ExtraData,
+			BodyRoot:       common.BytesToHash(protoHeader.ProposedHeader.BodyRoot),
 		}
 		if len(protoHeader.ProposedHeader.OfflineAddr) > 0 {
 			addr := common.BytesToAddress(protoHeader.ProposedHeader.OfflineAddr)
@@ -533,6 +680,33 @@ func (h *Header) Hash() common.Hash {
 	return h.EmptyBlockHeader.Hash()
 }
 
+// Clone returns a deep copy of the header
+func (h *Header) Clone() *Header {
+	clone := &Header{}
+	if h.EmptyBlockHeader != nil {
+		emptyHeader := *h.EmptyBlockHeader
+		emptyHeader.ProposerPubKey = common.CopyBytes(emptyHeader.ProposerPubKey)
+		emptyHeader.SeedProof = common.CopyBytes(emptyHeader.SeedProof)
+		emptyHeader.ExtraData = common.CopyBytes(emptyHeader.ExtraData)
+		clone.EmptyBlockHeader = &emptyHeader
+	}
+	if h.ProposedHeader != nil {
+		proposedHeader := *h.ProposedHeader
+		proposedHeader.ProposerPubKey = common.CopyBytes(proposedHeader.ProposerPubKey)
+		proposedHeader.IpfsHash = common.CopyBytes(proposedHeader.IpfsHash)
+		proposedHeader.TxBloom = common.CopyBytes(proposedHeader.TxBloom)
+		proposedHeader.SeedProof = common.CopyBytes(proposedHeader.SeedProof)
+		proposedHeader.ExtraData = common.CopyBytes(proposedHeader.ExtraData)
+		proposedHeader.FeePerByte = copyBigInt(proposedHeader.FeePerByte)
+		if proposedHeader.OfflineAddr != nil {
+			offlineAddr := *proposedHeader.OfflineAddr
+			proposedHeader.OfflineAddr = &offlineAddr
+		}
+		clone.ProposedHeader = &proposedHeader
+	}
+	return clone
+}
+
 func (h *Header) Height() uint64 {
 	if h.ProposedHeader != nil {
 		return h.ProposedHeader.Height
@@ -612,6 +786,26 @@ func (h *Header) Coinbase() common.Address {
 	}
 }
 
+// ProposerAddress returns address of the block proposer, for empty blocks it is derived from the VRF proposer key
+// and is zero if the empty block has no proposer attribution
+func (h *Header) ProposerAddress() common.Address {
+	if h.EmptyBlockHeader != nil {
+		if len(h.EmptyBlockHeader.ProposerPubKey) == 0 {
+			return common.Address{}
+		}
+		addr, _ := crypto.PubKeyBytesToAddress(h.EmptyBlockHeader.ProposerPubKey)
+		return addr
+	}
+	return h.Coinbase()
+}
+
+func (h *Header) ExtraData() []byte {
+	if h.EmptyBlockHeader != nil {
+		return h.EmptyBlockHeader.ExtraData
+	}
+	return h.ProposedHeader.ExtraData
+}
+
 func (h *Header) OfflineAddr() *common.Address {
 	if h.EmptyBlockHeader != nil {
 		return nil
@@ -643,10 +837,15 @@ func (h *ProposedHeader) ToProto() *models.ProtoBlockHeader_Proposed {
 		FeePerByte:     common.BigIntBytesOrNil(h.FeePerByte),
 		Upgrade:        h.Upgrade,
 		SeedProof:      h.SeedProof,
+		ExtraData:      h.ExtraData,
 	}
 	if h.OfflineAddr != nil {
 		protoProposed.OfflineAddr = (*h.OfflineAddr)[:]
 	}
+	// genesis headers have no body root, omitting it keeps their hashes
+	if h.BodyRoot != (common.Hash{}) {
+		protoProposed.BodyRoot = h.BodyRoot[:]
+	}
 	return protoProposed
 }
 
@@ -657,13 +856,16 @@ func (h *ProposedHeader) Hash() common.Hash {
 
 func (h *EmptyBlockHeader) ToProto() *models.ProtoBlockHeader_Empty {
 	protoEmpty := &models.ProtoBlockHeader_Empty{
-		ParentHash:   h.ParentHash[:],
-		Height:       h.Height,
-		Root:         h.Root[:],
-		IdentityRoot: h.IdentityRoot[:],
-		Timestamp:    h.Time,
-		BlockSeed:    h.BlockSeed[:],
-		Flags:        uint32(h.Flags),
+		ParentHash:     h.ParentHash[:],
+		Height:         h.Height,
+		Root:           h.Root[:],
+		IdentityRoot:   h.IdentityRoot[:],
+		Timestamp:      h.Time,
+		BlockSeed:      h.BlockSeed[:],
+		Flags:          uint32(h.Flags),
+		ProposerPubKey: h.ProposerPubKey,
+		SeedProof:      h.SeedProof,
+		ExtraData:      h.ExtraData,
 	}
 	return protoEmpty
 }
@@ -788,6 +990,52 @@ func (tx *Transaction) TipsOrZero() *big.Int {
 	return tx.Tips
 }
 
+// Expired reports whether the tx can no longer be included in a block with given height
+func (tx *Transaction) Expired(height uint64) bool {
+	return tx.MaxBlock > 0 && tx.MaxBlock < height
+}
+
+// Clone returns a deep copy of the tx, cached hashes and sender are kept
+func (tx *Transaction) Clone() *Transaction {
+	clone := &Transaction{
+		AccountNonce: tx.AccountNonce,
+		Epoch:        tx.Epoch,
+		Type:         tx.Type,
+		Amount:       copyBigInt(tx.Amount),
+		MaxFee:       copyBigInt(tx.MaxFee),
+		Tips:         copyBigInt(tx.Tips),
+		Payload:      common.CopyBytes(tx.Payload),
+		MaxBlock:     tx.MaxBlock,
+		Signature:    common.CopyBytes(tx.Signature),
+		UseRlp:       tx.UseRlp,
+	}
+	if tx.To != nil {
+		to := *tx.To
+		clone.To = &to
+	}
+	if tx.RefundTo != nil {
+		refundTo := *tx.RefundTo
+		clone.RefundTo = &refundTo
+	}
+	if hash := tx.hash.Load(); hash != nil {
+		clone.hash.Store(hash)
+	}
+	if hash128 := tx.hash128.Load(); hash128 != nil {
+		clone.hash128.Store(hash128)
+	}
+	if from := tx.from.Load(); from != nil {
+		clone.from.Store(from)
+	}
+	return clone
+}
+
+func copyBigInt(value *big.Int) *big.Int {
+	if value == nil {
+		return nil
+	}
+	return new(big.Int).Set(value)
+}
+
 func (tx *Transaction) Hash() common.Hash {
 	if hash := tx.hash.Load(); hash != nil {
 		return hash.(common.Hash)
@@ -817,32 +1065,96 @@ func (tx *Transaction) Size() int {
 	return len(b)
 }
 
+// EstimatedSize approximates Size() from field lengths without encoding the tx, the result is never less than Size()
+func (tx *Transaction) EstimatedSize() int {
+	bytesField := func(l int) int {
+		if l == 0 {
+			return 0
+		}
+		return 1 + proto.SizeVarint(uint64(l)) + l
+	}
+	varintField := func(v uint64) int {
+		if v == 0 {
+			return 0
+		}
+		return 1 + proto.SizeVarint(v)
+	}
+	bigIntLen := func(v *big.Int) int {
+		if v == nil {
+			return 0
+		}
+		return (v.BitLen() + 7) / 8
+	}
+	data := varintField(uint64(tx.AccountNonce)) + varintField(uint64(tx.Epoch)) + varintField(uint64(tx.Type)) +
+		bytesField(bigIntLen(tx.Amount)) + bytesField(bigIntLen(tx.MaxFee)) + bytesField(bigIntLen(tx.Tips)) +
+		bytesField(len(tx.Payload)) + varintField(tx.MaxBlock)
+	if tx.To != nil {
+		data += bytesField(common.AddressLength)
+	}
+	if tx.RefundTo != nil {
+		data += bytesField(common.AddressLength)
+	}
+	size := 1 + proto.SizeVarint(uint64(data)) + data + bytesField(len(tx.Signature))
+	if tx.UseRlp {
+		size += 2
+	}
+	return size
+}
+
+// SizeInBlock returns the estimated number of bytes the tx takes in the encoded block body
+func (tx *Transaction) SizeInBlock() int {
+	return protoFieldSize(tx.EstimatedSize())
+}
+
+// protoFieldSize returns the size of length-delimited protobuf field with one byte tag
+func protoFieldSize(l int) int {
+	return 1 + proto.SizeVarint(uint64(l)) + l
+}
+
+// DataHash returns keccak256 hash of the tx payload, empty hash for empty payload
+func (tx *Transaction) DataHash() common.Hash {
+	if len(tx.Payload) == 0 {
+		return common.Hash{}
+	}
+	return crypto.Keccak256Hash(tx.Payload)
+}
+
+// DataSize returns the payload size, it is already included into Size()
+func (tx *Transaction) DataSize() int {
+	return len(tx.Payload)
+}
+
 func (tx *Transaction) ToSignatureBytes() ([]byte, error) {
 	protoTx := &models.ProtoTransaction_Data{
-		Nonce:   tx.AccountNonce,
-		Epoch:   uint32(tx.Epoch),
-		Type:    uint32(tx.Type),
-		Payload: tx.Payload,
-		Amount:  common.BigIntBytesOrNil(tx.Amount),
-		Tips:    common.BigIntBytesOrNil(tx.Tips),
-		MaxFee:  common.BigIntBytesOrNil(tx.MaxFee),
+		Nonce:    tx.AccountNonce,
+		Epoch:    uint32(tx.Epoch),
+		Type:     uint32(tx.Type),
+		Payload:  tx.Payload,
+		Amount:   common.BigIntBytesOrNil(tx.Amount),
+		Tips:     common.BigIntBytesOrNil(tx.Tips),
+		MaxFee:   common.BigIntBytesOrNil(tx.MaxFee),
+		MaxBlock: tx.MaxBlock,
 	}
 	if tx.To != nil {
 		protoTx.To = tx.To.Bytes()
 	}
+	if tx.RefundTo != nil {
+		protoTx.RefundTo = tx.RefundTo.Bytes()
+	}
 	return proto.Marshal(protoTx)
 }
 
 func (tx *Transaction) ToProto() *models.ProtoTransaction {
 	protoTx := &models.ProtoTransaction{
 		Data: &models.ProtoTransaction_Data{
-			Nonce:   tx.AccountNonce,
-			Epoch:   uint32(tx.Epoch),
-			Type:    uint32(tx.Type),
-			Payload: tx.Payload,
-			Amount:  common.BigIntBytesOrNil(tx.Amount),
-			Tips:    common.BigIntBytesOrNil(tx.Tips),
-			MaxFee:  common.BigIntBytesOrNil(tx.MaxFee),
+			Nonce:    tx.AccountNonce,
+			Epoch:    uint32(tx.Epoch),
+			Type:     uint32(tx.Type),
+			Payload:  tx.Payload,
+			Amount:   common.BigIntBytesOrNil(tx.Amount),
+			Tips:     common.BigIntBytesOrNil(tx.Tips),
+			MaxFee:   common.BigIntBytesOrNil(tx.MaxFee),
+			MaxBlock: tx.MaxBlock,
 		},
 		Signature: tx.Signature,
 		UseRlp:    tx.UseRlp,
@@ -851,6 +1163,9 @@ func (tx *Transaction) ToProto() *models.ProtoTransaction {
 	if tx.To != nil {
 		protoTx.Data.To = tx.To.Bytes()
 	}
+	if tx.RefundTo != nil {
+		protoTx.Data.RefundTo = tx.RefundTo.Bytes()
+	}
 	return protoTx
 }
 
@@ -872,6 +1187,11 @@ func (tx *Transaction) FromProto(protoTx *models.ProtoTransaction) *Transaction
 		tx.Amount = common.BigIntOrNil(protoTx.Data.Amount)
 		tx.Tips = common.BigIntOrNil(protoTx.Data.Tips)
 		tx.MaxFee = common.BigIntOrNil(protoTx.Data.MaxFee)
+		tx.MaxBlock = protoTx.Data.MaxBlock
+		if refundTo := protoTx.Data.GetRefundTo(); refundTo != nil {
+			addr := common.BytesToAddress(refundTo)
+			tx.RefundTo = &addr
+		}
 	}
 
 	tx.Signature = protoTx.GetSignature()
@@ -948,6 +1268,48 @@ func (s *BlockCert) FromBytes(data []byte) error {
 	return nil
 }
 
+// Validate checks that cert signatures belong to distinct committee members and reach the quorum
+func (s *BlockCert) Validate(parentHash common.Hash, committee mapset.Set, quorum int) error {
+	return s.ValidateWeighted(parentHash, quorum, func(voter common.Address) int {
+		if committee != nil && committee.Contains(voter) {
+			return 1
+		}
+		return 0
+	})
+}
+
+// ValidateWeighted is like Validate but every voter is counted with the given weight, zero weight means non-member
+func (s *BlockCert) ValidateWeighted(parentHash common.Hash, quorum int, weight func(voter common.Address) int) error {
+	voters := mapset.NewSet()
+	votesWeight := 0
+	for _, signature := range s.Signatures {
+		vote := Vote{
+			Header: &VoteHeader{
+				Step:        s.Step,
+				Round:       s.Round,
+				TurnOffline: signature.TurnOffline,
+				Upgrade:     signature.Upgrade,
+				VotedHash:   s.VotedHash,
+				ParentHash:  parentHash,
+			},
+			Signature: signature.Signature,
+		}
+		voter := vote.VoterAddr()
+		voterWeight := weight(voter)
+		if voterWeight == 0 {
+			return ErrInvalidSignature
+		}
+		if !voters.Add(voter) {
+			return ErrDuplicateSigner
+		}
+		votesWeight += voterWeight
+	}
+	if votesWeight < quorum {
+		return ErrInsufficientQuorum
+	}
+	return nil
+}
+
 func (s *FullBlockCert) Compress() *BlockCert {
 	if len(s.Votes) == 0 {
 		return &BlockCert{}
@@ -1007,10 +1369,30 @@ func (b *Body) FromBytes(data []byte) {
 	b.FromProto(protoBody)
 }
 
+// MerkleRoot commits to the full body content unlike TxHash covering transactions only,
+// it is keccak256(TxHash || blockSeed || size of encoded body). Transactions hash of a body without transactions is zero.
+func (b *Body) MerkleRoot(blockSeed Seed) common.Hash {
+	var txHash common.Hash
+	var size uint64
+	if b != nil && len(b.Transactions) > 0 {
+		txHash = DeriveSha(Transactions(b.Transactions))
+		size = uint64(len(b.ToBytes()))
+	}
+	return crypto.Keccak256Hash(txHash[:], blockSeed[:], common.ToBytes(size))
+}
+
 func (b *Body) IsValid() bool {
 	return b != nil
 }
 
+// MaxTransactions limits block transactions count depending on network size, small networks get smaller blocks
+func MaxTransactions(networkSize int) int {
+	if limit := networkSize * blockTransactionsPerIdentity; limit > minBlockTransactions {
+		return limit
+	}
+	return minBlockTransactions
+}
+
 func (p *ProofProposal) ToSignatureBytes() ([]byte, error) {
 	protoObj := &models.ProtoProposeProof_Data{
 		Proof: p.Proof,
@@ -1,7 +1,15 @@
 package types
 
 import (
+	"bytes"
+	"crypto/ecdsa"
+	mapset "github.com/deckarep/golang-set"
+	"github.com/idena-network/idena-go/common"
+	"github.com/idena-network/idena-go/crypto"
+	"github.com/idena-network/idena-go/crypto/vrf/p256"
 	"github.com/stretchr/testify/require"
+	"math/big"
+	"math/rand"
 	"testing"
 )
 
@@ -51,3 +59,372 @@ func TestBlockCert_Empty(t *testing.T) {
 	var cert *BlockCert
 	require.True(t, cert.Empty())
 }
+
+func TestTransaction_DataSize(t *testing.T) {
+	to := common.Address{0x1}
+	for txType := SendTx; txType <= UnstakeTx; txType++ {
+		for _, payload := range [][]byte{nil, {}, {0x1, 0x2, 0x3}, make([]byte, 1024)} {
+			tx := &Transaction{
+				Type:      txType,
+				To:        &to,
+				Amount:    big.NewInt(10),
+				Payload:   payload,
+				Signature: []byte{0x1},
+			}
+			require.Equal(t, len(payload), tx.DataSize())
+			require.True(t, tx.DataSize() <= tx.Size())
+			if len(payload) == 0 {
+				require.Equal(t, common.Hash{}, tx.DataHash())
+			} else {
+				require.Equal(t, crypto.Keccak256Hash(payload), tx.DataHash())
+			}
+		}
+	}
+}
+
+func TestBlockCert_Validate(t *testing.T) {
+	require := require.New(t)
+	parentHash := common.Hash{0x1}
+	cert := &BlockCert{Round: 2, Step: Final, VotedHash: common.Hash{0x2}}
+	committee := mapset.NewSet()
+	sign := func() *BlockCertSignature {
+		key, _ := crypto.GenerateKey()
+		vote := &Vote{
+			Header: &VoteHeader{
+				Round:      cert.Round,
+				Step:       cert.Step,
+				ParentHash: parentHash,
+				VotedHash:  cert.VotedHash,
+			},
+		}
+		hash := crypto.SignatureHash(vote)
+		signature, _ := crypto.Sign(hash[:], key)
+		vote.Signature = signature
+		committee.Add(vote.VoterAddr())
+		return &BlockCertSignature{Signature: signature}
+	}
+	cert.Signatures = []*BlockCertSignature{sign(), sign()}
+
+	require.NoError(cert.Validate(parentHash, committee, 2))
+	require.Equal(ErrInsufficientQuorum, cert.Validate(parentHash, committee, 3))
+	require.Equal(ErrInvalidSignature, cert.Validate(common.Hash{0x3}, committee, 2))
+
+	cert.Signatures = append(cert.Signatures, cert.Signatures[0])
+	require.Equal(ErrDuplicateSigner, cert.Validate(parentHash, committee, 2))
+}
+
+func randomTx(rnd *rand.Rand) *Transaction {
+	randBigInt := func() *big.Int {
+		switch rnd.Intn(3) {
+		case 0:
+			return nil
+		case 1:
+			return big.NewInt(0)
+		default:
+			b := make([]byte, rnd.Intn(33))
+			rnd.Read(b)
+			return new(big.Int).SetBytes(b)
+		}
+	}
+	tx := &Transaction{
+		AccountNonce: rnd.Uint32() >> uint(rnd.Intn(32)),
+		Epoch:        uint16(rnd.Intn(1 << 16)),
+		Type:         TxType(rnd.Intn(20)),
+		Amount:       randBigInt(),
+		MaxFee:       randBigInt(),
+		Tips:         randBigInt(),
+		MaxBlock:     rnd.Uint64() >> uint(rnd.Intn(64)),
+		UseRlp:       rnd.Intn(2) == 0,
+	}
+	if rnd.Intn(2) == 0 {
+		to := common.Address{}
+		rnd.Read(to[:])
+		tx.To = &to
+	}
+	if rnd.Intn(4) == 0 {
+		refundTo := common.Address{}
+		rnd.Read(refundTo[:])
+		tx.RefundTo = &refundTo
+	}
+	if rnd.Intn(2) == 0 {
+		tx.Payload = make([]byte, rnd.Intn(1<<15))
+		rnd.Read(tx.Payload)
+	}
+	if rnd.Intn(2) == 0 {
+		tx.Signature = make([]byte, 65)
+		rnd.Read(tx.Signature)
+	}
+	return tx
+}
+
+func TestTransaction_EstimatedSize(t *testing.T) {
+	require := require.New(t)
+	rnd := rand.New(rand.NewSource(1))
+	for i := 0; i < 10000; i++ {
+		tx := randomTx(rnd)
+		size, estimated := tx.Size(), tx.EstimatedSize()
+		require.True(estimated >= size, "estimated %v, size %v", estimated, size)
+		require.True(estimated-size <= 8, "estimated %v, size %v", estimated, size)
+	}
+}
+
+func TestTransaction_RefundToBytes(t *testing.T) {
+	require := require.New(t)
+	refundTo := common.Address{0x1, 0x2}
+	tx := &Transaction{Type: ActivationTx, RefundTo: &refundTo}
+	data, err := tx.ToBytes()
+	require.NoError(err)
+
+	decoded := new(Transaction)
+	require.NoError(decoded.FromBytes(data))
+	require.Equal(&refundTo, decoded.RefundTo)
+
+	tx.RefundTo = nil
+	data, err = tx.ToBytes()
+	require.NoError(err)
+	decoded = new(Transaction)
+	require.NoError(decoded.FromBytes(data))
+	require.Nil(decoded.RefundTo)
+}
+
+func TestHeader_ExtraData(t *testing.T) {
+	require := require.New(t)
+	headers := []*Header{
+		{ProposedHeader: &ProposedHeader{Height: 2}},
+		{EmptyBlockHeader: &EmptyBlockHeader{Height: 2}},
+	}
+	for _, header := range headers {
+		hash := header.Hash()
+		if header.ProposedHeader != nil {
+			header.ProposedHeader.ExtraData = []byte{0x1, 0x2}
+		} else {
+			header.EmptyBlockHeader.ExtraData = []byte{0x1, 0x2}
+		}
+		require.NotEqual(hash, header.Hash())
+
+		data, err := header.ToBytes()
+		require.NoError(err)
+		decoded := new(Header)
+		require.NoError(decoded.FromBytes(data))
+		require.Equal([]byte{0x1, 0x2}, decoded.ExtraData())
+		require.Equal(header.Hash(), decoded.Hash())
+	}
+}
+
+func TestBody_MerkleRoot(t *testing.T) {
+	require := require.New(t)
+	seed := Seed{0x1}
+	empty := (&Body{}).MerkleRoot(seed)
+	require.Equal(crypto.Keccak256Hash(make([]byte, 32), seed[:], make([]byte, 8)), empty)
+	require.Equal(empty, (*Body)(nil).MerkleRoot(seed))
+	require.NotEqual(empty, (&Body{}).MerkleRoot(Seed{0x2}))
+
+	rnd := rand.New(rand.NewSource(1))
+	body := &Body{Transactions: []*Transaction{randomTx(rnd), randomTx(rnd)}}
+	root := body.MerkleRoot(seed)
+	require.NotEqual(empty, root)
+	require.Equal(root, new(Body).FromProto(body.ToProto()).MerkleRoot(seed))
+	body.Transactions = body.Transactions[:1]
+	require.NotEqual(root, body.MerkleRoot(seed))
+
+	header := &Header{ProposedHeader: &ProposedHeader{Height: 1}}
+	hash := header.Hash()
+	header.ProposedHeader.BodyRoot = root
+	require.NotEqual(hash, header.Hash())
+	data, err := header.ToBytes()
+	require.NoError(err)
+	decoded := new(Header)
+	require.NoError(decoded.FromBytes(data))
+	require.Equal(root, decoded.ProposedHeader.BodyRoot)
+}
+
+func TestBlock_Size(t *testing.T) {
+	require := require.New(t)
+	rnd := rand.New(rand.NewSource(1))
+	for i := 0; i < 200; i++ {
+		block := &Block{
+			Header: &Header{
+				ProposedHeader: &ProposedHeader{
+					Height:         rnd.Uint64(),
+					Time:           rnd.Int63(),
+					ProposerPubKey: make([]byte, 65),
+					TxBloom:        make([]byte, rnd.Intn(512)),
+					FeePerByte:     big.NewInt(rnd.Int63()),
+				},
+			},
+			Body: &Body{},
+		}
+		rnd.Read(block.Header.ProposedHeader.ParentHash[:])
+		rnd.Read(block.Header.ProposedHeader.TxHash[:])
+		for j := rnd.Intn(50); j > 0; j-- {
+			block.Body.Transactions = append(block.Body.Transactions, randomTx(rnd))
+		}
+		data, err := block.ToBytes()
+		require.NoError(err)
+		size := block.Size()
+		require.True(size >= len(data), "estimated %v, size %v", size, len(data))
+		require.True(float64(size-len(data)) <= float64(len(data))*0.02, "estimated %v, size %v", size, len(data))
+	}
+
+	empty := &Block{Header: &Header{EmptyBlockHeader: &EmptyBlockHeader{Height: 1}}, Body: &Body{}}
+	data, _ := empty.ToBytes()
+	require.Equal(len(data), empty.Size())
+}
+
+func TestTransactions_SortCanonically(t *testing.T) {
+	require := require.New(t)
+	key1, _ := crypto.GenerateKey()
+	key2, _ := crypto.GenerateKey()
+	sign := func(key *ecdsa.PrivateKey, nonce uint32, fee int64) *Transaction {
+		tx, _ := SignTx(&Transaction{AccountNonce: nonce, MaxFee: big.NewInt(fee)}, key)
+		return tx
+	}
+	fee := func(tx *Transaction) *big.Int {
+		return tx.MaxFee
+	}
+	a1, a2, a3 := sign(key1, 1, 1), sign(key1, 2, 5), sign(key1, 3, 2)
+	b1, b2 := sign(key2, 1, 3), sign(key2, 2, 3)
+
+	txs := Transactions{a3, b2, a2, b1, a1}
+	require.False(txs.IsSortedCanonically(fee))
+	txs.SortCanonically(fee)
+	require.Equal(Transactions{b1, b2, a1, a2, a3}, txs)
+	require.True(txs.IsSortedCanonically(fee))
+
+	// equal fees are ordered by hash
+	c1, c2 := sign(key1, 1, 3), sign(key2, 1, 3)
+	txs = Transactions{c1, c2}
+	txs.SortCanonically(fee)
+	if bytes.Compare(c1.Hash().Bytes(), c2.Hash().Bytes()) < 0 {
+		require.Equal(Transactions{c1, c2}, txs)
+	} else {
+		require.Equal(Transactions{c2, c1}, txs)
+	}
+}
+
+func TestMaxTransactions(t *testing.T) {
+	require.Equal(t, 100, MaxTransactions(0))
+	require.Equal(t, 100, MaxTransactions(20))
+	require.Equal(t, 105, MaxTransactions(21))
+	require.Equal(t, 5000, MaxTransactions(1000))
+}
+
+func TestSeed_IsValid(t *testing.T) {
+	require := require.New(t)
+
+	require.False(Seed{}.IsValid())
+
+	partiallyZero := Seed{}
+	for i := range partiallyZero {
+		if i < 8 || i >= 16 {
+			partiallyZero[i] = 0x1
+		}
+	}
+	require.False(partiallyZero.IsValid())
+
+	partiallyZero[15] = 0x1
+	require.True(partiallyZero.IsValid())
+
+	key, _ := crypto.GenerateKey()
+	signer, err := p256.NewVRFSigner(key)
+	require.NoError(err)
+	hash, proof := signer.Evaluate([]byte("seed data"))
+	verifier, err := p256.NewVRFVerifier(&key.PublicKey)
+	require.NoError(err)
+	verified, err := verifier.ProofToHash([]byte("seed data"), proof)
+	require.NoError(err)
+	require.Equal(hash, verified)
+	require.True(Seed(hash).IsValid())
+}
+
+func TestTransaction_Clone(t *testing.T) {
+	require := require.New(t)
+	for i := 0; i < 20; i++ {
+		seed := rand.Int63()
+		build := func() *Transaction {
+			r := rand.New(rand.NewSource(seed))
+			to, refundTo := common.Address{}, common.Address{}
+			r.Read(to[:])
+			r.Read(refundTo[:])
+			payload := make([]byte, r.Intn(100)+1)
+			r.Read(payload)
+			signature := make([]byte, 65)
+			r.Read(signature)
+			return &Transaction{
+				AccountNonce: r.Uint32(),
+				Epoch:        uint16(r.Uint32()),
+				Type:         TxType(r.Intn(int(SubmitFlipKeyTx) + 1)),
+				To:           &to,
+				Amount:       big.NewInt(r.Int63()),
+				MaxFee:       big.NewInt(r.Int63()),
+				Tips:         big.NewInt(r.Int63()),
+				Payload:      payload,
+				MaxBlock:     r.Uint64(),
+				RefundTo:     &refundTo,
+				Signature:    signature,
+				UseRlp:       r.Intn(2) == 0,
+			}
+		}
+		expected, original := build(), build()
+		clone := original.Clone()
+
+		original.AccountNonce++
+		original.Epoch++
+		original.Type++
+		original.To[0]++
+		original.Amount.Add(original.Amount, common.Big1)
+		original.MaxFee.Add(original.MaxFee, common.Big1)
+		original.Tips.Add(original.Tips, common.Big1)
+		original.Payload[0]++
+		original.MaxBlock++
+		original.RefundTo[0]++
+		original.Signature[0]++
+		original.UseRlp = !original.UseRlp
+
+		require.Equal(expected, clone)
+	}
+
+	tx := &Transaction{Type: SendTx}
+	require.Equal(tx, tx.Clone())
+	require.Equal(tx.Hash(), tx.Clone().Hash())
+}
+
+func TestBlock_Clone(t *testing.T) {
+	require := require.New(t)
+	offlineAddr := common.Address{0x1}
+	block := &Block{
+		Header: &Header{
+			ProposedHeader: &ProposedHeader{
+				Height:         10,
+				Root:           common.Hash{0x2},
+				ProposerPubKey: []byte{0x3},
+				OfflineAddr:    &offlineAddr,
+				FeePerByte:     big.NewInt(4),
+				ExtraData:      []byte{0x5},
+			},
+		},
+		Body: &Body{
+			Transactions: []*Transaction{{Type: SendTx, Amount: big.NewInt(6)}},
+		},
+	}
+	hash := block.Hash()
+	clone := block.Clone()
+	require.Equal(hash, clone.Header.Hash())
+
+	clone.Header.ProposedHeader.Root = common.Hash{0x7}
+	clone.Header.ProposedHeader.ProposerPubKey[0]++
+	clone.Header.ProposedHeader.OfflineAddr[0]++
+	clone.Header.ProposedHeader.FeePerByte.SetInt64(8)
+	clone.Body.Transactions[0].Amount.SetInt64(9)
+	require.NotEqual(hash, clone.Hash())
+
+	require.Equal(common.Hash{0x2}, block.Root())
+	require.Equal([]byte{0x3}, block.Header.ProposedHeader.ProposerPubKey)
+	require.Equal(common.Address{0x1}, *block.Header.OfflineAddr())
+	require.Equal(big.NewInt(4), block.Header.FeePerByte())
+	require.Equal(big.NewInt(6), block.Body.Transactions[0].Amount)
+	require.Equal(hash, block.Hash())
+
+	empty := &Block{Header: &Header{EmptyBlockHeader: &EmptyBlockHeader{Height: 1, ExtraData: []byte{0x1}}}, Body: &Body{}}
+	require.Equal(empty.Hash(), empty.Clone().Hash())
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/idena-network/idena-go/blockchain/fee"
 	"github.com/idena-network/idena-go/blockchain/types"
 	"github.com/idena-network/idena-go/common"
+	"github.com/idena-network/idena-go/config"
 	"github.com/idena-network/idena-go/core/appstate"
 	"github.com/idena-network/idena-go/core/state"
 	"github.com/idena-network/idena-go/crypto"
@@ -17,6 +18,9 @@ import (
 
 const (
 	MaxPayloadSize           = 3 * 1024
+	MaxTxDataSize            = types.MaxTxDataSize
+	MaxProfileHashSize       = 64
+	MaxFlipKeyHashSize       = 46
 	GodValidUntilNetworkSize = 10
 )
 
@@ -40,6 +44,7 @@ var (
 	EmptyPayload         = errors.New("payload can't be empty")
 	InvalidEpochTx       = errors.New("invalid epoch tx")
 	InvalidPayload       = errors.New("invalid payload")
+	ErrTxDataTooLarge    = types.ErrTxDataTooLarge
 	InvalidRecipient     = errors.New("invalid recipient")
 	EarlyTx              = errors.New("tx can't be accepted due to wrong period")
 	LateTx               = errors.New("tx can't be accepted due to validation ceremony")
@@ -55,6 +60,15 @@ var (
 	InvalidSender        = errors.New("invalid sender")
 	FlipIsMissing        = errors.New("flip is missing")
 	DuplicatedTx         = errors.New("duplicated tx")
+	ExpiredTx            = errors.New("tx is expired")
+	ChainedDelegation    = errors.New("delegatee can't delegate")
+	PendingWithdrawal    = errors.New("identity already has pending withdrawal")
+	NotCommitteeMember   = errors.New("sender is not a verified committee member")
+	InvalidRefundTo      = errors.New("invalid refund address")
+	LowInviterBalance    = errors.New("inviter balance is lower than minimal")
+	NoPendingRewards     = errors.New("there are no pending rewards")
+	NotVerified          = errors.New("sender is not verified")
+	DuplicatedFlipKey    = errors.New("flip key is already submitted in this epoch")
 	validators           map[types.TxType]validator
 )
 
@@ -63,10 +77,12 @@ var (
 		types.SendTx:          true,
 		types.BurnTx:          true,
 		types.ChangeProfileTx: true,
+		types.ParamChangeTx:   true,
+		types.ClaimRewardsTx:  true,
 	}
 )
 
-type validator func(appState *appstate.AppState, tx *types.Transaction, txType TxType) error
+type validator func(appState *appstate.AppState, tx *types.Transaction, txType TxType, conf *config.ConsensusConf) error
 
 func init() {
 	validators = map[types.TxType]validator{
@@ -85,9 +101,19 @@ func init() {
 		types.BurnTx:               validateBurnTx,
 		types.ChangeProfileTx:      validateChangeProfileTx,
 		types.DeleteFlipTx:         validateDeleteFlipTx,
+		types.DelegateTx:           validateDelegateTx,
+		types.UnstakeTx:            validateUnstakeTx,
+		types.ParamChangeTx:        validateParamChangeTx,
+		types.ClaimRewardsTx:       validateClaimRewardsTx,
+		types.SubmitFlipKeyTx:      validateSubmitFlipKeyTx,
 	}
 }
 
+// isForkActivated reports whether the tx is validated with rules guarded by the fork
+func isForkActivated(appState *appstate.AppState, conf *config.ConsensusConf) bool {
+	return conf.IsForkActivated(uint64(appState.State.Version()) + 1)
+}
+
 func checkIfNonNegative(value *big.Int) error {
 	if value == nil {
 		return nil
@@ -98,7 +124,9 @@ func checkIfNonNegative(value *big.Int) error {
 	return nil
 }
 
-func ValidateTx(appState *appstate.AppState, tx *types.Transaction, minFeePerByte *big.Int, txType TxType) error {
+// ValidateTx checks the tx against appState for inclusion into the block following the state version,
+// conf provides consensus params the validity depends on
+func ValidateTx(appState *appstate.AppState, tx *types.Transaction, minFeePerByte *big.Int, txType TxType, conf *config.ConsensusConf) error {
 	sender, _ := types.Sender(tx)
 
 	if sender == (common.Address{}) {
@@ -109,6 +137,11 @@ func ValidateTx(appState *appstate.AppState, tx *types.Transaction, minFeePerByt
 		return InvalidPayload
 	}
 
+	// not checked for block txs, larger payloads were valid before the limit was introduced
+	if _, ok := types.CeremonialTxs[tx.Type]; !ok && txType != InBlockTx && len(tx.Payload) > MaxTxDataSize {
+		return ErrTxDataTooLarge
+	}
+
 	if err := checkIfNonNegative(tx.Amount); err != nil {
 		return errors.Wrap(err, "amount")
 	}
@@ -127,14 +160,22 @@ func ValidateTx(appState *appstate.AppState, tx *types.Transaction, minFeePerByt
 		return InvalidEpoch
 	}
 
+	if tx.Expired(uint64(appState.State.Version()) + 1) {
+		return ExpiredTx
+	}
+
+	if tx.RefundTo != nil && tx.Type != types.ActivationTx {
+		return InvalidRefundTo
+	}
+
 	nonce, epoch := appState.State.GetNonce(sender), appState.State.GetEpoch(sender)
 
 	if nonce >= tx.AccountNonce && epoch == globalEpoch && tx.Epoch == globalEpoch {
 		return errors.Wrapf(InvalidNonce, "state nonce: %v, state epoch: %v, tx nonce: %v, tx epoch: %v", nonce, epoch, tx.AccountNonce, tx.Epoch)
 	}
 
-	minFee := fee.CalculateFee(appState.ValidatorsCache.NetworkSize(), minFeePerByte, tx)
-	if minFee.Cmp(tx.MaxFeeOrZero()) == 1 {
+	minFee := fee.CalculateFee(appState.ValidatorsCache.NetworkSize(), minFeePerByte, tx, isForkActivated(appState, conf))
+	if !isMaxFeeUnlimited(appState, tx, conf) && minFee.Cmp(tx.MaxFeeOrZero()) == 1 {
 		return InvalidMaxFee
 	}
 
@@ -147,19 +188,19 @@ func ValidateTx(appState *appstate.AppState, tx *types.Transaction, minFeePerByt
 	if !ok {
 		return nil
 	}
-	if err := validator(appState, tx, txType); err != nil {
+	if err := validator(appState, tx, txType, conf); err != nil {
 		return err
 	}
 
 	return nil
 }
 
-func validateTotalCost(sender common.Address, appState *appstate.AppState, tx *types.Transaction, txType TxType) error {
+func validateTotalCost(sender common.Address, appState *appstate.AppState, tx *types.Transaction, txType TxType, conf *config.ConsensusConf) error {
 	var cost *big.Int
 	if txType != InBlockTx {
-		cost = calculateMaxCost(tx)
+		cost = calculateMaxCost(appState, tx, conf)
 	} else {
-		cost = fee.CalculateCost(appState.ValidatorsCache.NetworkSize(), appState.State.FeePerByte(), tx)
+		cost = fee.CalculateCost(appState.ValidatorsCache.NetworkSize(), appState.State.FeePerByte(), tx, appState.State.Epoch(), conf, isForkActivated(appState, conf))
 	}
 	if cost.Sign() > 0 && appState.State.GetBalance(sender).Cmp(cost) < 0 {
 		return InsufficientFunds
@@ -180,38 +221,57 @@ func validateCeremonyTx(sender common.Address, appState *appstate.AppState, tx *
 	return nil
 }
 
-func ValidateFee(appState *appstate.AppState, tx *types.Transaction, txType TxType) error {
+func ValidateFee(appState *appstate.AppState, tx *types.Transaction, txType TxType, conf *config.ConsensusConf) error {
 	if txType != InBlockTx {
 		return nil
 	}
-	feeAmount := fee.CalculateFee(appState.ValidatorsCache.NetworkSize(), appState.State.FeePerByte(), tx)
+	if isMaxFeeUnlimited(appState, tx, conf) {
+		return nil
+	}
+	feeAmount := fee.CalculateFee(appState.ValidatorsCache.NetworkSize(), appState.State.FeePerByte(), tx, isForkActivated(appState, conf))
 	if feeAmount.Cmp(tx.MaxFeeOrZero()) == 1 {
 		return BigFee
 	}
 	return nil
 }
 
-func calculateMaxCost(tx *types.Transaction) *big.Int {
+// isMaxFeeUnlimited reports whether the sender accepts any fee, nil max fee means no limit after the fork and zero before
+func isMaxFeeUnlimited(appState *appstate.AppState, tx *types.Transaction, conf *config.ConsensusConf) bool {
+	return tx.MaxFee == nil && isForkActivated(appState, conf)
+}
+
+// maxFee returns tx max fee or the current fee if max fee is not limited
+func maxFee(appState *appstate.AppState, tx *types.Transaction, conf *config.ConsensusConf) *big.Int {
+	if isMaxFeeUnlimited(appState, tx, conf) {
+		return fee.CalculateFee(appState.ValidatorsCache.NetworkSize(), appState.State.FeePerByte(), tx, isForkActivated(appState, conf))
+	}
+	return tx.MaxFeeOrZero()
+}
+
+func calculateMaxCost(appState *appstate.AppState, tx *types.Transaction, conf *config.ConsensusConf) *big.Int {
 	result := big.NewInt(0)
 	result.Add(result, tx.AmountOrZero())
 	result.Add(result, tx.TipsOrZero())
-	result.Add(result, tx.MaxFeeOrZero())
+	result.Add(result, maxFee(appState, tx, conf))
+	if tx.Type == types.InviteTx {
+		result.Add(result, fee.CalculateInvitationCost(appState.State.Epoch(), appState.ValidatorsCache.NetworkSize(), conf))
+	}
 	return result
 }
 
 // specific validation for sendTx
-func validateSendTx(appState *appstate.AppState, tx *types.Transaction, txType TxType) error {
+func validateSendTx(appState *appstate.AppState, tx *types.Transaction, txType TxType, conf *config.ConsensusConf) error {
 	sender, _ := types.Sender(tx)
 
 	if tx.To == nil {
 		return RecipientRequired
 	}
 
-	if err := ValidateFee(appState, tx, txType); err != nil {
+	if err := ValidateFee(appState, tx, txType, conf); err != nil {
 		return err
 	}
 
-	if err := validateTotalCost(sender, appState, tx, txType); err != nil {
+	if err := validateTotalCost(sender, appState, tx, txType, conf); err != nil {
 		return err
 	}
 
@@ -219,7 +279,7 @@ func validateSendTx(appState *appstate.AppState, tx *types.Transaction, txType T
 }
 
 // specific validation for approving tx
-func validateActivationTx(appState *appstate.AppState, tx *types.Transaction, txType TxType) error {
+func validateActivationTx(appState *appstate.AppState, tx *types.Transaction, txType TxType, conf *config.ConsensusConf) error {
 	sender, _ := types.Sender(tx)
 
 	if len(tx.Payload) == 0 {
@@ -237,10 +297,10 @@ func validateActivationTx(appState *appstate.AppState, tx *types.Transaction, tx
 	if tx.To == nil || *tx.To == (common.Address{}) {
 		return RecipientRequired
 	}
-	if err := ValidateFee(appState, tx, txType); err != nil {
+	if err := ValidateFee(appState, tx, txType, conf); err != nil {
 		return err
 	}
-	if err := validateTotalCost(sender, appState, tx, txType); err != nil {
+	if err := validateTotalCost(sender, appState, tx, txType, conf); err != nil {
 		return err
 	}
 	if appState.ValidatorsCache.Contains(*tx.To) {
@@ -258,21 +318,20 @@ func validateActivationTx(appState *appstate.AppState, tx *types.Transaction, tx
 		return InvalidRecipient
 	}
 
+	if tx.RefundTo != nil && (*tx.RefundTo == sender || appState.State.GetIdentityState(*tx.RefundTo) != state.Verified) {
+		return InvalidRefundTo
+	}
+
 	return nil
 }
 
-func validateSendInviteTx(appState *appstate.AppState, tx *types.Transaction, txType TxType) error {
+func validateSendInviteTx(appState *appstate.AppState, tx *types.Transaction, txType TxType, conf *config.ConsensusConf) error {
 	sender, _ := types.Sender(tx)
 
 	if tx.To == nil || *tx.To == (common.Address{}) {
 		return RecipientRequired
 	}
-	if err := ValidateFee(appState, tx, txType); err != nil {
-		return err
-	}
-	if err := validateTotalCost(sender, appState, tx, txType); err != nil {
-		return err
-	}
+	// applying the tx subtracts an invite, so senders without invites are rejected before other checks
 	godAddress := appState.State.GodAddress()
 	if sender != godAddress && appState.State.GetInvites(sender) == 0 {
 		return InsufficientInvites
@@ -280,6 +339,17 @@ func validateSendInviteTx(appState *appstate.AppState, tx *types.Transaction, tx
 	if sender == godAddress && appState.State.GodAddressInvites() == 0 {
 		return InsufficientInvites
 	}
+	if err := ValidateFee(appState, tx, txType, conf); err != nil {
+		return err
+	}
+	if err := validateTotalCost(sender, appState, tx, txType, conf); err != nil {
+		return err
+	}
+	// block txs are checked only after the fork, invites of lower balance senders were valid before
+	if conf.MinInviterBalance != nil && (txType != InBlockTx || isForkActivated(appState, conf)) &&
+		appState.State.GetBalance(sender).Cmp(conf.MinInviterBalance) < 0 {
+		return LowInviterBalance
+	}
 	if appState.State.ValidationPeriod() >= state.FlipLotteryPeriod {
 		return LateTx
 	}
@@ -293,16 +363,16 @@ func validateSendInviteTx(appState *appstate.AppState, tx *types.Transaction, tx
 	return nil
 }
 
-func validateSubmitFlipTx(appState *appstate.AppState, tx *types.Transaction, txType TxType) error {
+func validateSubmitFlipTx(appState *appstate.AppState, tx *types.Transaction, txType TxType, conf *config.ConsensusConf) error {
 	sender, _ := types.Sender(tx)
 
 	if tx.To != nil {
 		return InvalidRecipient
 	}
-	if err := ValidateFee(appState, tx, txType); err != nil {
+	if err := ValidateFee(appState, tx, txType, conf); err != nil {
 		return err
 	}
-	if err := validateTotalCost(sender, appState, tx, txType); err != nil {
+	if err := validateTotalCost(sender, appState, tx, txType, conf); err != nil {
 		return err
 	}
 	if appState.State.ValidationPeriod() >= state.FlipLotteryPeriod {
@@ -346,16 +416,16 @@ func validateSubmitFlipTx(appState *appstate.AppState, tx *types.Transaction, tx
 	return nil
 }
 
-func validateSubmitAnswersHashTx(appState *appstate.AppState, tx *types.Transaction, txType TxType) error {
+func validateSubmitAnswersHashTx(appState *appstate.AppState, tx *types.Transaction, txType TxType, conf *config.ConsensusConf) error {
 	sender, _ := types.Sender(tx)
 
 	if tx.To != nil {
 		return InvalidRecipient
 	}
-	if err := ValidateFee(appState, tx, txType); err != nil {
+	if err := ValidateFee(appState, tx, txType, conf); err != nil {
 		return err
 	}
-	if err := validateTotalCost(sender, appState, tx, txType); err != nil {
+	if err := validateTotalCost(sender, appState, tx, txType, conf); err != nil {
 		return err
 	}
 	if len(tx.Payload) != common.HashLength {
@@ -374,7 +444,7 @@ func validateSubmitAnswersHashTx(appState *appstate.AppState, tx *types.Transact
 	return nil
 }
 
-func validateSubmitShortAnswersTx(appState *appstate.AppState, tx *types.Transaction, txType TxType) error {
+func validateSubmitShortAnswersTx(appState *appstate.AppState, tx *types.Transaction, txType TxType, conf *config.ConsensusConf) error {
 	sender, _ := types.Sender(tx)
 
 	if tx.To != nil {
@@ -383,10 +453,10 @@ func validateSubmitShortAnswersTx(appState *appstate.AppState, tx *types.Transac
 	if txType == InboundTx && appState.State.ValidationPeriod() == state.AfterLongSessionPeriod {
 		return LateTx
 	}
-	if err := ValidateFee(appState, tx, txType); err != nil {
+	if err := ValidateFee(appState, tx, txType, conf); err != nil {
 		return err
 	}
-	if err := validateTotalCost(sender, appState, tx, txType); err != nil {
+	if err := validateTotalCost(sender, appState, tx, txType, conf); err != nil {
 		return err
 	}
 	if appState.State.ValidationPeriod() < state.LongSessionPeriod && txType == InBlockTx {
@@ -411,7 +481,7 @@ func validateSubmitShortAnswersTx(appState *appstate.AppState, tx *types.Transac
 	return nil
 }
 
-func validateSubmitLongAnswersTx(appState *appstate.AppState, tx *types.Transaction, txType TxType) error {
+func validateSubmitLongAnswersTx(appState *appstate.AppState, tx *types.Transaction, txType TxType, conf *config.ConsensusConf) error {
 	sender, _ := types.Sender(tx)
 
 	if tx.To != nil {
@@ -420,10 +490,10 @@ func validateSubmitLongAnswersTx(appState *appstate.AppState, tx *types.Transact
 	if txType == InboundTx && appState.State.ValidationPeriod() == state.AfterLongSessionPeriod {
 		return LateTx
 	}
-	if err := ValidateFee(appState, tx, txType); err != nil {
+	if err := ValidateFee(appState, tx, txType, conf); err != nil {
 		return err
 	}
-	if err := validateTotalCost(sender, appState, tx, txType); err != nil {
+	if err := validateTotalCost(sender, appState, tx, txType, conf); err != nil {
 		return err
 	}
 	if appState.State.ValidationPeriod() < state.ShortSessionPeriod && txType == InBlockTx {
@@ -466,16 +536,16 @@ func validateSubmitLongAnswersTx(appState *appstate.AppState, tx *types.Transact
 	return nil
 }
 
-func validateEvidenceTx(appState *appstate.AppState, tx *types.Transaction, txType TxType) error {
+func validateEvidenceTx(appState *appstate.AppState, tx *types.Transaction, txType TxType, conf *config.ConsensusConf) error {
 	sender, _ := types.Sender(tx)
 
 	if tx.To != nil {
 		return InvalidRecipient
 	}
-	if err := ValidateFee(appState, tx, txType); err != nil {
+	if err := ValidateFee(appState, tx, txType, conf); err != nil {
 		return err
 	}
-	if err := validateTotalCost(sender, appState, tx, txType); err != nil {
+	if err := validateTotalCost(sender, appState, tx, txType, conf); err != nil {
 		return err
 	}
 	if appState.State.ValidationPeriod() < state.LongSessionPeriod && txType == InBlockTx {
@@ -490,16 +560,16 @@ func validateEvidenceTx(appState *appstate.AppState, tx *types.Transaction, txTy
 	return nil
 }
 
-func validateOnlineStatusTx(appState *appstate.AppState, tx *types.Transaction, txType TxType) error {
+func validateOnlineStatusTx(appState *appstate.AppState, tx *types.Transaction, txType TxType, conf *config.ConsensusConf) error {
 	sender, _ := types.Sender(tx)
 
 	if tx.To != nil {
 		return InvalidRecipient
 	}
-	if err := ValidateFee(appState, tx, txType); err != nil {
+	if err := ValidateFee(appState, tx, txType, conf); err != nil {
 		return err
 	}
-	if err := validateTotalCost(sender, appState, tx, txType); err != nil {
+	if err := validateTotalCost(sender, appState, tx, txType, conf); err != nil {
 		return err
 	}
 	if appState.State.ValidationPeriod() >= state.FlipLotteryPeriod {
@@ -529,20 +599,20 @@ func validateOnlineStatusTx(appState *appstate.AppState, tx *types.Transaction,
 	return nil
 }
 
-func validateKillIdentityTx(appState *appstate.AppState, tx *types.Transaction, txType TxType) error {
+func validateKillIdentityTx(appState *appstate.AppState, tx *types.Transaction, txType TxType, conf *config.ConsensusConf) error {
 	sender, _ := types.Sender(tx)
 
 	if tx.To == nil || *tx.To == (common.Address{}) {
 		return RecipientRequired
 	}
-	if err := ValidateFee(appState, tx, txType); err != nil {
+	if err := ValidateFee(appState, tx, txType, conf); err != nil {
 		return err
 	}
 	var txFee *big.Int
 	if txType != InBlockTx {
-		txFee = tx.MaxFeeOrZero()
+		txFee = maxFee(appState, tx, conf)
 	} else {
-		txFee = fee.CalculateFee(appState.ValidatorsCache.NetworkSize(), appState.State.FeePerByte(), tx)
+		txFee = fee.CalculateFee(appState.ValidatorsCache.NetworkSize(), appState.State.FeePerByte(), tx, isForkActivated(appState, conf))
 	}
 	if txFee.Sign() > 0 && appState.State.GetStakeBalance(sender).Cmp(txFee) < 0 {
 		return InsufficientFunds
@@ -560,15 +630,15 @@ func validateKillIdentityTx(appState *appstate.AppState, tx *types.Transaction,
 	return nil
 }
 
-func validateKillInviteeTx(appState *appstate.AppState, tx *types.Transaction, txType TxType) error {
+func validateKillInviteeTx(appState *appstate.AppState, tx *types.Transaction, txType TxType, conf *config.ConsensusConf) error {
 	sender, _ := types.Sender(tx)
 	if tx.To == nil || *tx.To == (common.Address{}) {
 		return RecipientRequired
 	}
-	if err := ValidateFee(appState, tx, txType); err != nil {
+	if err := ValidateFee(appState, tx, txType, conf); err != nil {
 		return err
 	}
-	if err := validateTotalCost(sender, appState, tx, txType); err != nil {
+	if err := validateTotalCost(sender, appState, tx, txType, conf); err != nil {
 		return err
 	}
 	if appState.State.ValidationPeriod() >= state.FlipLotteryPeriod {
@@ -578,10 +648,13 @@ func validateKillInviteeTx(appState *appstate.AppState, tx *types.Transaction, t
 	if inviter == nil || inviter.Address != sender {
 		return InvalidRecipient
 	}
+	if isForkActivated(appState, conf) && appState.State.GetIdentityState(*tx.To).VerifiedOrBetter() {
+		return InvalidRecipient
+	}
 	return nil
 }
 
-func validateChangeGodAddressTx(appState *appstate.AppState, tx *types.Transaction, txType TxType) error {
+func validateChangeGodAddressTx(appState *appstate.AppState, tx *types.Transaction, txType TxType, conf *config.ConsensusConf) error {
 	sender, _ := types.Sender(tx)
 	if tx.To == nil || *tx.To == (common.Address{}) {
 		return RecipientRequired
@@ -589,10 +662,10 @@ func validateChangeGodAddressTx(appState *appstate.AppState, tx *types.Transacti
 	if sender != appState.State.GodAddress() {
 		return InvalidSender
 	}
-	if err := ValidateFee(appState, tx, txType); err != nil {
+	if err := ValidateFee(appState, tx, txType, conf); err != nil {
 		return err
 	}
-	if err := validateTotalCost(sender, appState, tx, txType); err != nil {
+	if err := validateTotalCost(sender, appState, tx, txType, conf); err != nil {
 		return err
 	}
 	if appState.State.ValidationPeriod() >= state.FlipLotteryPeriod {
@@ -602,15 +675,15 @@ func validateChangeGodAddressTx(appState *appstate.AppState, tx *types.Transacti
 	return nil
 }
 
-func validateBurnTx(appState *appstate.AppState, tx *types.Transaction, txType TxType) error {
+func validateBurnTx(appState *appstate.AppState, tx *types.Transaction, txType TxType, conf *config.ConsensusConf) error {
 	if tx.To != nil {
 		return InvalidRecipient
 	}
-	if err := ValidateFee(appState, tx, txType); err != nil {
+	if err := ValidateFee(appState, tx, txType, conf); err != nil {
 		return err
 	}
 	sender, _ := types.Sender(tx)
-	if err := validateTotalCost(sender, appState, tx, txType); err != nil {
+	if err := validateTotalCost(sender, appState, tx, txType, conf); err != nil {
 		return err
 	}
 	attachment := attachments.ParseBurnAttachment(tx)
@@ -620,34 +693,39 @@ func validateBurnTx(appState *appstate.AppState, tx *types.Transaction, txType T
 	return nil
 }
 
-func validateChangeProfileTx(appState *appstate.AppState, tx *types.Transaction, txType TxType) error {
+func validateChangeProfileTx(appState *appstate.AppState, tx *types.Transaction, txType TxType, conf *config.ConsensusConf) error {
 	if tx.To != nil {
 		return InvalidRecipient
 	}
-	if err := ValidateFee(appState, tx, txType); err != nil {
+	if err := ValidateFee(appState, tx, txType, conf); err != nil {
 		return err
 	}
 	sender, _ := types.Sender(tx)
-	if err := validateTotalCost(sender, appState, tx, txType); err != nil {
+	if err := validateTotalCost(sender, appState, tx, txType, conf); err != nil {
 		return err
 	}
 	attachment := attachments.ParseChangeProfileAttachment(tx)
 	if attachment == nil {
 		return InvalidPayload
 	}
+	// blocks before the fork could contain profile hashes of any size
+	if (txType != InBlockTx || isForkActivated(appState, conf)) &&
+		(len(attachment.Hash) == 0 || len(attachment.Hash) > MaxProfileHashSize) {
+		return InvalidPayload
+	}
 	return nil
 }
 
-func validateDeleteFlipTx(appState *appstate.AppState, tx *types.Transaction, txType TxType) error {
+func validateDeleteFlipTx(appState *appstate.AppState, tx *types.Transaction, txType TxType, conf *config.ConsensusConf) error {
 	sender, _ := types.Sender(tx)
 
 	if tx.To != nil {
 		return InvalidRecipient
 	}
-	if err := ValidateFee(appState, tx, txType); err != nil {
+	if err := ValidateFee(appState, tx, txType, conf); err != nil {
 		return err
 	}
-	if err := validateTotalCost(sender, appState, tx, txType); err != nil {
+	if err := validateTotalCost(sender, appState, tx, txType, conf); err != nil {
 		return err
 	}
 	if appState.State.ValidationPeriod() >= state.FlipLotteryPeriod {
@@ -674,3 +752,112 @@ func validateDeleteFlipTx(appState *appstate.AppState, tx *types.Transaction, tx
 
 	return nil
 }
+
+func validateDelegateTx(appState *appstate.AppState, tx *types.Transaction, txType TxType, conf *config.ConsensusConf) error {
+	sender, _ := types.Sender(tx)
+
+	if tx.To == nil || *tx.To == (common.Address{}) {
+		return RecipientRequired
+	}
+	if *tx.To == sender {
+		return InvalidRecipient
+	}
+	if err := ValidateFee(appState, tx, txType, conf); err != nil {
+		return err
+	}
+	if err := validateTotalCost(sender, appState, tx, txType, conf); err != nil {
+		return err
+	}
+	if !appState.State.GetIdentityState(sender).VerifiedOrBetter() {
+		return InvalidSender
+	}
+	if !appState.State.GetIdentityState(*tx.To).VerifiedOrBetter() {
+		return InvalidRecipient
+	}
+	if appState.State.GetDelegatee(*tx.To) != nil || appState.State.IsDelegatee(sender) {
+		return ChainedDelegation
+	}
+	return nil
+}
+
+func validateUnstakeTx(appState *appstate.AppState, tx *types.Transaction, txType TxType, conf *config.ConsensusConf) error {
+	sender, _ := types.Sender(tx)
+
+	if tx.To != nil {
+		return InvalidRecipient
+	}
+	if tx.TipsOrZero().Sign() > 0 {
+		return errors.New("tips are not allowed")
+	}
+	if tx.AmountOrZero().Sign() == 0 || appState.State.GetStakeBalance(sender).Cmp(tx.AmountOrZero()) < 0 {
+		return InsufficientFunds
+	}
+	if appState.State.HasPendingWithdrawal(sender) {
+		return PendingWithdrawal
+	}
+	return nil
+}
+
+func validateParamChangeTx(appState *appstate.AppState, tx *types.Transaction, txType TxType, conf *config.ConsensusConf) error {
+	sender, _ := types.Sender(tx)
+
+	if tx.To != nil {
+		return InvalidRecipient
+	}
+	if err := ValidateFee(appState, tx, txType, conf); err != nil {
+		return err
+	}
+	if err := validateTotalCost(sender, appState, tx, txType, conf); err != nil {
+		return err
+	}
+	if appState.State.GetIdentityState(sender) != state.Verified || !appState.ValidatorsCache.IsOnlineIdentity(sender) {
+		return NotCommitteeMember
+	}
+	attachment := attachments.ParseParamChangeAttachment(tx)
+	if attachment == nil || config.ValidateParam(attachment.Key, attachment.Value) != nil {
+		return InvalidPayload
+	}
+	return nil
+}
+
+func validateClaimRewardsTx(appState *appstate.AppState, tx *types.Transaction, txType TxType, conf *config.ConsensusConf) error {
+	sender, _ := types.Sender(tx)
+
+	if tx.To != nil {
+		return InvalidRecipient
+	}
+	if tx.AmountOrZero().Sign() > 0 || tx.TipsOrZero().Sign() > 0 {
+		return errors.New("amount and tips are not allowed")
+	}
+	if appState.State.GetPendingReward(sender).Sign() == 0 {
+		return NoPendingRewards
+	}
+	return nil
+}
+
+func validateSubmitFlipKeyTx(appState *appstate.AppState, tx *types.Transaction, txType TxType, conf *config.ConsensusConf) error {
+	sender, _ := types.Sender(tx)
+
+	if tx.To != nil {
+		return InvalidRecipient
+	}
+	if err := ValidateFee(appState, tx, txType, conf); err != nil {
+		return err
+	}
+	if err := validateTotalCost(sender, appState, tx, txType, conf); err != nil {
+		return err
+	}
+	if len(tx.Payload) == 0 || len(tx.Payload) > MaxFlipKeyHashSize {
+		return InvalidPayload
+	}
+	if appState.State.ValidationPeriod() >= state.FlipLotteryPeriod {
+		return LateTx
+	}
+	if appState.State.GetIdentityState(sender) != state.Verified {
+		return NotVerified
+	}
+	if len(appState.State.GetFlipKeyHash(sender)) > 0 {
+		return DuplicatedFlipKey
+	}
+	return nil
+}
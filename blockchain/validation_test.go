@@ -1,17 +1,22 @@
 package blockchain
 
 import (
+	"crypto/ecdsa"
 	"github.com/idena-network/idena-go/blockchain/attachments"
 	"github.com/idena-network/idena-go/blockchain/types"
 	"github.com/idena-network/idena-go/blockchain/validation"
+	"github.com/idena-network/idena-go/common"
+	"github.com/idena-network/idena-go/config"
+	"github.com/idena-network/idena-go/core/state"
 	"github.com/idena-network/idena-go/crypto"
+	"github.com/idena-network/idena-go/tests"
 	"github.com/stretchr/testify/require"
 	"math/big"
 	"testing"
 )
 
 func Test_ValidateDeleteFlipTx(t *testing.T) {
-	_, appState, _, key := NewTestBlockchain(true, nil)
+	chain, appState, _, key := NewTestBlockchain(true, nil)
 	minFeePerByte := big.NewInt(1)
 
 	buildTx := func(cid []byte) *types.Transaction {
@@ -26,15 +31,351 @@ func Test_ValidateDeleteFlipTx(t *testing.T) {
 	}
 
 	tx := buildTx(nil)
-	err := validation.ValidateTx(appState, tx, minFeePerByte, validation.InBlockTx)
+	err := validation.ValidateTx(appState, tx, minFeePerByte, validation.InBlockTx, chain.config.Consensus)
 	require.Equal(t, validation.InvalidPayload, err)
 
 	tx = buildTx([]byte{0x1, 0x2, 0x3})
-	err = validation.ValidateTx(appState, tx, minFeePerByte, validation.InBlockTx)
+	err = validation.ValidateTx(appState, tx, minFeePerByte, validation.InBlockTx, chain.config.Consensus)
 	require.Equal(t, validation.FlipIsMissing, err)
 
 	addr := crypto.PubkeyToAddress(key.PublicKey)
 	appState.State.AddFlip(addr, []byte{0x1, 0x2, 0x3}, 0)
-	err = validation.ValidateTx(appState, tx, minFeePerByte, validation.InBlockTx)
+	err = validation.ValidateTx(appState, tx, minFeePerByte, validation.InBlockTx, chain.config.Consensus)
 	require.Equal(t, nil, err)
 }
+
+func Test_ValidateKillInviteeTx(t *testing.T) {
+	chain, appState, _, key := NewTestBlockchain(true, nil)
+	minFeePerByte := big.NewInt(1)
+	sender := crypto.PubkeyToAddress(key.PublicKey)
+	invitee := tests.GetRandAddr()
+
+	appState.State.SetInviter(invitee, sender, common.Hash{})
+	appState.State.SetState(invitee, state.Invite)
+
+	tx := &types.Transaction{
+		AccountNonce: 1,
+		Type:         types.KillInviteeTx,
+		To:           &invitee,
+	}
+	signedTx, _ := types.SignTx(tx, key)
+	require.Nil(t, validation.ValidateTx(appState, signedTx, minFeePerByte, validation.InBlockTx, chain.config.Consensus))
+
+	appState.State.SetState(invitee, state.Verified)
+	require.Equal(t, validation.InvalidRecipient, validation.ValidateTx(appState, signedTx, minFeePerByte, validation.InBlockTx, chain.config.Consensus))
+
+	// the tx is not fee-free before the fork
+	chain.config.Consensus.ForkHeight = config.ForkNotScheduled
+	tx.MaxFee = common.DnaBase
+	signedTx, _ = types.SignTx(tx, key)
+	require.Nil(t, validation.ValidateTx(appState, signedTx, minFeePerByte, validation.InBlockTx, chain.config.Consensus))
+}
+
+func Test_ValidateExpiredTx(t *testing.T) {
+	chain, appState, _, key := NewTestBlockchain(true, nil)
+	minFeePerByte := big.NewInt(1)
+	to := tests.GetRandAddr()
+	appState.State.SetBalance(crypto.PubkeyToAddress(key.PublicKey), new(big.Int).Mul(common.DnaBase, big.NewInt(10)))
+
+	buildTx := func(maxBlock uint64) *types.Transaction {
+		tx := &types.Transaction{
+			AccountNonce: 1,
+			Type:         types.SendTx,
+			To:           &to,
+			Amount:       big.NewInt(1),
+			MaxFee:       big.NewInt(1_000_000),
+			MaxBlock:     maxBlock,
+		}
+		signedTx, _ := types.SignTx(tx, key)
+		return signedTx
+	}
+
+	nextHeight := chain.GetCurrentHead().Height() + 1
+
+	require.Nil(t, validation.ValidateTx(appState, buildTx(0), minFeePerByte, validation.InBlockTx, chain.config.Consensus))
+	require.Nil(t, validation.ValidateTx(appState, buildTx(nextHeight), minFeePerByte, validation.InBlockTx, chain.config.Consensus))
+	require.Equal(t, validation.ExpiredTx, validation.ValidateTx(appState, buildTx(nextHeight-1), minFeePerByte, validation.InBlockTx, chain.config.Consensus))
+}
+
+func Test_ValidateDelegateTx(t *testing.T) {
+	chain, appState, _, key := NewTestBlockchain(true, nil)
+	minFeePerByte := big.NewInt(1)
+	sender := crypto.PubkeyToAddress(key.PublicKey)
+	appState.State.SetBalance(sender, new(big.Int).Mul(common.DnaBase, big.NewInt(10)))
+	delegatee := tests.GetRandAddr()
+
+	buildTx := func(to *common.Address) *types.Transaction {
+		tx := &types.Transaction{
+			AccountNonce: 1,
+			Type:         types.DelegateTx,
+			To:           to,
+			MaxFee:       big.NewInt(1_000_000),
+		}
+		signedTx, _ := types.SignTx(tx, key)
+		return signedTx
+	}
+
+	require.Equal(t, validation.RecipientRequired, validation.ValidateTx(appState, buildTx(nil), minFeePerByte, validation.InBlockTx, chain.config.Consensus))
+	require.Equal(t, validation.InvalidRecipient, validation.ValidateTx(appState, buildTx(&sender), minFeePerByte, validation.InBlockTx, chain.config.Consensus))
+	require.Equal(t, validation.InvalidRecipient, validation.ValidateTx(appState, buildTx(&delegatee), minFeePerByte, validation.InBlockTx, chain.config.Consensus))
+
+	appState.State.SetState(delegatee, state.Verified)
+	require.Nil(t, validation.ValidateTx(appState, buildTx(&delegatee), minFeePerByte, validation.InBlockTx, chain.config.Consensus))
+
+	appState.State.SetDelegatee(delegatee, tests.GetRandAddr())
+	require.Equal(t, validation.ChainedDelegation, validation.ValidateTx(appState, buildTx(&delegatee), minFeePerByte, validation.InBlockTx, chain.config.Consensus))
+
+	appState.State.ResetDelegatee(delegatee)
+	require.Nil(t, validation.ValidateTx(appState, buildTx(&delegatee), minFeePerByte, validation.InBlockTx, chain.config.Consensus))
+
+	// delegation to the sender applied earlier in the same block isn't in the validators cache yet
+	delegator := tests.GetRandAddr()
+	appState.State.SetDelegatee(delegator, sender)
+	require.Equal(t, validation.ChainedDelegation, validation.ValidateTx(appState, buildTx(&delegatee), minFeePerByte, validation.InBlockTx, chain.config.Consensus))
+
+	appState.State.ResetDelegatee(delegator)
+	appState.State.SetState(sender, state.Newbie)
+	require.Equal(t, validation.InvalidSender, validation.ValidateTx(appState, buildTx(&delegatee), minFeePerByte, validation.InBlockTx, chain.config.Consensus))
+}
+
+func Test_ValidateUnstakeTx(t *testing.T) {
+	chain, appState, _, key := NewTestBlockchain(true, nil)
+	minFeePerByte := big.NewInt(1)
+	sender := crypto.PubkeyToAddress(key.PublicKey)
+	appState.State.AddStake(sender, big.NewInt(100))
+
+	buildTx := func(amount int64) *types.Transaction {
+		tx := &types.Transaction{
+			AccountNonce: 1,
+			Type:         types.UnstakeTx,
+			Amount:       big.NewInt(amount),
+		}
+		signedTx, _ := types.SignTx(tx, key)
+		return signedTx
+	}
+
+	require.Equal(t, validation.InsufficientFunds, validation.ValidateTx(appState, buildTx(0), minFeePerByte, validation.InBlockTx, chain.config.Consensus))
+	require.Equal(t, validation.InsufficientFunds, validation.ValidateTx(appState, buildTx(101), minFeePerByte, validation.InBlockTx, chain.config.Consensus))
+	require.Nil(t, validation.ValidateTx(appState, buildTx(100), minFeePerByte, validation.InBlockTx, chain.config.Consensus))
+
+	appState.State.AddPendingWithdrawal(sender, big.NewInt(1), 100)
+	require.Equal(t, validation.PendingWithdrawal, validation.ValidateTx(appState, buildTx(50), minFeePerByte, validation.InBlockTx, chain.config.Consensus))
+}
+
+func Test_ValidateChangeProfileTx(t *testing.T) {
+	chain, appState, _, key := NewTestBlockchain(true, nil)
+	minFeePerByte := big.NewInt(1)
+	appState.State.SetBalance(crypto.PubkeyToAddress(key.PublicKey), big.NewInt(1e+18))
+
+	buildTx := func(hash []byte) *types.Transaction {
+		tx := types.Transaction{
+			AccountNonce: 1,
+			Type:         types.ChangeProfileTx,
+			Payload:      attachments.CreateChangeProfileAttachment(hash),
+			MaxFee:       big.NewInt(1e+18),
+		}
+		signedTx, _ := types.SignTx(&tx, key)
+		return signedTx
+	}
+
+	require.Equal(t, validation.InvalidPayload, validation.ValidateTx(appState, buildTx(nil), minFeePerByte, validation.InBlockTx, chain.config.Consensus))
+	require.Equal(t, validation.InvalidPayload, validation.ValidateTx(appState, buildTx(make([]byte, validation.MaxProfileHashSize+1)), minFeePerByte, validation.InBlockTx, chain.config.Consensus))
+	require.Nil(t, validation.ValidateTx(appState, buildTx(make([]byte, validation.MaxProfileHashSize)), minFeePerByte, validation.InBlockTx, chain.config.Consensus))
+
+	// the hash size is not limited in blocks before the fork
+	chain.config.Consensus.ForkHeight = config.ForkNotScheduled
+	require.Nil(t, validation.ValidateTx(appState, buildTx(make([]byte, validation.MaxProfileHashSize+1)), minFeePerByte, validation.InBlockTx, chain.config.Consensus))
+	require.Equal(t, validation.InvalidPayload, validation.ValidateTx(appState, buildTx(make([]byte, validation.MaxProfileHashSize+1)), minFeePerByte, validation.MempoolTx, chain.config.Consensus))
+}
+
+func Test_ValidateTxDataSize(t *testing.T) {
+	chain, appState, _, key := NewTestBlockchain(true, nil)
+	minFeePerByte := big.NewInt(1)
+	appState.State.SetBalance(crypto.PubkeyToAddress(key.PublicKey), big.NewInt(1e+18))
+
+	buildTx := func(txType types.TxType, size int) *types.Transaction {
+		to := tests.GetRandAddr()
+		tx := types.Transaction{
+			AccountNonce: 1,
+			Type:         txType,
+			To:           &to,
+			Payload:      make([]byte, size),
+			MaxFee:       big.NewInt(1e+18),
+		}
+		signedTx, _ := types.SignTx(&tx, key)
+		return signedTx
+	}
+
+	require.Nil(t, validation.ValidateTx(appState, buildTx(types.SendTx, validation.MaxTxDataSize), minFeePerByte, validation.InBlockTx, chain.config.Consensus))
+	require.Equal(t, validation.ErrTxDataTooLarge, validation.ValidateTx(appState, buildTx(types.SendTx, validation.MaxTxDataSize+1), minFeePerByte, validation.MempoolTx, chain.config.Consensus))
+	require.Nil(t, validation.ValidateTx(appState, buildTx(types.SendTx, validation.MaxTxDataSize+1), minFeePerByte, validation.InBlockTx, chain.config.Consensus))
+	require.NotEqual(t, validation.ErrTxDataTooLarge, validation.ValidateTx(appState, buildTx(types.EvidenceTx, validation.MaxTxDataSize+1), minFeePerByte, validation.MempoolTx, chain.config.Consensus))
+	require.Equal(t, validation.InvalidPayload, validation.ValidateTx(appState, buildTx(types.EvidenceTx, validation.MaxPayloadSize+1), minFeePerByte, validation.InBlockTx, chain.config.Consensus))
+}
+
+func Test_ValidateParamChangeTx(t *testing.T) {
+	chain, appState, _, key := NewTestBlockchain(true, nil)
+	minFeePerByte := big.NewInt(1)
+	sender := crypto.PubkeyToAddress(key.PublicKey)
+	appState.State.SetBalance(sender, new(big.Int).Mul(common.DnaBase, big.NewInt(10)))
+
+	buildTx := func(param string, value []byte) *types.Transaction {
+		tx := &types.Transaction{
+			AccountNonce: 1,
+			Type:         types.ParamChangeTx,
+			MaxFee:       big.NewInt(1_000_000),
+			Payload:      attachments.CreateParamChangeAttachment(param, value),
+		}
+		signedTx, _ := types.SignTx(tx, key)
+		return signedTx
+	}
+	validTx := buildTx(config.FeeBurnRateParam, config.EncodeParamValue(0.5))
+
+	require.Equal(t, validation.NotCommitteeMember, validation.ValidateTx(appState, validTx, minFeePerByte, validation.InBlockTx, chain.config.Consensus))
+
+	appState.IdentityState.SetOnline(sender, true)
+	require.NoError(t, appState.Commit(nil))
+	appState.ValidatorsCache.Load()
+	require.Nil(t, validation.ValidateTx(appState, validTx, minFeePerByte, validation.InBlockTx, chain.config.Consensus))
+	require.Equal(t, validation.InvalidPayload, validation.ValidateTx(appState, buildTx("BlockReward", config.EncodeParamValue(0.5)), minFeePerByte, validation.InBlockTx, chain.config.Consensus))
+	require.Equal(t, validation.InvalidPayload, validation.ValidateTx(appState, buildTx(config.FeeBurnRateParam, config.EncodeParamValue(1.5)), minFeePerByte, validation.InBlockTx, chain.config.Consensus))
+	require.Equal(t, validation.InvalidPayload, validation.ValidateTx(appState, buildTx(config.CommitteePercentParam, config.EncodeParamValue(0)), minFeePerByte, validation.InBlockTx, chain.config.Consensus))
+	require.Equal(t, validation.InvalidPayload, validation.ValidateTx(appState, buildTx(config.ProposerThresholdParam, config.EncodeParamValue(1)), minFeePerByte, validation.InBlockTx, chain.config.Consensus))
+	require.Equal(t, validation.InvalidPayload, validation.ValidateTx(appState, buildTx(config.CommitteePercentParam, []byte{0x1}), minFeePerByte, validation.InBlockTx, chain.config.Consensus))
+
+	appState.State.SetState(sender, state.Newbie)
+	require.Equal(t, validation.NotCommitteeMember, validation.ValidateTx(appState, validTx, minFeePerByte, validation.InBlockTx, chain.config.Consensus))
+}
+
+func Test_ValidateActivationTxRefundTo(t *testing.T) {
+	chain, appState, _, _ := NewTestBlockchain(true, nil)
+	minFeePerByte := big.NewInt(1)
+
+	key, _ := crypto.GenerateKey()
+	receiverKey, _ := crypto.GenerateKey()
+	sender := crypto.PubkeyToAddress(key.PublicKey)
+	receiver := crypto.PubkeyToAddress(receiverKey.PublicKey)
+	refundTo := tests.GetRandAddr()
+
+	appState.State.SetBalance(sender, new(big.Int).Mul(common.DnaBase, big.NewInt(10)))
+	appState.State.SetState(sender, state.Invite)
+
+	buildTx := func(txType types.TxType, refundTo *common.Address) *types.Transaction {
+		tx := &types.Transaction{
+			AccountNonce: 1,
+			Type:         txType,
+			To:           &receiver,
+			Amount:       big.NewInt(0),
+			MaxFee:       big.NewInt(1_000_000),
+			RefundTo:     refundTo,
+		}
+		if txType == types.ActivationTx {
+			tx.Payload = crypto.FromECDSAPub(&receiverKey.PublicKey)
+		}
+		signedTx, _ := types.SignTx(tx, key)
+		return signedTx
+	}
+
+	require.Nil(t, validation.ValidateTx(appState, buildTx(types.ActivationTx, nil), minFeePerByte, validation.InBlockTx, chain.config.Consensus))
+	require.Equal(t, validation.InvalidRefundTo, validation.ValidateTx(appState, buildTx(types.ActivationTx, &sender), minFeePerByte, validation.InBlockTx, chain.config.Consensus))
+	require.Equal(t, validation.InvalidRefundTo, validation.ValidateTx(appState, buildTx(types.ActivationTx, &refundTo), minFeePerByte, validation.InBlockTx, chain.config.Consensus))
+
+	appState.State.SetState(refundTo, state.Verified)
+	require.Nil(t, validation.ValidateTx(appState, buildTx(types.ActivationTx, &refundTo), minFeePerByte, validation.InBlockTx, chain.config.Consensus))
+	require.Equal(t, validation.InvalidRefundTo, validation.ValidateTx(appState, buildTx(types.SendTx, &refundTo), minFeePerByte, validation.InBlockTx, chain.config.Consensus))
+}
+
+func Test_ValidateActivationTxRecipientState(t *testing.T) {
+	chain, appState, _, _ := NewTestBlockchain(true, nil)
+	minFeePerByte := big.NewInt(1)
+
+	key, _ := crypto.GenerateKey()
+	receiverKey, _ := crypto.GenerateKey()
+	sender := crypto.PubkeyToAddress(key.PublicKey)
+	receiver := crypto.PubkeyToAddress(receiverKey.PublicKey)
+
+	appState.State.SetBalance(sender, new(big.Int).Mul(common.DnaBase, big.NewInt(10)))
+	appState.State.SetState(sender, state.Invite)
+
+	tx := &types.Transaction{
+		AccountNonce: 1,
+		Type:         types.ActivationTx,
+		To:           &receiver,
+		Amount:       big.NewInt(0),
+		MaxFee:       big.NewInt(1_000_000),
+		Payload:      crypto.FromECDSAPub(&receiverKey.PublicKey),
+	}
+	signedTx, _ := types.SignTx(tx, key)
+
+	require.Nil(t, validation.ValidateTx(appState, signedTx, minFeePerByte, validation.InBlockTx, chain.config.Consensus))
+	appState.State.SetState(receiver, state.Invite)
+	require.Nil(t, validation.ValidateTx(appState, signedTx, minFeePerByte, validation.InBlockTx, chain.config.Consensus))
+
+	for _, recipientState := range []state.IdentityState{state.Verified, state.Killed, state.Candidate} {
+		appState.State.SetState(receiver, recipientState)
+		require.Equal(t, validation.InvalidRecipient, validation.ValidateTx(appState, signedTx, minFeePerByte, validation.InBlockTx, chain.config.Consensus))
+	}
+}
+
+func Test_ValidateInviteTxMinInviterBalance(t *testing.T) {
+	chain, appState, _, key := NewTestBlockchain(true, nil)
+	minFeePerByte := big.NewInt(1)
+	minBalance := config.GetDefaultConsensusConfig().MinInviterBalance
+
+	inviterKey, _ := crypto.GenerateKey()
+	inviter := crypto.PubkeyToAddress(inviterKey.PublicKey)
+	appState.State.SetState(inviter, state.Verified)
+	appState.State.SetInvites(inviter, 1)
+
+	buildTx := func(txType types.TxType, key *ecdsa.PrivateKey) *types.Transaction {
+		to := tests.GetRandAddr()
+		tx := &types.Transaction{
+			AccountNonce: 1,
+			Type:         txType,
+			To:           &to,
+			MaxFee:       big.NewInt(1_000_000),
+		}
+		signedTx, _ := types.SignTx(tx, key)
+		return signedTx
+	}
+
+	appState.State.SetBalance(inviter, minBalance)
+	require.Nil(t, validation.ValidateTx(appState, buildTx(types.InviteTx, inviterKey), minFeePerByte, validation.InBlockTx, chain.config.Consensus))
+
+	appState.State.SetBalance(inviter, new(big.Int).Sub(minBalance, big.NewInt(1)))
+	require.Equal(t, validation.LowInviterBalance, validation.ValidateTx(appState, buildTx(types.InviteTx, inviterKey), minFeePerByte, validation.InBlockTx, chain.config.Consensus))
+
+	chain.config.Consensus.ForkHeight = config.ForkNotScheduled
+	require.Nil(t, validation.ValidateTx(appState, buildTx(types.InviteTx, inviterKey), minFeePerByte, validation.InBlockTx, chain.config.Consensus))
+	require.Equal(t, validation.LowInviterBalance, validation.ValidateTx(appState, buildTx(types.InviteTx, inviterKey), minFeePerByte, validation.MempoolTx, chain.config.Consensus))
+	chain.config.Consensus.ForkHeight = 0
+
+	appState.State.SetBalance(crypto.PubkeyToAddress(key.PublicKey), new(big.Int).Sub(minBalance, big.NewInt(1)))
+	require.Nil(t, validation.ValidateTx(appState, buildTx(types.KillTx, key), minFeePerByte, validation.InBlockTx, chain.config.Consensus))
+}
+
+func Test_ValidateInviteTxWithoutInvites(t *testing.T) {
+	chain, appState, pool, _ := NewTestBlockchain(true, nil)
+
+	inviterKey, _ := crypto.GenerateKey()
+	inviter := crypto.PubkeyToAddress(inviterKey.PublicKey)
+	appState.State.SetState(inviter, state.Verified)
+	appState.State.SetBalance(inviter, new(big.Int).Mul(common.DnaBase, big.NewInt(10)))
+	require.Zero(t, appState.State.GetInvites(inviter))
+
+	to := tests.GetRandAddr()
+	tx := &types.Transaction{
+		AccountNonce: 1,
+		Type:         types.InviteTx,
+		To:           &to,
+		Amount:       big.NewInt(1),
+		MaxFee:       big.NewInt(1_000_000),
+	}
+	signedTx, _ := types.SignTx(tx, inviterKey)
+
+	require.Equal(t, validation.InsufficientInvites, pool.Add(signedTx))
+	require.Zero(t, appState.State.GetInvites(inviter))
+
+	appState.State.SetInvites(inviter, 1)
+	require.Nil(t, validation.ValidateTx(appState, signedTx, big.NewInt(1), validation.MempoolTx, chain.config.Consensus))
+}
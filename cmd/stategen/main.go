@@ -78,6 +78,8 @@ func main() {
 			EmptyBlocksBits:               common.BigIntBytesOrNil(globalObject.EmptyBlocksBits()),
 			GodAddressInvites:             uint32(globalObject.GodAddressInvites()),
 			BlocksCntWithoutCeremonialTxs: uint32(globalObject.BlocksCntWithoutCeremonialTxs()),
+			ConsecutiveEmptyBlocks:        globalObject.ConsecutiveEmptyBlocks(),
+			RecentEmptyBlocksBits:         common.BigIntBytesOrNil(globalObject.RecentEmptyBlocksBits()),
 		}
 
 		snapshot.StatusSwitch = &models.ProtoPredefinedState_StatusSwitch{
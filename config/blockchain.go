@@ -4,4 +4,8 @@ type BlockchainConfig struct {
 	// distance between blocks with permanent certificates
 	StoreCertRange uint64
 	BurnTxRange    uint64
+	// fee records older than this range are pruned, zero disables pruning
+	FeeHistoryRange uint64
+	// max count of stored proposer history records, zero means default size
+	ProposerHistorySize uint64
 }
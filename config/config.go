@@ -251,8 +251,10 @@ func getDefaultConfig(dataDir string) *Config {
 		},
 		OfflineDetection: GetDefaultOfflineDetectionConfig(),
 		Blockchain: &BlockchainConfig{
-			StoreCertRange: DefaultStoreCertRange,
-			BurnTxRange:    DefaultBurntTxRange,
+			StoreCertRange:      DefaultStoreCertRange,
+			BurnTxRange:         DefaultBurntTxRange,
+			FeeHistoryRange:     DefaultFeeHistoryRange,
+			ProposerHistorySize: DefaultProposerHistorySize,
 		},
 		Mempool: GetDefaultMempoolConfig(),
 	}
@@ -1,10 +1,31 @@
 package config
 
 import (
+	"encoding/binary"
+	"github.com/idena-network/idena-go/blockchain/types"
+	"github.com/pkg/errors"
+	"math"
 	"math/big"
+	"sort"
+	"strings"
 	"time"
 )
 
+// consensus params which can be changed by ParamChangeTx votes of the network quorum, values are big-endian encoded float64
+const (
+	ProposerThresholdParam = "MinProposerThreshold"
+	CommitteePercentParam  = "CommitteePercent"
+	FeeBurnRateParam       = "FeeBurnRate"
+)
+
+// ForkNotScheduled keeps protocol changes guarded by ConsensusConf.ForkHeight disabled
+const ForkNotScheduled = math.MaxUint64
+
+var (
+	ErrUnknownParam      = errors.New("unknown consensus param")
+	ErrInvalidParamValue = errors.New("invalid consensus param value")
+)
+
 type ConsensusConf struct {
 	MaxSteps                          uint8
 	AgreementThreshold                float64
@@ -38,6 +59,30 @@ type ConsensusConf struct {
 	StatusSwitchRange                 uint64
 	InvitesPercent                    float32
 	MinProposerThreshold              float64
+	MaxBlockTransactions              int
+	MaxBlockSize                      int
+	UnstakeLockupBlocks               uint64
+	MaxBlockTimeDrift                 time.Duration
+	InviteBaseCoef                    float64
+	InviteDecayRate                   float64
+	StakeInterestRate                 float64
+	// epoch is finished forcibly after this count of consecutive empty blocks, zero disables the limit
+	MaxEmptyBlocksPerEpoch int
+	// hard minimum between block timestamps, unlike MinBlockDistance it is not relaxed for clock skew
+	MinBlockInterval time.Duration
+	// identities staying in Invite state longer than this count of blocks are killed at the new epoch, zero disables expiry
+	InviteExpiryBlocks uint64
+	// block reward is multiplied by HalvingCoef every HalvingInterval blocks, zero interval disables halving
+	HalvingInterval uint64
+	HalvingCoef     float64
+	// sender of InviteTx should keep at least this balance in addition to affording the tx cost, nil disables the check
+	MinInviterBalance *big.Int
+	// fixed seed replacing block seeds in committee selection to make committees deterministic in tests,
+	// it must never be set outside of tests and is rejected by Validate in production builds
+	TestOnlyCommitteeSelectionSeed *types.Seed
+	// height of the first block validated with protocol changes made after the initial release, earlier blocks keep
+	// the original rules so historic blocks stay valid. Zero applies the changes from genesis
+	ForkHeight uint64
 }
 
 func GetDefaultConsensusConfig() *ConsensusConf {
@@ -73,5 +118,154 @@ func GetDefaultConsensusConfig() *ConsensusConf {
 		StatusSwitchRange:                 50,
 		InvitesPercent:                    0.5,
 		MinProposerThreshold:              0.5,
+		MaxBlockTransactions:              3000,
+		MaxBlockSize:                      2 * 1024 * 1024,
+		UnstakeLockupBlocks:               30240, // ~1 week
+		MaxBlockTimeDrift:                 time.Second * 15,
+		MinBlockInterval:                  time.Second,
+		HalvingCoef:                       0.5,
+		MinInviterBalance:                 big.NewInt(1e+18),
+		ForkHeight:                        ForkNotScheduled,
+	}
+}
+
+// ConfigErrors collects all violations found by config validation
+type ConfigErrors []error
+
+func (e ConfigErrors) Error() string {
+	msgs := make([]string, 0, len(e))
+	for _, err := range e {
+		msgs = append(msgs, err.Error())
+	}
+	return "invalid consensus config: " + strings.Join(msgs, "; ")
+}
+
+// Validate checks that consensus params are within sensible ranges, all violations are returned as ConfigErrors.
+// Conditions are written so that NaN values are rejected too
+func (c *ConsensusConf) Validate() error {
+	var errs ConfigErrors
+	check := func(ok bool, format string, args ...interface{}) {
+		if !ok {
+			errs = append(errs, errors.Errorf(format, args...))
+		}
+	}
+	check(c.MinProposerThreshold > 0 && c.MinProposerThreshold < 1, "MinProposerThreshold should be in (0, 1), got %v", c.MinProposerThreshold)
+	check(c.CommitteePercent > 0 && c.CommitteePercent <= 1, "CommitteePercent should be in (0, 1], got %v", c.CommitteePercent)
+	check(c.FinalCommitteePercent > 0 && c.FinalCommitteePercent <= 1, "FinalCommitteePercent should be in (0, 1], got %v", c.FinalCommitteePercent)
+	check(c.AgreementThreshold > 0 && c.AgreementThreshold <= 1, "AgreementThreshold should be in (0, 1], got %v", c.AgreementThreshold)
+	check(c.FeeBurnRate >= 0 && c.FeeBurnRate <= 1, "FeeBurnRate should be in [0, 1], got %v", c.FeeBurnRate)
+	check(c.StakeRewardRate >= 0 && c.StakeRewardRate <= 1, "StakeRewardRate should be in [0, 1], got %v", c.StakeRewardRate)
+	check(c.StakeRewardRateForNewbie >= 0 && c.StakeRewardRateForNewbie <= 1, "StakeRewardRateForNewbie should be in [0, 1], got %v", c.StakeRewardRateForNewbie)
+	for name, percent := range map[string]float32{
+		"SuccessfulValidationRewardPercent": c.SuccessfulValidationRewardPercent,
+		"FlipRewardPercent":                 c.FlipRewardPercent,
+		"ValidInvitationRewardPercent":      c.ValidInvitationRewardPercent,
+		"FoundationPayoutsPercent":          c.FoundationPayoutsPercent,
+		"ZeroWalletPercent":                 c.ZeroWalletPercent,
+		"InvitesPercent":                    c.InvitesPercent,
+	} {
+		check(percent >= 0 && percent <= 1, "%v should be in [0, 1], got %v", name, percent)
+	}
+	check(c.InviteBaseCoef >= 0, "InviteBaseCoef should not be negative, got %v", c.InviteBaseCoef)
+	check(c.InviteDecayRate >= 0, "InviteDecayRate should not be negative, got %v", c.InviteDecayRate)
+	check(c.StakeInterestRate >= 0, "StakeInterestRate should not be negative, got %v", c.StakeInterestRate)
+	check(c.BlockReward != nil && c.BlockReward.Sign() > 0, "BlockReward should be positive, got %v", c.BlockReward)
+	check(c.FinalCommitteeReward != nil && c.FinalCommitteeReward.Sign() > 0, "FinalCommitteeReward should be positive, got %v", c.FinalCommitteeReward)
+	check(c.MaxCommitteeSize > 0, "MaxCommitteeSize should be positive, got %v", c.MaxCommitteeSize)
+	check(c.MaxBlockTransactions > 0, "MaxBlockTransactions should be positive, got %v", c.MaxBlockTransactions)
+	check(c.MaxBlockSize >= 0, "MaxBlockSize should not be negative, got %v", c.MaxBlockSize)
+	check(c.HalvingInterval == 0 || c.HalvingCoef > 0 && c.HalvingCoef <= 1, "HalvingCoef should be in (0, 1], got %v", c.HalvingCoef)
+	check(c.MinInviterBalance == nil || c.MinInviterBalance.Sign() >= 0, "MinInviterBalance should not be negative, got %v", c.MinInviterBalance)
+	check(c.MinBlockInterval >= 0, "MinBlockInterval should not be negative, got %v", c.MinBlockInterval)
+	check(c.MaxEmptyBlocksPerEpoch >= 0, "MaxEmptyBlocksPerEpoch should not be negative, got %v", c.MaxEmptyBlocksPerEpoch)
+	check(testOnlyOverridesAllowed || c.TestOnlyCommitteeSelectionSeed == nil, "TestOnlyCommitteeSelectionSeed should not be set in production builds")
+	if len(errs) > 0 {
+		sort.Slice(errs, func(i, j int) bool {
+			return errs[i].Error() < errs[j].Error()
+		})
+		return errs
+	}
+	return nil
+}
+
+// IsForkActivated reports whether the block at the given height is processed with protocol changes guarded by ForkHeight
+func (c *ConsensusConf) IsForkActivated(height uint64) bool {
+	return height >= c.ForkHeight
+}
+
+// CommitteeSeed returns the seed committees are selected with, it is the block seed unless
+// TestOnlyCommitteeSelectionSeed is set
+func (c *ConsensusConf) CommitteeSeed(blockSeed types.Seed) types.Seed {
+	if c.TestOnlyCommitteeSelectionSeed != nil {
+		return *c.TestOnlyCommitteeSelectionSeed
+	}
+	return blockSeed
+}
+
+func EncodeParamValue(value float64) []byte {
+	data := make([]byte, 8)
+	binary.BigEndian.PutUint64(data, math.Float64bits(value))
+	return data
+}
+
+// ValidateParam checks that the param is governable and its value is within the allowed range
+func ValidateParam(key string, value []byte) error {
+	_, err := decodeParam(key, value)
+	return err
+}
+
+// GovernedParams are consensus params which can be changed by ParamChangeTx
+type GovernedParams struct {
+	MinProposerThreshold float64
+	CommitteePercent     float64
+	FeeBurnRate          float32
+}
+
+// GovernedParams returns values of governable params set by the config
+func (c *ConsensusConf) GovernedParams() GovernedParams {
+	return GovernedParams{
+		MinProposerThreshold: c.MinProposerThreshold,
+		CommitteePercent:     c.CommitteePercent,
+		FeeBurnRate:          c.FeeBurnRate,
+	}
+}
+
+// ApplyParam sets the governable param if its value is valid
+func (p *GovernedParams) ApplyParam(key string, value []byte) error {
+	v, err := decodeParam(key, value)
+	if err != nil {
+		return err
+	}
+	switch key {
+	case ProposerThresholdParam:
+		p.MinProposerThreshold = v
+	case CommitteePercentParam:
+		p.CommitteePercent = v
+	case FeeBurnRateParam:
+		p.FeeBurnRate = float32(v)
+	}
+	return nil
+}
+
+// decodeParam decodes the param value and checks it against the bounds of ConsensusConf.Validate
+func decodeParam(key string, value []byte) (float64, error) {
+	var valid func(v float64) bool
+	switch key {
+	case ProposerThresholdParam:
+		valid = func(v float64) bool { return v > 0 && v < 1 }
+	case CommitteePercentParam:
+		valid = func(v float64) bool { return v > 0 && v <= 1 }
+	case FeeBurnRateParam:
+		valid = func(v float64) bool { return v >= 0 && v <= 1 }
+	default:
+		return 0, ErrUnknownParam
+	}
+	if len(value) != 8 {
+		return 0, ErrInvalidParamValue
+	}
+	v := math.Float64frombits(binary.BigEndian.Uint64(value))
+	if math.IsNaN(v) || !valid(v) {
+		return 0, ErrInvalidParamValue
 	}
+	return v, nil
 }
@@ -0,0 +1,86 @@
+package config
+
+import (
+	"github.com/idena-network/idena-go/blockchain/types"
+	"github.com/stretchr/testify/require"
+	"math"
+	"math/big"
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+func TestConsensusConf_Validate(t *testing.T) {
+	require := require.New(t)
+	require.NoError(GetDefaultConsensusConfig().Validate())
+
+	conf := GetDefaultConsensusConfig()
+	conf.MinProposerThreshold = 1.5
+	conf.FeeBurnRate = 1.1
+	conf.CommitteePercent = 0
+	conf.BlockReward = big.NewInt(0)
+	conf.FinalCommitteeReward = nil
+	err := conf.Validate()
+	require.Error(err)
+	require.Len(err.(ConfigErrors), 5)
+
+	conf = GetDefaultConsensusConfig()
+	conf.AgreementThreshold = math.NaN()
+	require.Len(conf.Validate().(ConfigErrors), 1)
+
+	conf = GetDefaultConsensusConfig()
+	conf.HalvingCoef = 0
+	require.NoError(conf.Validate())
+	conf.HalvingInterval = 1000
+	require.Len(conf.Validate().(ConfigErrors), 1)
+}
+
+func TestOnlyCommitteeSelectionSeed(t *testing.T) {
+	require := require.New(t)
+	conf := GetDefaultConsensusConfig()
+	blockSeed := types.Seed{0x1}
+	require.Equal(blockSeed, conf.CommitteeSeed(blockSeed))
+
+	conf.TestOnlyCommitteeSelectionSeed = &types.Seed{0x2}
+	require.Equal(types.Seed{0x2}, conf.CommitteeSeed(blockSeed))
+	require.NoError(conf.Validate())
+}
+
+func TestConsensusConf_ValidateRandomFloats(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	specials := []float64{0, 1, -1, math.NaN(), math.Inf(1), math.Inf(-1), math.SmallestNonzeroFloat64, math.MaxFloat64}
+	for i := 0; i < 1000; i++ {
+		conf := GetDefaultConsensusConfig()
+		value := reflect.ValueOf(conf).Elem()
+		for j := 0; j < value.NumField(); j++ {
+			v := specials[rnd.Intn(len(specials))]
+			if rnd.Intn(2) == 0 {
+				v = rnd.NormFloat64() * 10
+			}
+			switch field := value.Field(j); field.Kind() {
+			case reflect.Float32, reflect.Float64:
+				field.SetFloat(v)
+			}
+		}
+		require.NotPanics(t, func() {
+			conf.Validate()
+		})
+	}
+}
+
+func TestGovernedParams_ApplyParamMatchesValidate(t *testing.T) {
+	require := require.New(t)
+	values := []float64{0, 0.5, 1, 1.5, -1, math.NaN(), math.SmallestNonzeroFloat64}
+	for _, key := range []string{ProposerThresholdParam, CommitteePercentParam, FeeBurnRateParam} {
+		for _, v := range values {
+			conf := GetDefaultConsensusConfig()
+			params := conf.GovernedParams()
+			err := params.ApplyParam(key, EncodeParamValue(v))
+			conf.MinProposerThreshold = params.MinProposerThreshold
+			conf.CommitteePercent = params.CommitteePercent
+			conf.FeeBurnRate = params.FeeBurnRate
+			require.Equal(err == nil, conf.Validate() == nil && ValidateParam(key, EncodeParamValue(v)) == nil, "%v=%v", key, v)
+		}
+	}
+	require.Equal(ErrUnknownParam, ValidateParam("BlockReward", EncodeParamValue(0.5)))
+}
@@ -17,6 +17,9 @@ const (
 	DefaultMaxInboundPeers  = 12
 	DefaultMaxOutboundPeers = 6
 	DefaultBurntTxRange     = 180
+	DefaultFeeHistoryRange  = 100000
+
+	DefaultProposerHistorySize = 1000
 
 	LowPowerMaxInboundPeers  = 6
 	LowPowerMaxOutboundPeers = 3
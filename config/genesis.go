@@ -2,6 +2,7 @@ package config
 
 import (
 	"github.com/idena-network/idena-go/common"
+	"github.com/idena-network/idena-go/common/hexutil"
 	"math/big"
 )
 
@@ -16,4 +17,9 @@ type GenesisConf struct {
 	GodAddress        common.Address
 	FirstCeremonyTime int64
 	GodAddressInvites uint16
+	// unix timestamp of the genesis block, it is a part of the genesis hash so all nodes of the network must use the same value
+	GenesisTimestamp int64
+	// public keys allowed to sign genesis patches, a patch requires TrustedKeysThreshold signatures of different keys
+	TrustedKeys          []hexutil.Bytes
+	TrustedKeysThreshold int
 }
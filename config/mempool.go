@@ -9,6 +9,8 @@ type Mempool struct {
 	TxPoolAddrQueueLimit      int
 	TxPoolAddrExecutableLimit int
 	TxLifetime                time.Duration
+	// max count of regular txs in the pool, zero means the limit derived from slots and address limits
+	TxPoolMaxSize int
 }
 
 func GetDefaultMempoolConfig() *Mempool {
@@ -0,0 +1,6 @@
+// +build !production
+
+package config
+
+// test only overrides like ConsensusConf.TestOnlyCommitteeSelectionSeed are rejected by production builds
+const testOnlyOverridesAllowed = true
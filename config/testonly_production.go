@@ -0,0 +1,5 @@
+// +build production
+
+package config
+
+const testOnlyOverridesAllowed = false
@@ -102,7 +102,7 @@ func (engine *Engine) GetProcess() string {
 }
 
 func (engine *Engine) ReadonlyAppState() (*appstate.AppState, error) {
-	currentBlock := engine.chain.Head.Height()
+	currentBlock := engine.chain.GetCurrentHead().Height()
 	if engine.appStateCache != nil && engine.appStateCache.block == currentBlock {
 		return engine.appStateCache.appState, nil
 	}
@@ -111,7 +111,7 @@ func (engine *Engine) ReadonlyAppState() (*appstate.AppState, error) {
 	if engine.appStateCache != nil && engine.appStateCache.block == currentBlock {
 		return engine.appStateCache.appState, nil
 	}
-	s, err := engine.appState.Readonly(engine.chain.Head.Height())
+	s, err := engine.appState.Readonly(engine.chain.GetCurrentHead().Height())
 	if err != nil {
 		return nil, err
 	}
@@ -139,7 +139,7 @@ func (engine *Engine) alignTime() {
 		}
 	}
 	correctedNow := now.Add(-offset)
-	headTime := time.Unix(engine.chain.Head.Time(), 0)
+	headTime := time.Unix(engine.chain.GetCurrentHead().Time(), 0)
 
 	if correctedNow.After(headTime) {
 		maxDelay := engine.config.MinBlockDistance - engine.config.EstimatedBaVariance - engine.config.WaitSortitionProofDelay
@@ -182,7 +182,7 @@ func (engine *Engine) loop() {
 			continue
 		}
 		engine.synced = true
-		head := engine.chain.Head
+		head := engine.chain.GetCurrentHead()
 
 		round := head.Height() + 1
 		engine.completeRound(round - 1)
@@ -205,7 +205,7 @@ func (engine *Engine) loop() {
 			engine.process = "Propose block"
 			block = engine.proposeBlock(proposerProof)
 			if block != nil {
-				engine.log.Info("Selected as proposer", "block", block.Hash().Hex(), "round", round, "thresholdVrf", engine.appState.State.VrfProposerThreshold())
+				engine.log.Info("Selected as proposer", "block", block.Hash().Hex(), "round", round, "thresholdVrf", engine.chain.ComputeVRFThreshold(block.Height()))
 			}
 		}
 
@@ -260,16 +260,20 @@ func (engine *Engine) loop() {
 			engine.chain.WriteCertificate(blockHash, cert.Compress(), engine.chain.IsPermanentCert(emptyBlock.Header))
 			engine.log.Info("Reached consensus on empty block")
 		} else {
-			block, err := engine.getBlockByHash(round, blockHash)
+			proposedBlock, err := engine.getBlockByHash(round, blockHash)
 			if err == nil {
-				if err := engine.chain.AddBlock(block, nil, engine.statsCollector); err != nil {
+				block := proposedBlock.Block
+				if err := engine.chain.AddProposedBlock(proposedBlock, engine.statsCollector); err != nil {
 					engine.log.Error("Add block", "err", err)
 					continue
 				}
 				if hash == blockHash {
 					engine.log.Info("Reached FINAL", "block", blockHash.Hex(), "txs", len(block.Body.Transactions))
-					engine.chain.WriteFinalConsensus(blockHash)
-					cert = finalCert
+					if err := engine.chain.WriteFinalConsensus(blockHash, finalCert.Compress()); err != nil {
+						engine.log.Warn("Invalid final cert", "err", err)
+					} else {
+						cert = finalCert
+					}
 				} else {
 					engine.log.Info("Reached TENTATIVE", "block", blockHash.Hex(), "txs", len(block.Body.Transactions))
 				}
@@ -311,6 +315,9 @@ func (engine *Engine) completeRound(round uint64) {
 
 func (engine *Engine) proposeBlock(proof []byte) *types.Block {
 	proposal := engine.chain.ProposeBlock(proof)
+	if proposal == nil {
+		return nil
+	}
 
 	engine.log.Info("Proposed block", "block", proposal.Hash().Hex(), "txs", len(proposal.Body.Transactions))
 
@@ -436,16 +443,16 @@ func (engine *Engine) binaryBa(blockHash common.Hash) (common.Hash, *types.FullB
 
 func (engine *Engine) vote(round uint64, step uint8, block common.Hash) {
 	committeeSize := engine.chain.GetCommitteeSize(engine.appState.ValidatorsCache, step == types.Final)
-	stepValidators := engine.appState.ValidatorsCache.GetOnlineValidators(engine.chain.Head.Seed(), round, step, committeeSize)
+	stepValidators := engine.appState.ValidatorsCache.GetOnlineValidators(engine.config.CommitteeSeed(engine.chain.GetCurrentHead().Seed()), round, step, committeeSize)
 	if stepValidators == nil {
 		return
 	}
-	if stepValidators.Contains(engine.addr) {
+	if engine.appState.ValidatorsCache.VoteWeight(stepValidators, engine.addr) > 0 {
 		vote := types.Vote{
 			Header: &types.VoteHeader{
 				Round:      round,
 				Step:       step,
-				ParentHash: engine.chain.Head.Hash(),
+				ParentHash: engine.chain.GetCurrentHead().Hash(),
 				VotedHash:  block,
 			},
 		}
@@ -470,7 +477,8 @@ func (engine *Engine) countVotes(round uint64, step uint8, parentHash common.Has
 	defer engine.log.Debug("Finish count votes", "step", step)
 
 	byBlock := make(map[common.Hash]map[common.Address]*types.Vote)
-	validators := engine.appState.ValidatorsCache.GetOnlineValidators(engine.chain.Head.Seed(), round, step, engine.chain.GetCommitteeSize(engine.appState.ValidatorsCache, step == types.Final))
+	weightByBlock := make(map[common.Hash]int)
+	validators := engine.appState.ValidatorsCache.GetOnlineValidators(engine.config.CommitteeSeed(engine.chain.GetCurrentHead().Seed()), round, step, engine.chain.GetCommitteeSize(engine.appState.ValidatorsCache, step == types.Final))
 	if validators == nil {
 		return common.Hash{}, nil, errors.Errorf("validators were not setup, step=%v", step)
 	}
@@ -495,27 +503,30 @@ func (engine *Engine) countVotes(round uint64, step uint8, parentHash common.Has
 					if vote.Header.ParentHash != parentHash {
 						return true
 					}
-					if !validators.Contains(vote.VoterAddr()) {
+					weight := engine.appState.ValidatorsCache.VoteWeight(validators, vote.VoterAddr())
+					if weight == 0 {
 						return true
 					}
 					if vote.Header.Step != step {
 						return true
 					}
 					roundVotes[vote.VoterAddr()] = vote
+					weightByBlock[vote.Header.VotedHash] += weight
 
-					if len(roundVotes) >= necessaryVotesCount {
+					if weightByBlock[vote.Header.VotedHash] >= necessaryVotesCount {
 						list := make([]*types.Vote, 0, necessaryVotesCount)
-
+						listWeight := 0
 						for _, v := range roundVotes {
 							list = append(list, v)
-							if len(list) >= necessaryVotesCount {
+							listWeight += engine.appState.ValidatorsCache.VoteWeight(validators, v.VoterAddr())
+							if listWeight >= necessaryVotesCount {
 								break
 							}
 						}
 						cert = types.FullBlockCert{Votes: list}
 						bestHash = vote.Header.VotedHash
-						found = len(cert.Votes) >= necessaryVotesCount
-						engine.log.Debug("Has votes", "cnt", len(roundVotes), "need", necessaryVotesCount, "step", step, "hash", bestHash.Hex())
+						found = true
+						engine.log.Debug("Has votes", "cnt", len(roundVotes), "weight", weightByBlock[bestHash], "need", necessaryVotesCount, "step", step, "hash", bestHash.Hex())
 						return !found
 					}
 				}
@@ -531,10 +542,11 @@ func (engine *Engine) countVotes(round uint64, step uint8, parentHash common.Has
 	return common.Hash{}, nil, errors.New(fmt.Sprintf("votes for step is not received, step=%v", step))
 }
 
-func (engine *Engine) getBlockByHash(round uint64, hash common.Hash) (*types.Block, error) {
+func (engine *Engine) getBlockByHash(round uint64, hash common.Hash) (*blockchain.PrevalidatedBlock, error) {
+	// proposals keep only blocks which passed validation
 	block, err := engine.proposals.GetBlockByHash(round, hash)
 	if err == nil {
-		return block, nil
+		return blockchain.NewPrevalidatedBlock(block, true), nil
 	}
 	engine.proposals.ApproveBlock(hash)
 	engine.pm.RequestBlockByHash(hash)
@@ -543,7 +555,7 @@ func (engine *Engine) getBlockByHash(round uint64, hash common.Hash) (*types.Blo
 		block := engine.proposals.GetBlock(hash)
 		if block != nil {
 			engine.log.Info("Block was received successfully", "hash", block.Hash().Hex())
-			return block, nil
+			return blockchain.NewPrevalidatedBlock(block, false), nil
 		} else {
 			time.Sleep(100 * time.Millisecond)
 		}
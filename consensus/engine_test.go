@@ -0,0 +1,24 @@
+package consensus
+
+import (
+	"github.com/idena-network/idena-go/blockchain"
+	"github.com/idena-network/idena-go/crypto"
+	"github.com/idena-network/idena-go/log"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestEngine_proposeBlockSkipped(t *testing.T) {
+	key, _ := crypto.GenerateKey()
+	cfg := blockchain.NewTestConfig(crypto.PubkeyToAddress(key.PublicKey), nil)
+	cfg.GenesisConf.GenesisTimestamp = time.Now().UTC().Unix()
+	chain, _ := blockchain.NewCustomTestBlockchainWithConfig(0, 0, key, cfg)
+
+	// the head is too recent to propose the next block, the engine must not touch the missing proposal
+	cfg.Consensus.Automine = false
+	cfg.Consensus.MinBlockInterval = time.Hour
+	engine := &Engine{chain: chain.Blockchain, log: log.New()}
+
+	require.Nil(t, engine.proposeBlock(nil))
+}
@@ -117,7 +117,7 @@ func (resolver *ForkResolver) checkForkSize(fork []types.BlockBundle) error {
 	}
 	forkLastBlock := fork[len(fork)-1].Block
 
-	if forkLastBlock.Height() > resolver.chain.Head.Height() {
+	if forkLastBlock.Height() > resolver.chain.GetCurrentHead().Height() {
 		return nil
 	}
 
@@ -130,14 +130,14 @@ func (resolver *ForkResolver) checkForkSize(fork []types.BlockBundle) error {
 		if !fork[j].Block.IsEmpty() {
 			forkProposedBlocks++
 		}
-		if !resolver.chain.GetBlockByHeight(i).IsEmpty() {
+		if resolver.chain.GetBlockHeaderByHeight(i).EmptyBlockHeader == nil {
 			ownProposedBlocks++
 		}
 	}
 	if forkProposedBlocks < ownProposedBlocks {
 		return errors.New("fork has less proposed blocks")
 	}
-	forkHasBestSeed := bytes.Compare(fork[0].Block.Seed().Bytes(), resolver.chain.GetBlockByHeight(firstForkBlockHeight).Seed().Bytes()) > 0
+	forkHasBestSeed := bytes.Compare(fork[0].Block.Seed().Bytes(), resolver.chain.GetBlockHeaderByHeight(firstForkBlockHeight).Seed().Bytes()) > 0
 	if forkHasBestSeed {
 		return nil
 	}
@@ -16,11 +16,11 @@ func TestForkResolver_ResolveFork(t *testing.T) {
 	chain, _ := blockchain.NewCustomTestBlockchain(100, 0, key)
 	chain2, _ := chain.Copy()
 	chain2.ResetTo(80)
-	require.Equal(t, chain.GetBlockHeaderByHeight(80).Hash(), chain2.Head.Hash())
+	require.Equal(t, chain.GetBlockHeaderByHeight(80).Hash(), chain2.GetCurrentHead().Hash())
 
 	chain2.GenerateEmptyBlocks(1).GenerateBlocks(20)
 
-	require.Equal(t, chain.Head.Height(), chain2.Head.Height())
+	require.Equal(t, chain.GetCurrentHead().Height(), chain2.GetCurrentHead().Height())
 
 	forkHashes := chain2.GetTopBlockHashes(100)
 	initialHashes := chain.GetTopBlockHashes(100)
@@ -60,7 +60,7 @@ func TestForkResolver_ResolveFork(t *testing.T) {
 	require.Nil(t, resolver.applicableFork)
 	require.True(t, resolver.triedPeers.Cardinality() == 0)
 
-	require.Equal(t, chain.Head.Hash(), chain2.GetBlockHeaderByHeight(chain.Head.Height()).Hash())
+	require.Equal(t, chain.GetCurrentHead().Hash(), chain2.GetBlockHeaderByHeight(chain.GetCurrentHead().Height()).Hash())
 }
 
 func TestForkResolver_ResolveFork2(t *testing.T) {
@@ -75,6 +75,30 @@ func (s *AppState) Readonly(height uint64) (*AppState, error) {
 	}, nil
 }
 
+// Copy returns an independent app state with the same version and uncommitted changes, the state must not be precommitted
+func (s *AppState) Copy() (*AppState, error) {
+	st, err := s.State.Copy()
+	if err != nil {
+		return nil, err
+	}
+	identityState, err := s.IdentityState.Copy()
+	if err != nil {
+		return nil, err
+	}
+
+	validatorsCache := s.ValidatorsCache.Clone()
+	if validatorsCache.Height() != identityState.Version() {
+		validatorsCache = validators.NewValidatorsCache(identityState, st.GodAddress())
+		validatorsCache.Load()
+	}
+	return &AppState{
+		State:           st,
+		IdentityState:   identityState,
+		ValidatorsCache: validatorsCache,
+		NonceCache:      s.NonceCache,
+	}, nil
+}
+
 // loads appState
 func (s *AppState) ForCheckWithOverwrite(height uint64) (*AppState, error) {
 
@@ -299,7 +299,7 @@ func (vc *ValidationCeremony) startValidationShortSessionTimer() {
 				select {
 				case <-ticker.C:
 					if time.Now().UTC().After(validationTime) {
-						if appState, err := vc.appState.Readonly(vc.chain.Head.Height()); err == nil {
+						if appState, err := vc.appState.Readonly(vc.chain.GetCurrentHead().Height()); err == nil {
 							vc.startShortSession(appState)
 							vc.log.Info("Timer triggered")
 						} else {
@@ -506,7 +506,7 @@ func (vc *ValidationCeremony) shouldInteractWithNetwork() bool {
 		conf.GetShortSessionDuration() +
 		conf.GetLongSessionDuration(vc.appState.ValidatorsCache.NetworkSize()) +
 		time.Minute*15 // added extra minutes to prevent time lags
-	headTime := time.Unix(vc.chain.Head.Time(), 0)
+	headTime := time.Unix(vc.chain.GetCurrentHead().Time(), 0)
 
 	// if head's timestamp is close to now() we should interact with network
 	return time.Now().UTC().Sub(headTime) < ceremonyDuration
@@ -813,6 +813,9 @@ func applyOnState(appState *appstate.AppState, statsCollector collector.StatsCol
 		identitiesCount++
 	} else if value.state == state.Killed {
 		// Stake of killed identity is burnt
+		if value.prevState != state.Killed {
+			appState.State.AddBurntCoins(appState.State.GetStakeBalance(addr))
+		}
 		collector.AddKilledBurntCoins(statsCollector, addr, appState.State.GetStakeBalance(addr))
 	}
 	return identitiesCount
@@ -1,14 +1,19 @@
 package mempool
 
 import (
+	"container/heap"
+	"github.com/idena-network/idena-go/blockchain/fee"
 	"github.com/idena-network/idena-go/blockchain/types"
 	"github.com/idena-network/idena-go/blockchain/validation"
 	"github.com/idena-network/idena-go/common"
+	"github.com/idena-network/idena-go/config"
 	"github.com/idena-network/idena-go/core/appstate"
+	"math/big"
 )
 
 type buildingContext struct {
 	appState           *appstate.AppState
+	consensusCfg       *config.ConsensusConf
 	sortedTxs          []*types.Transaction
 	sortedPriorityTxs  []*types.Transaction
 	sortedTxsPerSender map[common.Address][]*types.Transaction
@@ -19,6 +24,7 @@ type buildingContext struct {
 
 func newBuildingContext(
 	appState *appstate.AppState,
+	consensusCfg *config.ConsensusConf,
 	sortedTxs []*types.Transaction,
 	sortedPriorityTxs []*types.Transaction,
 	sortedTxsPerSender map[common.Address][]*types.Transaction,
@@ -27,6 +33,7 @@ func newBuildingContext(
 
 	ctx := &buildingContext{
 		appState:           appState,
+		consensusCfg:       consensusCfg,
 		sortedTxs:          sortedTxs,
 		sortedPriorityTxs:  sortedPriorityTxs,
 		sortedTxsPerSender: sortedTxsPerSender,
@@ -81,13 +88,21 @@ func (ctx *buildingContext) addNextPriorityTxToBlock() {
 }
 
 func (ctx *buildingContext) addTxsToBlock() {
-	txs := ctx.sortedTxs
-	for _, tx := range txs {
+	queue := ctx.newTxsByPriceAndNonce()
+	for queue.Len() > 0 {
+		tx := queue.peek()
 		if !ctx.checkFee(tx) {
+			queue.pop()
 			continue
 		}
 		sender, _ := types.Sender(tx)
+		// tx is already added to block
+		if tx.AccountNonce <= ctx.curNoncesPerSender[sender] {
+			queue.shift()
+			continue
+		}
 		if ctx.curNoncesPerSender[sender]+1 != tx.AccountNonce {
+			queue.pop()
 			continue
 		}
 		if ctx.blockSize+tx.Size() > BlockBodySize {
@@ -96,9 +111,91 @@ func (ctx *buildingContext) addTxsToBlock() {
 		ctx.blockTxs = append(ctx.blockTxs, tx)
 		ctx.blockSize += tx.Size()
 		ctx.curNoncesPerSender[sender] = tx.AccountNonce
+		queue.shift()
+	}
+}
+
+func (ctx *buildingContext) newTxsByPriceAndNonce() *txsByPriceAndNonce {
+	networkSize := ctx.appState.ValidatorsCache.NetworkSize()
+	feePerByte := ctx.appState.State.FeePerByte()
+	forkActivated := ctx.consensusCfg.IsForkActivated(uint64(ctx.appState.State.Version()) + 1)
+	queue := &txsByPriceAndNonce{
+		txsPerSender: make(map[common.Address][]*types.Transaction),
+		prices:       make(map[common.Hash]*big.Int),
+		positions:    make(map[common.Hash]int),
+	}
+	for i, tx := range ctx.sortedTxs {
+		sender, _ := types.Sender(tx)
+		hash := tx.Hash()
+		price := fee.CalculateFee(networkSize, feePerByte, tx, forkActivated)
+		queue.prices[hash] = price.Add(price, tx.TipsOrZero())
+		queue.positions[hash] = i
+		if _, ok := queue.txsPerSender[sender]; !ok {
+			queue.heads = append(queue.heads, tx)
+		}
+		queue.txsPerSender[sender] = append(queue.txsPerSender[sender], tx)
+	}
+	heap.Init(queue)
+	return queue
+}
+
+// txsByPriceAndNonce keeps the first tx of every sender ordered by fee including tips
+type txsByPriceAndNonce struct {
+	heads        []*types.Transaction
+	txsPerSender map[common.Address][]*types.Transaction
+	prices       map[common.Hash]*big.Int
+	positions    map[common.Hash]int
+}
+
+func (q *txsByPriceAndNonce) Len() int { return len(q.heads) }
+
+func (q *txsByPriceAndNonce) Less(i, j int) bool {
+	hashI, hashJ := q.heads[i].Hash(), q.heads[j].Hash()
+	if cmp := q.prices[hashI].Cmp(q.prices[hashJ]); cmp != 0 {
+		return cmp > 0
 	}
+	return q.positions[hashI] < q.positions[hashJ]
+}
+
+func (q *txsByPriceAndNonce) Swap(i, j int) { q.heads[i], q.heads[j] = q.heads[j], q.heads[i] }
+
+func (q *txsByPriceAndNonce) Push(x interface{}) {
+	q.heads = append(q.heads, x.(*types.Transaction))
+}
+
+func (q *txsByPriceAndNonce) Pop() interface{} {
+	old := q.heads
+	n := len(old)
+	x := old[n-1]
+	old[n-1] = nil
+	q.heads = old[:n-1]
+	return x
+}
+
+func (q *txsByPriceAndNonce) peek() *types.Transaction {
+	return q.heads[0]
+}
+
+// shift replaces the best tx with the next tx of the same sender
+func (q *txsByPriceAndNonce) shift() {
+	sender, _ := types.Sender(q.heads[0])
+	txs := q.txsPerSender[sender][1:]
+	q.txsPerSender[sender] = txs
+	if len(txs) == 0 {
+		heap.Pop(q)
+		return
+	}
+	q.heads[0] = txs[0]
+	heap.Fix(q, 0)
+}
+
+// pop removes the best tx and all remaining txs of the same sender
+func (q *txsByPriceAndNonce) pop() {
+	sender, _ := types.Sender(q.heads[0])
+	delete(q.txsPerSender, sender)
+	heap.Pop(q)
 }
 
 func (ctx *buildingContext) checkFee(tx *types.Transaction) bool {
-	return validation.ValidateFee(ctx.appState, tx, validation.InBlockTx) == nil
+	return validation.ValidateFee(ctx.appState, tx, validation.InBlockTx, ctx.consensusCfg) == nil
 }
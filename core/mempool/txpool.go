@@ -11,19 +11,32 @@ import (
 	"github.com/idena-network/idena-go/core/appstate"
 	"github.com/idena-network/idena-go/events"
 	"github.com/idena-network/idena-go/log"
+	"github.com/idena-network/idena-go/rlp"
 	"github.com/pkg/errors"
+	dbm "github.com/tendermint/tm-db"
+	"math/big"
 	"sort"
 	"sync"
+	"time"
 )
 
 const (
 	BlockBodySize  = 300 * 1024
 	MaxDeferredTxs = 100
+
+	// minimal fee increase (in percents) required to replace pending tx
+	ReplaceTxFeeBumpPercent = 10
+
+	// max number of txs written by Persist, the oldest txs are evicted first
+	MaxPersistedTxs = 10000
 )
 
 var (
 	DuplicateTxError = errors.New("tx with same hash already exists")
 	MempoolFullError = errors.New("mempool is full")
+	ErrFeeBumpTooLow = errors.New("replacement tx fee is too low")
+	ErrNoTxToReplace = errors.New("no pending tx to replace")
+	persistedTxsKey  = []byte("mempool-txs")
 	priorityTypes    = map[types.TxType]bool{
 		types.SubmitAnswersHashTx:  true,
 		types.SubmitShortAnswersTx: true,
@@ -44,6 +57,7 @@ type TxPool struct {
 	executableTxs    map[common.Address]*sortedTxs
 	pendingTxs       map[common.Address]*txMap
 	cfg              *config.Mempool
+	consensusCfg     *config.ConsensusConf
 	txSubscription   chan *types.Transaction
 	mutex            *sync.Mutex
 	appState         *appstate.AppState
@@ -52,15 +66,30 @@ type TxPool struct {
 	bus              eventbus.Bus
 	isSyncing        bool //indicates about blockchain's syncing
 	coinbase         common.Address
+	sizeByType       map[types.TxType]int
+	submittedAt      map[common.Hash]time.Time
+	now              func() time.Time
+}
+
+// TxPoolStats describes pool fill level, PendingCount includes QueuedCount txs waiting for missing nonces
+type TxPoolStats struct {
+	PendingCount int           `json:"pendingCount"`
+	QueuedCount  int           `json:"queuedCount"`
+	MaxCapacity  int           `json:"maxCapacity"`
+	OldestTxAge  time.Duration `json:"oldestTxAge"`
 }
 
-func NewTxPool(appState *appstate.AppState, bus eventbus.Bus, cfg *config.Mempool) *TxPool {
+func NewTxPool(appState *appstate.AppState, bus eventbus.Bus, cfg *config.Mempool, consensusCfg *config.ConsensusConf) *TxPool {
 	pool := &TxPool{
 		all:              newTxMap(-1),
 		executableTxs:    make(map[common.Address]*sortedTxs),
 		pendingTxs:       make(map[common.Address]*txMap),
 		knownDeferredTxs: mapset.NewSet(),
+		sizeByType:       make(map[types.TxType]int),
+		submittedAt:      make(map[common.Hash]time.Time),
+		now:              time.Now,
 		cfg:              cfg,
+		consensusCfg:     consensusCfg,
 		mutex:            &sync.Mutex{},
 		appState:         appState,
 		log:              log.New(),
@@ -142,15 +171,20 @@ func (pool *TxPool) checkPriorityTxLimits(tx *types.Transaction) error {
 	return nil
 }
 
-func (pool *TxPool) checkRegularTxLimits(tx *types.Transaction) error {
-	var totalLimit = 0
+// maxSize returns max count of regular txs in the pool, non-positive value means no limit
+func (pool *TxPool) maxSize() int {
+	if pool.cfg.TxPoolMaxSize > 0 {
+		return pool.cfg.TxPoolMaxSize
+	}
 	if pool.cfg.TxPoolExecutableSlots < 0 || pool.cfg.TxPoolQueueSlots < 0 {
-		totalLimit = -1
-	} else {
-		totalLimit = pool.cfg.TxPoolExecutableSlots*pool.cfg.TxPoolAddrExecutableLimit +
-			pool.cfg.TxPoolQueueSlots*pool.cfg.TxPoolAddrQueueLimit
+		return -1
 	}
-	if totalLimit > 0 && len(pool.all.txs) >= totalLimit {
+	return pool.cfg.TxPoolExecutableSlots*pool.cfg.TxPoolAddrExecutableLimit +
+		pool.cfg.TxPoolQueueSlots*pool.cfg.TxPoolAddrQueueLimit
+}
+
+func (pool *TxPool) checkRegularTxLimits(tx *types.Transaction) error {
+	if totalLimit := pool.maxSize(); totalLimit > 0 && pool.totalSize() >= totalLimit {
 		return errors.New("tx queue max size reached")
 	}
 	sender, _ := types.Sender(tx)
@@ -173,7 +207,7 @@ func (pool *TxPool) checkRegularTxLimits(tx *types.Transaction) error {
 
 func (pool *TxPool) validate(tx *types.Transaction, appState *appstate.AppState, txType validation.TxType) error {
 	minFeePerByte := fee.GetFeePerByteForNetwork(appState.ValidatorsCache.NetworkSize())
-	return validation.ValidateTx(appState, tx, minFeePerByte, txType)
+	return validation.ValidateTx(appState, tx, minFeePerByte, txType, pool.consensusCfg)
 }
 
 func (pool *TxPool) AddTxs(txs []*types.Transaction) {
@@ -206,6 +240,8 @@ func (pool *TxPool) AddTxs(txs []*types.Transaction) {
 }
 
 func (pool *TxPool) Add(tx *types.Transaction) error {
+	// the pool keeps its own copy so the caller can't mutate stored txs
+	tx = tx.Clone()
 
 	sender, _ := types.Sender(tx)
 
@@ -255,6 +291,90 @@ func (pool *TxPool) add(tx *types.Transaction, appState *appstate.AppState) erro
 	return pool.put(tx)
 }
 
+// ReplaceTx replaces pending tx having the same sender, epoch and nonce with newTx if newTx pays enough higher fee
+func (pool *TxPool) ReplaceTx(newTx *types.Transaction) error {
+	pool.mutex.Lock()
+	defer pool.mutex.Unlock()
+
+	if _, ok := pool.all.Get(newTx.Hash()); ok {
+		return DuplicateTxError
+	}
+	appState, err := pool.appState.Readonly(pool.head.Height())
+	if err != nil {
+		return errors.WithMessage(err, "tx can't be validated")
+	}
+
+	sender, _ := types.Sender(newTx)
+	oldTx := pool.findTx(sender, newTx.Epoch, newTx.AccountNonce)
+	if oldTx == nil {
+		return ErrNoTxToReplace
+	}
+
+	networkSize := appState.ValidatorsCache.NetworkSize()
+	minFeePerByte := fee.GetFeePerByteForNetwork(networkSize)
+	forkActivated := pool.consensusCfg.IsForkActivated(pool.head.Height() + 1)
+	oldFee := txFeeWithTips(networkSize, minFeePerByte, oldTx, forkActivated)
+	newFee := txFeeWithTips(networkSize, minFeePerByte, newTx, forkActivated)
+	minNewFee := new(big.Int).Mul(oldFee, big.NewInt(100+ReplaceTxFeeBumpPercent))
+	minNewFee.Div(minNewFee, big.NewInt(100))
+	if newFee.Cmp(minNewFee) < 0 || newFee.Cmp(oldFee) == 0 {
+		return ErrFeeBumpTooLow
+	}
+
+	if err := validation.ValidateTx(appState, newTx, minFeePerByte, validation.InboundTx, pool.consensusCfg); err != nil {
+		return err
+	}
+
+	if executable, ok := pool.executableTxs[sender]; ok {
+		for i, tx := range executable.txs {
+			if tx.Hash() == oldTx.Hash() {
+				executable.txs[i] = newTx
+			}
+		}
+	}
+	if pending, ok := pool.pendingTxs[sender]; ok {
+		if _, ok := pending.Get(oldTx.Hash()); ok {
+			pending.Remove(oldTx.Hash())
+			pending.Add(newTx)
+		}
+	}
+	pool.all.Remove(oldTx.Hash())
+	pool.all.Add(newTx)
+	pool.decSizeByType(oldTx.Type)
+	pool.sizeByType[newTx.Type]++
+	delete(pool.submittedAt, oldTx.Hash())
+	pool.submittedAt[newTx.Hash()] = pool.now()
+
+	pool.bus.Publish(&events.NewTxEvent{
+		Tx:  newTx,
+		Own: sender == pool.coinbase,
+	})
+	return nil
+}
+
+func (pool *TxPool) findTx(sender common.Address, epoch uint16, nonce uint32) *types.Transaction {
+	if executable, ok := pool.executableTxs[sender]; ok {
+		for _, tx := range executable.txs {
+			if tx.Epoch == epoch && tx.AccountNonce == nonce {
+				return tx
+			}
+		}
+	}
+	if pending, ok := pool.pendingTxs[sender]; ok {
+		for _, tx := range pending.List() {
+			if tx.Epoch == epoch && tx.AccountNonce == nonce {
+				return tx
+			}
+		}
+	}
+	return nil
+}
+
+func txFeeWithTips(networkSize int, feePerByte *big.Int, tx *types.Transaction, forkActivated bool) *big.Int {
+	result := fee.CalculateFee(networkSize, feePerByte, tx, forkActivated)
+	return result.Add(result, tx.TipsOrZero())
+}
+
 func (pool *TxPool) putToPending(tx *types.Transaction) error {
 	sender, _ := types.Sender(tx)
 	set, ok := pool.pendingTxs[sender]
@@ -310,6 +430,8 @@ func (pool *TxPool) put(tx *types.Transaction) error {
 	}
 
 	pool.all.Add(tx)
+	pool.sizeByType[tx.Type]++
+	pool.submittedAt[tx.Hash()] = pool.now()
 
 	pool.appState.NonceCache.SetNonce(sender, tx.Epoch, tx.AccountNonce)
 
@@ -328,7 +450,7 @@ func (pool *TxPool) GetPendingByAddress(address common.Address) []*types.Transac
 	pool.mutex.Lock()
 	defer pool.mutex.Unlock()
 
-	var list []*types.Transaction
+	list := make([]*types.Transaction, 0)
 
 	if executable, ok := pool.executableTxs[address]; ok {
 		for _, tx := range executable.txs {
@@ -342,9 +464,121 @@ func (pool *TxPool) GetPendingByAddress(address common.Address) []*types.Transac
 		}
 	}
 
+	sort.SliceStable(list, func(i, j int) bool {
+		return list[i].AccountNonce < list[j].AccountNonce
+	})
 	return list
 }
 
+// Persist writes pending txs to db so they survive node restart, at most MaxPersistedTxs of the newest txs are kept
+func (pool *TxPool) Persist(db dbm.DB) error {
+	txs := pool.all.ListByArrival()
+	if len(txs) > MaxPersistedTxs {
+		txs = txs[len(txs)-MaxPersistedTxs:]
+	}
+	data := make([][]byte, 0, len(txs))
+	for _, tx := range txs {
+		txBytes, err := tx.ToBytes()
+		if err != nil {
+			return err
+		}
+		data = append(data, txBytes)
+	}
+	encoded, err := rlp.EncodeToBytes(data)
+	if err != nil {
+		return err
+	}
+	return db.Set(persistedTxsKey, encoded)
+}
+
+// LoadPersisted adds txs written by Persist back to the pool, txs which are not valid anymore (e.g. stale epoch or nonce) are dropped
+func (pool *TxPool) LoadPersisted(db dbm.DB) error {
+	encoded, err := db.Get(persistedTxsKey)
+	if err != nil || encoded == nil {
+		return err
+	}
+	var data [][]byte
+	if err := rlp.DecodeBytes(encoded, &data); err != nil {
+		return errors.Wrap(err, "failed to decode persisted txs")
+	}
+	txs := make([]*types.Transaction, 0, len(data))
+	for _, txBytes := range data {
+		tx := new(types.Transaction)
+		if err := tx.FromBytes(txBytes); err != nil {
+			continue
+		}
+		txs = append(txs, tx)
+	}
+	sort.SliceStable(txs, func(i, j int) bool {
+		if txs[i].Epoch != txs[j].Epoch {
+			return txs[i].Epoch < txs[j].Epoch
+		}
+		return txs[i].AccountNonce < txs[j].AccountNonce
+	})
+	loaded := 0
+	for _, tx := range txs {
+		if err := pool.Add(tx); err == nil {
+			loaded++
+		}
+	}
+	pool.log.Info("Persisted txs loaded", "total", len(txs), "loaded", loaded)
+	return db.Delete(persistedTxsKey)
+}
+
+// SizeByType returns count of pool transactions per type
+func (pool *TxPool) SizeByType() map[types.TxType]int {
+	pool.mutex.Lock()
+	defer pool.mutex.Unlock()
+	result := make(map[types.TxType]int, len(pool.sizeByType))
+	for txType, cnt := range pool.sizeByType {
+		result[txType] = cnt
+	}
+	return result
+}
+
+func (pool *TxPool) TotalSize() int {
+	pool.mutex.Lock()
+	defer pool.mutex.Unlock()
+	return pool.totalSize()
+}
+
+func (pool *TxPool) totalSize() int {
+	size := 0
+	for _, cnt := range pool.sizeByType {
+		size += cnt
+	}
+	return size
+}
+
+// Stats returns current pool fill level, OldestTxAge is zero for the empty pool
+func (pool *TxPool) Stats() TxPoolStats {
+	pool.mutex.Lock()
+	defer pool.mutex.Unlock()
+	stats := TxPoolStats{
+		PendingCount: pool.totalSize(),
+	}
+	if maxSize := pool.maxSize(); maxSize > 0 {
+		stats.MaxCapacity = maxSize
+	}
+	for _, pending := range pool.pendingTxs {
+		stats.QueuedCount += len(pending.List())
+	}
+	now := pool.now()
+	for _, submittedAt := range pool.submittedAt {
+		if age := now.Sub(submittedAt); age > stats.OldestTxAge {
+			stats.OldestTxAge = age
+		}
+	}
+	return stats
+}
+
+func (pool *TxPool) decSizeByType(txType types.TxType) {
+	pool.sizeByType[txType]--
+	if pool.sizeByType[txType] <= 0 {
+		delete(pool.sizeByType, txType)
+	}
+}
+
 func (pool *TxPool) GetTx(hash common.Hash) *types.Transaction {
 	pool.mutex.Lock()
 	defer pool.mutex.Unlock()
@@ -366,7 +600,29 @@ func (pool *TxPool) BuildBlockTransactions() []*types.Transaction {
 func (pool *TxPool) Remove(transaction *types.Transaction) {
 	pool.mutex.Lock()
 	defer pool.mutex.Unlock()
-	pool.all.Remove(transaction.Hash())
+	pool.remove(transaction)
+}
+
+// EvictByEpoch removes txs of epochs preceding currentEpoch, such txs can't be included into blocks anymore
+func (pool *TxPool) EvictByEpoch(currentEpoch uint16) int {
+	pool.mutex.Lock()
+	defer pool.mutex.Unlock()
+	evicted := 0
+	for _, tx := range pool.all.List() {
+		if tx.Epoch < currentEpoch {
+			pool.remove(tx)
+			evicted++
+		}
+	}
+	return evicted
+}
+
+func (pool *TxPool) remove(transaction *types.Transaction) {
+	if _, ok := pool.all.Get(transaction.Hash()); ok {
+		pool.all.Remove(transaction.Hash())
+		pool.decSizeByType(transaction.Type)
+		delete(pool.submittedAt, transaction.Hash())
+	}
 
 	sender, _ := types.Sender(transaction)
 
@@ -459,7 +715,7 @@ func (pool *TxPool) ResetTo(block *types.Block) {
 			continue
 		}
 
-		if err := validation.ValidateTx(appState, tx, minFeePerByte, validation.MempoolTx); err != nil {
+		if err := validation.ValidateTx(appState, tx, minFeePerByte, validation.MempoolTx, pool.consensusCfg); err != nil {
 			if errors.Cause(err) == validation.InvalidNonce {
 				removingTxs[tx.Hash()] = tx
 				continue
@@ -484,6 +740,10 @@ func (pool *TxPool) ResetTo(block *types.Block) {
 		if tx.Epoch > globalEpoch {
 			continue
 		}
+		if tx.Expired(block.Height() + 1) {
+			removingTxs[tx.Hash()] = tx
+			continue
+		}
 
 		sender, _ := types.Sender(tx)
 
@@ -545,7 +805,7 @@ func (pool *TxPool) createBuildingContext() *buildingContext {
 		}
 	}
 
-	return newBuildingContext(pool.appState, txs, priorityTxs, sortedTxsPerSender, curNoncesPerSender)
+	return newBuildingContext(pool.appState, pool.consensusCfg, txs, priorityTxs, sortedTxsPerSender, curNoncesPerSender)
 }
 
 func (pool *TxPool) StartSync() {
@@ -568,9 +828,11 @@ var (
 )
 
 type txMap struct {
-	mutex  sync.RWMutex
-	txs    map[common.Hash]*types.Transaction
-	maxTxs int
+	mutex   sync.RWMutex
+	txs     map[common.Hash]*types.Transaction
+	arrival map[common.Hash]uint64
+	seq     uint64
+	maxTxs  int
 }
 
 func (m *txMap) Get(hash common.Hash) (*types.Transaction, bool) {
@@ -589,7 +851,12 @@ func (m *txMap) Add(tx *types.Transaction) error {
 	if _, ok := priorityTypes[tx.Type]; !ok && m.Full() {
 		return setIsFullErr
 	}
-	m.txs[tx.Hash()] = tx
+	hash := tx.Hash()
+	if _, ok := m.txs[hash]; !ok {
+		m.seq++
+		m.arrival[hash] = m.seq
+	}
+	m.txs[hash] = tx
 	return nil
 }
 
@@ -601,6 +868,7 @@ func (m *txMap) Remove(hash common.Hash) {
 	m.mutex.Lock()
 	defer m.mutex.Unlock()
 	delete(m.txs, hash)
+	delete(m.arrival, hash)
 }
 
 func (m *txMap) Empty() bool {
@@ -637,10 +905,22 @@ func (m *txMap) List() []*types.Transaction {
 	return result
 }
 
+// ListByArrival returns txs in the order they were added, the oldest first
+func (m *txMap) ListByArrival() []*types.Transaction {
+	result := m.List()
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+	sort.SliceStable(result, func(i, j int) bool {
+		return m.arrival[result[i].Hash()] < m.arrival[result[j].Hash()]
+	})
+	return result
+}
+
 func newTxMap(maxTxs int) *txMap {
 	return &txMap{
-		txs:    make(map[common.Hash]*types.Transaction),
-		maxTxs: maxTxs,
+		txs:     make(map[common.Hash]*types.Transaction),
+		arrival: make(map[common.Hash]uint64),
+		maxTxs:  maxTxs,
 	}
 }
 
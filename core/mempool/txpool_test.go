@@ -9,10 +9,13 @@ import (
 	"github.com/idena-network/idena-go/core/appstate"
 	"github.com/idena-network/idena-go/crypto"
 	"github.com/idena-network/idena-go/secstore"
+	"github.com/idena-network/idena-go/tests"
 	"github.com/stretchr/testify/require"
 	"github.com/tendermint/tm-db"
 	"math/big"
+	"sync"
 	"testing"
+	"time"
 )
 
 func TestTxPool_addDeferredTx(t *testing.T) {
@@ -22,7 +25,7 @@ func TestTxPool_addDeferredTx(t *testing.T) {
 	key, _ := crypto.GenerateKey()
 	secStore := secstore.NewSecStore()
 	secStore.AddKey(crypto.FromECDSA(key))
-	pool := NewTxPool(appState, bus, &config.Mempool{TxPoolQueueSlots: -1, TxPoolAddrQueueLimit: -1})
+	pool := NewTxPool(appState, bus, &config.Mempool{TxPoolQueueSlots: -1, TxPoolAddrQueueLimit: -1}, config.GetDefaultConsensusConfig())
 	r := require.New(t)
 
 	key, _ = crypto.GenerateKey()
@@ -168,7 +171,7 @@ func TestTxPool_ResetTo(t *testing.T) {
 func getPool() *TxPool {
 	bus := eventbus.New()
 	appState := appstate.NewAppState(db.NewMemDB(), bus)
-	return NewTxPool(appState, bus, config.GetDefaultMempoolConfig())
+	return NewTxPool(appState, bus, config.GetDefaultMempoolConfig(), config.GetDefaultConsensusConfig())
 }
 
 func TestSortedTxs_Remove(t *testing.T) {
@@ -257,3 +260,395 @@ func TestTxMap_Sorted(t *testing.T) {
 	sorted = txMap.Sorted()
 	require.Equal(t, uint32(4), sorted[3].AccountNonce)
 }
+
+func TestTxPool_ResetToRemovesExpiredTxs(t *testing.T) {
+	pool := getPool()
+	key, _ := crypto.GenerateKey()
+	address := crypto.PubkeyToAddress(key.PublicKey)
+	pool.appState.State.SetBalance(address, new(big.Int).Mul(common.DnaBase, big.NewInt(100)))
+	pool.appState.Commit(nil)
+	pool.appState.Initialize(1)
+	pool.head = &types.Header{
+		EmptyBlockHeader: &types.EmptyBlockHeader{
+			Height: 1,
+		},
+	}
+
+	addTx := func(nonce uint32, maxBlock uint64) *types.Transaction {
+		tx := &types.Transaction{
+			AccountNonce: nonce,
+			To:           &address,
+			Type:         types.SendTx,
+			Amount:       big.NewInt(1),
+			MaxBlock:     maxBlock,
+		}
+		tx, _ = types.SignTx(tx, key)
+		require.NoError(t, pool.Add(tx))
+		return tx
+	}
+	expiring := addTx(1, 2)
+	persistent := addTx(2, 0)
+	require.Len(t, pool.all.txs, 2)
+
+	pool.appState.Commit(nil)
+	pool.ResetTo(&types.Block{
+		Header: &types.Header{ProposedHeader: &types.ProposedHeader{
+			Height: 2,
+		}},
+		Body: &types.Body{},
+	})
+
+	_, ok := pool.all.Get(expiring.Hash())
+	require.False(t, ok)
+	_, ok = pool.all.Get(persistent.Hash())
+	require.False(t, ok, "tx with broken nonce sequence should be removed")
+}
+
+func TestTxPool_ReplaceTx(t *testing.T) {
+	pool := getPool()
+	key, _ := crypto.GenerateKey()
+	address := crypto.PubkeyToAddress(key.PublicKey)
+	pool.appState.State.SetBalance(address, new(big.Int).Mul(common.DnaBase, big.NewInt(1000)))
+	pool.appState.Commit(nil)
+	pool.appState.Initialize(1)
+	pool.head = &types.Header{
+		EmptyBlockHeader: &types.EmptyBlockHeader{
+			Height: 1,
+		},
+	}
+
+	buildTx := func(nonce uint32, tips int64) *types.Transaction {
+		tx := &types.Transaction{
+			AccountNonce: nonce,
+			To:           &address,
+			Type:         types.SendTx,
+			Amount:       big.NewInt(1),
+			MaxFee:       new(big.Int).Mul(common.DnaBase, big.NewInt(1)),
+			Tips:         new(big.Int).Mul(common.DnaBase, big.NewInt(tips)),
+		}
+		tx, _ = types.SignTx(tx, key)
+		return tx
+	}
+
+	oldTx := buildTx(1, 100)
+	require.NoError(t, pool.Add(oldTx))
+	pendingTx := buildTx(3, 1)
+	require.NoError(t, pool.Add(pendingTx))
+
+	require.Equal(t, ErrNoTxToReplace, pool.ReplaceTx(buildTx(2, 2)))
+	require.Equal(t, ErrFeeBumpTooLow, pool.ReplaceTx(buildTx(1, 105)))
+
+	newTx := buildTx(1, 110)
+	require.NoError(t, pool.ReplaceTx(newTx))
+	require.Nil(t, pool.GetTx(oldTx.Hash()))
+	require.Equal(t, newTx, pool.GetTx(newTx.Hash()))
+	require.Equal(t, newTx, pool.executableTxs[address].txs[0])
+
+	newPendingTx := buildTx(3, 2)
+	require.NoError(t, pool.ReplaceTx(newPendingTx))
+	require.Nil(t, pool.GetTx(pendingTx.Hash()))
+	_, ok := pool.pendingTxs[address].Get(newPendingTx.Hash())
+	require.True(t, ok)
+	require.Len(t, pool.all.txs, 2)
+}
+
+func TestTxPool_BuildBlockTransactionsOrderedByTips(t *testing.T) {
+	pool := getPool()
+	keyA, _ := crypto.GenerateKey()
+	keyB, _ := crypto.GenerateKey()
+	for _, key := range []*ecdsa.PrivateKey{keyA, keyB} {
+		pool.appState.State.SetBalance(crypto.PubkeyToAddress(key.PublicKey), new(big.Int).Mul(common.DnaBase, big.NewInt(100)))
+	}
+	pool.appState.Commit(nil)
+	pool.appState.Initialize(1)
+	pool.head = &types.Header{
+		EmptyBlockHeader: &types.EmptyBlockHeader{
+			Height: 1,
+		},
+	}
+
+	addTx := func(key *ecdsa.PrivateKey, nonce uint32, tips int64) *types.Transaction {
+		address := crypto.PubkeyToAddress(key.PublicKey)
+		tx := &types.Transaction{
+			AccountNonce: nonce,
+			To:           &address,
+			Type:         types.SendTx,
+			Amount:       big.NewInt(1),
+			Tips:         big.NewInt(tips),
+		}
+		tx, _ = types.SignTx(tx, key)
+		require.NoError(t, pool.Add(tx))
+		return tx
+	}
+	a1 := addTx(keyA, 1, 1)
+	a2 := addTx(keyA, 2, 100)
+	b1 := addTx(keyB, 1, 10)
+	b2 := addTx(keyB, 2, 0)
+
+	var hashes []common.Hash
+	for _, tx := range pool.BuildBlockTransactions() {
+		hashes = append(hashes, tx.Hash())
+	}
+	require.Equal(t, []common.Hash{b1.Hash(), a1.Hash(), a2.Hash(), b2.Hash()}, hashes)
+}
+
+func TestTxPool_GetPendingByAddress(t *testing.T) {
+	pool := getPool()
+	key, _ := crypto.GenerateKey()
+	address := crypto.PubkeyToAddress(key.PublicKey)
+	pool.appState.State.SetBalance(address, new(big.Int).Mul(common.DnaBase, big.NewInt(1000)))
+	pool.appState.Commit(nil)
+	pool.appState.Initialize(1)
+	pool.head = &types.Header{
+		EmptyBlockHeader: &types.EmptyBlockHeader{
+			Height: 1,
+		},
+	}
+
+	list := pool.GetPendingByAddress(address)
+	require.NotNil(t, list)
+	require.Empty(t, list)
+
+	const count = 20
+	wg := sync.WaitGroup{}
+	errs := make(chan error, count)
+	for i := count; i > 0; i-- {
+		tx := &types.Transaction{
+			AccountNonce: uint32(i),
+			To:           &address,
+			Type:         types.SendTx,
+			Amount:       big.NewInt(1),
+			MaxFee:       new(big.Int).Mul(common.DnaBase, big.NewInt(1)),
+		}
+		tx, _ = types.SignTx(tx, key)
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs <- pool.Add(tx)
+		}()
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		require.NoError(t, err)
+	}
+
+	list = pool.GetPendingByAddress(address)
+	require.Len(t, list, count)
+	for i, tx := range list {
+		require.Equal(t, uint32(i+1), tx.AccountNonce)
+	}
+	require.Empty(t, pool.GetPendingByAddress(tests.GetRandAddr()))
+}
+
+func TestTxPool_SizeByType(t *testing.T) {
+	pool := getPool()
+	key, _ := crypto.GenerateKey()
+	address := crypto.PubkeyToAddress(key.PublicKey)
+	pool.appState.State.SetBalance(address, new(big.Int).Mul(common.DnaBase, big.NewInt(1000)))
+	pool.appState.State.SetInvites(address, 3)
+	pool.appState.Commit(nil)
+	pool.appState.Initialize(1)
+	pool.head = &types.Header{
+		EmptyBlockHeader: &types.EmptyBlockHeader{
+			Height: 1,
+		},
+	}
+
+	var txs []*types.Transaction
+	for i := 1; i <= 5; i++ {
+		txType := types.InviteTx
+		if i > 3 {
+			txType = types.SendTx
+		}
+		to := tests.GetRandAddr()
+		tx, _ := types.SignTx(&types.Transaction{
+			AccountNonce: uint32(i),
+			To:           &to,
+			Type:         txType,
+			Amount:       big.NewInt(1),
+			MaxFee:       new(big.Int).Mul(common.DnaBase, big.NewInt(1)),
+		}, key)
+		require.NoError(t, pool.Add(tx))
+		txs = append(txs, tx)
+	}
+	require.Equal(t, map[types.TxType]int{types.InviteTx: 3, types.SendTx: 2}, pool.SizeByType())
+	require.Equal(t, 5, pool.TotalSize())
+
+	resetTo := func(height uint64, nonce uint32, txs []*types.Transaction) {
+		pool.appState.State.SetNonce(address, nonce)
+		pool.appState.Commit(nil)
+		pool.ResetTo(&types.Block{
+			Header: &types.Header{ProposedHeader: &types.ProposedHeader{
+				Height: height,
+			}},
+			Body: &types.Body{Transactions: txs},
+		})
+	}
+
+	resetTo(2, 3, txs[:3])
+	require.Equal(t, map[types.TxType]int{types.SendTx: 2}, pool.SizeByType())
+	require.Equal(t, 2, pool.TotalSize())
+
+	resetTo(3, 5, txs[3:])
+	require.Empty(t, pool.SizeByType())
+	require.Zero(t, pool.TotalSize())
+}
+
+func TestTxPool_EvictByEpoch(t *testing.T) {
+	pool := getPool()
+	key, _ := crypto.GenerateKey()
+	key2, _ := crypto.GenerateKey()
+	address := crypto.PubkeyToAddress(key.PublicKey)
+	address2 := crypto.PubkeyToAddress(key2.PublicKey)
+	for _, addr := range []common.Address{address, address2} {
+		pool.appState.State.SetBalance(addr, new(big.Int).Mul(common.DnaBase, big.NewInt(1000)))
+	}
+	pool.appState.State.SetInvites(address2, 2)
+	pool.appState.State.IncEpoch()
+	pool.appState.Commit(nil)
+	pool.appState.Initialize(1)
+	pool.head = &types.Header{
+		EmptyBlockHeader: &types.EmptyBlockHeader{
+			Height: 1,
+		},
+	}
+
+	addTx := func(key *ecdsa.PrivateKey, txType types.TxType, epoch uint16, nonce uint32) {
+		to := tests.GetRandAddr()
+		tx, _ := types.SignTx(&types.Transaction{
+			AccountNonce: nonce,
+			Epoch:        epoch,
+			To:           &to,
+			Type:         txType,
+			Amount:       big.NewInt(1),
+			MaxFee:       new(big.Int).Mul(common.DnaBase, big.NewInt(1)),
+		}, key)
+		require.NoError(t, pool.Add(tx))
+	}
+	for i := uint32(1); i <= 3; i++ {
+		addTx(key, types.SendTx, 1, i)
+	}
+	for i := uint32(1); i <= 2; i++ {
+		addTx(key2, types.InviteTx, 1, i)
+	}
+
+	pool.appState.State.IncEpoch()
+	pool.appState.Commit(nil)
+	for i := uint32(1); i <= 3; i++ {
+		addTx(key, types.SendTx, 2, i)
+	}
+	require.Equal(t, 8, pool.TotalSize())
+
+	require.Equal(t, 5, pool.EvictByEpoch(2))
+	require.Equal(t, 3, pool.TotalSize())
+	require.Equal(t, map[types.TxType]int{types.SendTx: 3}, pool.SizeByType())
+	list := pool.GetPendingByAddress(address)
+	require.Len(t, list, 3)
+	for _, tx := range list {
+		require.Equal(t, uint16(2), tx.Epoch)
+	}
+	require.Empty(t, pool.GetPendingByAddress(address2))
+	require.NotContains(t, pool.executableTxs, address2)
+	require.NotContains(t, pool.pendingTxs, address2)
+
+	require.Zero(t, pool.EvictByEpoch(2))
+}
+
+func TestTxPool_Stats(t *testing.T) {
+	require := require.New(t)
+	pool := getPool()
+	key, _ := crypto.GenerateKey()
+	address := crypto.PubkeyToAddress(key.PublicKey)
+	pool.appState.State.SetBalance(address, new(big.Int).Mul(common.DnaBase, big.NewInt(1000)))
+	pool.appState.Commit(nil)
+	pool.appState.Initialize(1)
+	pool.head = &types.Header{
+		EmptyBlockHeader: &types.EmptyBlockHeader{
+			Height: 1,
+		},
+	}
+	now := time.Unix(1000, 0)
+	pool.now = func() time.Time {
+		return now
+	}
+
+	buildTx := func(nonce uint32) *types.Transaction {
+		tx := &types.Transaction{
+			AccountNonce: nonce,
+			To:           &address,
+			Type:         types.SendTx,
+			Amount:       big.NewInt(1),
+			MaxFee:       new(big.Int).Mul(common.DnaBase, big.NewInt(1)),
+		}
+		tx, _ = types.SignTx(tx, key)
+		return tx
+	}
+
+	stats := pool.Stats()
+	require.Zero(stats.PendingCount)
+	require.Zero(stats.OldestTxAge)
+	require.Equal(pool.maxSize(), stats.MaxCapacity)
+
+	first := buildTx(1)
+	require.NoError(pool.Add(first))
+	now = now.Add(time.Minute)
+	require.NoError(pool.Add(buildTx(3)))
+
+	stats = pool.Stats()
+	require.Equal(2, stats.PendingCount)
+	require.Equal(1, stats.QueuedCount)
+	require.Equal(time.Minute, stats.OldestTxAge)
+
+	prevAge := stats.OldestTxAge
+	for i := 0; i < 3; i++ {
+		now = now.Add(time.Second)
+		age := pool.Stats().OldestTxAge
+		require.True(age > prevAge)
+		prevAge = age
+	}
+
+	pool.Remove(first)
+	stats = pool.Stats()
+	require.Equal(1, stats.PendingCount)
+	require.Equal(3*time.Second, stats.OldestTxAge)
+
+	pool.cfg.TxPoolMaxSize = 1
+	require.Equal(1, pool.Stats().MaxCapacity)
+	require.Error(pool.Add(buildTx(2)))
+}
+
+func TestTxPool_AddStoresCopy(t *testing.T) {
+	require := require.New(t)
+	pool := getPool()
+	key, _ := crypto.GenerateKey()
+	address := crypto.PubkeyToAddress(key.PublicKey)
+	pool.appState.State.SetBalance(address, new(big.Int).Mul(common.DnaBase, big.NewInt(1000)))
+	pool.appState.Commit(nil)
+	pool.appState.Initialize(1)
+	pool.head = &types.Header{
+		EmptyBlockHeader: &types.EmptyBlockHeader{
+			Height: 1,
+		},
+	}
+
+	tx := &types.Transaction{
+		AccountNonce: 1,
+		To:           &address,
+		Type:         types.SendTx,
+		Amount:       big.NewInt(1),
+		MaxFee:       new(big.Int).Mul(common.DnaBase, big.NewInt(1)),
+	}
+	tx, _ = types.SignTx(tx, key)
+	hash := tx.Hash()
+	require.NoError(pool.Add(tx))
+
+	tx.Amount.SetInt64(100)
+	tx.Signature[0]++
+	stored := pool.GetTx(hash)
+	require.NotNil(stored)
+	require.Equal(big.NewInt(1), stored.Amount)
+	sender, err := types.Sender(stored)
+	require.NoError(err)
+	require.Equal(address, sender)
+}
@@ -0,0 +1,171 @@
+package state
+
+import (
+	"encoding/json"
+	"github.com/idena-network/idena-go/common"
+	"github.com/idena-network/idena-go/common/hexutil"
+	"github.com/pkg/errors"
+	"math/big"
+)
+
+var identityStateNames = map[IdentityState]string{
+	Undefined: "Undefined",
+	Invite:    "Invite",
+	Candidate: "Candidate",
+	Verified:  "Verified",
+	Suspended: "Suspended",
+	Killed:    "Killed",
+	Zombie:    "Zombie",
+	Newbie:    "Newbie",
+	Human:     "Human",
+}
+
+type identityJSON struct {
+	ProfileHash          hexutil.Bytes        `json:"profileHash,omitempty"`
+	Stake                string               `json:"stake"`
+	Invites              uint8                `json:"invites"`
+	Birthday             uint16               `json:"birthday"`
+	State                string               `json:"state"`
+	QualifiedFlips       uint32               `json:"qualifiedFlips"`
+	ShortFlipPoints      uint32               `json:"shortFlipPoints"`
+	PubKey               hexutil.Bytes        `json:"pubKey,omitempty"`
+	RequiredFlips        uint8                `json:"requiredFlips"`
+	Flips                []identityFlipJSON   `json:"flips,omitempty"`
+	Generation           uint32               `json:"generation"`
+	Code                 hexutil.Bytes        `json:"code,omitempty"`
+	Invitees             []txAddrJSON         `json:"invitees,omitempty"`
+	Inviter              *txAddrJSON          `json:"inviter,omitempty"`
+	Penalty              string               `json:"penalty"`
+	ValidationTxsBits    byte                 `json:"validationTxsBits"`
+	LastValidationStatus ValidationStatusFlag `json:"lastValidationStatus"`
+	Delegatee            *common.Address      `json:"delegatee,omitempty"`
+	CreatedAtBlock       uint64               `json:"createdAtBlock"`
+	FlipKeyHash          hexutil.Bytes        `json:"flipKeyHash,omitempty"`
+}
+
+type identityFlipJSON struct {
+	Cid  hexutil.Bytes `json:"cid"`
+	Pair uint8         `json:"pair"`
+}
+
+type txAddrJSON struct {
+	TxHash  common.Hash    `json:"txHash"`
+	Address common.Address `json:"address"`
+}
+
+// StateString returns the name of the identity state
+func (i *Identity) StateString() string {
+	if name, ok := identityStateNames[i.State]; ok {
+		return name
+	}
+	return identityStateNames[Undefined]
+}
+
+// MarshalJSON encodes the identity for API responses: addresses and hashes are hex strings,
+// amounts are decimal strings and the state is a name
+func (i Identity) MarshalJSON() ([]byte, error) {
+	res := identityJSON{
+		ProfileHash:          i.ProfileHash,
+		Stake:                bigIntString(i.Stake),
+		Invites:              i.Invites,
+		Birthday:             i.Birthday,
+		State:                i.StateString(),
+		QualifiedFlips:       i.QualifiedFlips,
+		ShortFlipPoints:      i.ShortFlipPoints,
+		PubKey:               i.PubKey,
+		RequiredFlips:        i.RequiredFlips,
+		Generation:           i.Generation,
+		Code:                 i.Code,
+		Penalty:              bigIntString(i.Penalty),
+		ValidationTxsBits:    i.ValidationTxsBits,
+		LastValidationStatus: i.LastValidationStatus,
+		Delegatee:            i.Delegatee,
+		CreatedAtBlock:       i.CreatedAtBlock,
+		FlipKeyHash:          i.FlipKeyHash,
+	}
+	for _, flip := range i.Flips {
+		res.Flips = append(res.Flips, identityFlipJSON{flip.Cid, flip.Pair})
+	}
+	for _, invitee := range i.Invitees {
+		res.Invitees = append(res.Invitees, txAddrJSON{invitee.TxHash, invitee.Address})
+	}
+	if i.Inviter != nil {
+		res.Inviter = &txAddrJSON{i.Inviter.TxHash, i.Inviter.Address}
+	}
+	return json.Marshal(res)
+}
+
+func (i *Identity) UnmarshalJSON(data []byte) error {
+	var res identityJSON
+	if err := json.Unmarshal(data, &res); err != nil {
+		return err
+	}
+	identityState, err := parseIdentityState(res.State)
+	if err != nil {
+		return err
+	}
+	stake, err := parseBigInt(res.Stake)
+	if err != nil {
+		return errors.WithMessage(err, "stake")
+	}
+	penalty, err := parseBigInt(res.Penalty)
+	if err != nil {
+		return errors.WithMessage(err, "penalty")
+	}
+	*i = Identity{
+		ProfileHash:          res.ProfileHash,
+		Stake:                stake,
+		Invites:              res.Invites,
+		Birthday:             res.Birthday,
+		State:                identityState,
+		QualifiedFlips:       res.QualifiedFlips,
+		ShortFlipPoints:      res.ShortFlipPoints,
+		PubKey:               res.PubKey,
+		RequiredFlips:        res.RequiredFlips,
+		Generation:           res.Generation,
+		Code:                 res.Code,
+		Penalty:              penalty,
+		ValidationTxsBits:    res.ValidationTxsBits,
+		LastValidationStatus: res.LastValidationStatus,
+		Delegatee:            res.Delegatee,
+		CreatedAtBlock:       res.CreatedAtBlock,
+		FlipKeyHash:          res.FlipKeyHash,
+	}
+	for _, flip := range res.Flips {
+		i.Flips = append(i.Flips, IdentityFlip{flip.Cid, flip.Pair})
+	}
+	for _, invitee := range res.Invitees {
+		i.Invitees = append(i.Invitees, TxAddr{invitee.TxHash, invitee.Address})
+	}
+	if res.Inviter != nil {
+		i.Inviter = &TxAddr{res.Inviter.TxHash, res.Inviter.Address}
+	}
+	return nil
+}
+
+func parseIdentityState(name string) (IdentityState, error) {
+	for identityState, stateName := range identityStateNames {
+		if stateName == name {
+			return identityState, nil
+		}
+	}
+	return Undefined, errors.Errorf("unknown identity state %q", name)
+}
+
+func bigIntString(value *big.Int) string {
+	if value == nil {
+		return "0"
+	}
+	return value.String()
+}
+
+func parseBigInt(value string) (*big.Int, error) {
+	if value == "" {
+		return nil, nil
+	}
+	res, ok := new(big.Int).SetString(value, 10)
+	if !ok {
+		return nil, errors.Errorf("invalid decimal %q", value)
+	}
+	return res, nil
+}
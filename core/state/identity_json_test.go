@@ -0,0 +1,60 @@
+package state
+
+import (
+	"encoding/json"
+	"github.com/idena-network/idena-go/common"
+	"github.com/stretchr/testify/require"
+	"math/big"
+	"strings"
+	"testing"
+)
+
+func TestIdentity_JSON(t *testing.T) {
+	require := require.New(t)
+	delegatee := getRandAddr()
+	inviter := getRandAddr()
+
+	for identityState, name := range identityStateNames {
+		identity := Identity{
+			ProfileHash:          []byte{0x1, 0x2},
+			Stake:                new(big.Int).Mul(common.DnaBase, big.NewInt(15)),
+			Invites:              3,
+			Birthday:             2,
+			State:                identityState,
+			QualifiedFlips:       10,
+			ShortFlipPoints:      7,
+			PubKey:               []byte{0x3},
+			RequiredFlips:        5,
+			Flips:                []IdentityFlip{{Cid: []byte{0x4}, Pair: 1}},
+			Generation:           4,
+			Invitees:             []TxAddr{{TxHash: common.Hash{0x5}, Address: getRandAddr()}},
+			Inviter:              &TxAddr{TxHash: common.Hash{0x6}, Address: inviter},
+			Penalty:              big.NewInt(100),
+			ValidationTxsBits:    3,
+			LastValidationStatus: AtLeastOneFlipReported,
+			Delegatee:            &delegatee,
+			CreatedAtBlock:       50,
+			FlipKeyHash:          []byte{0x7},
+		}
+		require.Equal(name, identity.StateString())
+
+		data, err := json.Marshal(identity)
+		require.NoError(err)
+
+		var fields map[string]interface{}
+		require.NoError(json.Unmarshal(data, &fields))
+		require.Equal(name, fields["state"])
+		require.Equal("15000000000000000000", fields["stake"])
+		require.Equal(float64(3), fields["invites"])
+		require.Equal(strings.ToLower(delegatee.Hex()), fields["delegatee"])
+		require.Equal(strings.ToLower(inviter.Hex()), fields["inviter"].(map[string]interface{})["address"])
+
+		restored := new(Identity)
+		require.NoError(json.Unmarshal(data, restored))
+		require.Equal(identity, *restored)
+	}
+
+	require.Equal("Undefined", (&Identity{State: 100}).StateString())
+	require.Error(json.Unmarshal([]byte(`{"state":"Unknown"}`), new(Identity)))
+	require.Error(json.Unmarshal([]byte(`{"state":"Verified","stake":"1.5"}`), new(Identity)))
+}
@@ -73,6 +73,26 @@ func (s *IdentityStateDB) ForCheck(height uint64) (*IdentityStateDB, error) {
 	}, nil
 }
 
+// Copy returns an independent state loaded at the current version with all changes which are not precommitted yet
+func (s *IdentityStateDB) Copy() (*IdentityStateDB, error) {
+	if s.tree.WorkingHash() != s.tree.Hash() {
+		return nil, ErrPrecommittedChanges
+	}
+	copied, err := s.ForCheck(uint64(s.tree.Version()))
+	if err != nil {
+		return nil, err
+	}
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	for addr := range s.stateIdentitiesDirty {
+		var data ApprovedIdentity
+		copyStateData(&s.stateIdentities[addr].data, &data)
+		copied.stateIdentities[addr] = newApprovedIdentityObject(addr, data, nil)
+		copied.stateIdentitiesDirty[addr] = struct{}{}
+	}
+	return copied, nil
+}
+
 func (s *IdentityStateDB) Readonly(height uint64) (*IdentityStateDB, error) {
 	tree := NewMutableTreeWithOpts(s.db, s.tree.RecentDb(), s.tree.KeepEvery(), s.tree.KeepRecent())
 	if _, err := tree.LazyLoad(int64(height)); err != nil {
@@ -314,6 +334,18 @@ func (s *IdentityStateDB) SetOnline(addr common.Address, online bool) {
 	s.GetOrNewIdentityObject(addr).SetOnline(online)
 }
 
+func (s *IdentityStateDB) SetDelegatee(addr common.Address, delegatee *common.Address) {
+	s.GetOrNewIdentityObject(addr).SetDelegatee(delegatee)
+}
+
+func (s *IdentityStateDB) Delegatee(addr common.Address) *common.Address {
+	stateObject := s.getStateIdentity(addr)
+	if stateObject != nil {
+		return stateObject.data.Delegatee
+	}
+	return nil
+}
+
 func (s *IdentityStateDB) ResetTo(height uint64) error {
 	s.Clear()
 	_, err := s.tree.LoadVersionForOverwriting(int64(height))
@@ -327,6 +359,15 @@ func (s *IdentityStateDB) IterateIdentities(fn func(key []byte, value []byte) bo
 	return s.tree.GetImmutable().IterateRange(nil, nil, true, fn)
 }
 
+func (s *IdentityStateDB) IterateAll(fn func(key []byte, value []byte) bool) bool {
+	return s.tree.GetImmutable().IterateRange(nil, nil, true, fn)
+}
+
+// SetRaw writes encoded entry directly to the identity state tree, it is used by state import only
+func (s *IdentityStateDB) SetRaw(key []byte, value []byte) {
+	s.tree.Set(key, value)
+}
+
 func (s *IdentityStateDB) Version() uint64 {
 	return uint64(s.tree.Version())
 }
@@ -0,0 +1,223 @@
+package state
+
+import (
+	"fmt"
+	"github.com/idena-network/idena-go/common"
+	"math/big"
+)
+
+type stateObjectData interface {
+	ToBytes() ([]byte, error)
+	FromBytes(data []byte) error
+}
+
+type revision struct {
+	id                   int
+	journalIndex         int
+	identityStateChanges int
+	mintedCoins          *big.Int
+	burntCoins           *big.Int
+}
+
+// journal keeps undo entries of live state objects touched since the oldest active snapshot,
+// every object is saved once per revision on its first access
+type journal struct {
+	entries   []func()
+	revisions []revision
+	nextId    int
+
+	savedAccounts        map[common.Address]int
+	savedIdentities      map[common.Address]int
+	savedGlobal          int
+	savedStatusSwitch    int
+	savedConsensusParams int
+}
+
+func (j *journal) active() bool {
+	return len(j.revisions) > 0
+}
+
+func (j *journal) currentId() int {
+	return j.revisions[len(j.revisions)-1].id
+}
+
+func copyStateObjectData(src, dst stateObjectData) {
+	data, err := src.ToBytes()
+	if err != nil {
+		panic(err)
+	}
+	if err := dst.FromBytes(data); err != nil {
+		panic(err)
+	}
+}
+
+// Snapshot returns an identifier of the current state which can be passed to RevertToSnapshot,
+// snapshots are invalidated by Precommit and Clear
+func (s *StateDB) Snapshot() int {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if s.journal.savedAccounts == nil {
+		s.journal.savedAccounts = make(map[common.Address]int)
+		s.journal.savedIdentities = make(map[common.Address]int)
+	}
+	s.journal.nextId++
+	s.journal.revisions = append(s.journal.revisions, revision{
+		id:                   s.journal.nextId,
+		journalIndex:         len(s.journal.entries),
+		identityStateChanges: len(s.identityStateChanges),
+		mintedCoins:          s.mintedCoins,
+		burntCoins:           s.burntCoins,
+	})
+	return s.journal.nextId
+}
+
+// RevertToSnapshot discards all changes of live objects made since the snapshot with the given id was taken
+func (s *StateDB) RevertToSnapshot(id int) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	idx := -1
+	for i := len(s.journal.revisions) - 1; i >= 0; i-- {
+		if s.journal.revisions[i].id == id {
+			idx = i
+			break
+		}
+	}
+	if idx < 0 {
+		panic(fmt.Sprintf("revision id %v cannot be reverted", id))
+	}
+	rev := s.journal.revisions[idx]
+	for i := len(s.journal.entries) - 1; i >= rev.journalIndex; i-- {
+		s.journal.entries[i]()
+	}
+	s.journal.entries = s.journal.entries[:rev.journalIndex]
+	s.journal.revisions = s.journal.revisions[:idx]
+	s.identityStateChanges = s.identityStateChanges[:rev.identityStateChanges]
+	s.mintedCoins, s.burntCoins = rev.mintedCoins, rev.burntCoins
+	if !s.journal.active() {
+		s.journal = journal{}
+	}
+}
+
+func (s *StateDB) journalAccount(addr common.Address) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if !s.journal.active() || s.journal.savedAccounts[addr] == s.journal.currentId() {
+		return
+	}
+	s.journal.savedAccounts[addr] = s.journal.currentId()
+	obj := s.stateAccounts[addr]
+	_, dirty := s.stateAccountsDirty[addr]
+	if obj == nil {
+		s.journal.entries = append(s.journal.entries, func() {
+			delete(s.stateAccounts, addr)
+			delete(s.stateAccountsDirty, addr)
+		})
+		return
+	}
+	var data Account
+	copyStateObjectData(&obj.data, &data)
+	deleted := obj.deleted
+	s.journal.entries = append(s.journal.entries, func() {
+		obj.data, obj.deleted = data, deleted
+		s.stateAccounts[addr] = obj
+		if !dirty {
+			delete(s.stateAccountsDirty, addr)
+		}
+	})
+}
+
+func (s *StateDB) journalIdentity(addr common.Address) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if !s.journal.active() || s.journal.savedIdentities[addr] == s.journal.currentId() {
+		return
+	}
+	s.journal.savedIdentities[addr] = s.journal.currentId()
+	obj := s.stateIdentities[addr]
+	_, dirty := s.stateIdentitiesDirty[addr]
+	if obj == nil {
+		s.journal.entries = append(s.journal.entries, func() {
+			delete(s.stateIdentities, addr)
+			delete(s.stateIdentitiesDirty, addr)
+		})
+		return
+	}
+	var data Identity
+	copyStateObjectData(&obj.data, &data)
+	deleted := obj.deleted
+	s.journal.entries = append(s.journal.entries, func() {
+		obj.data, obj.deleted = data, deleted
+		s.stateIdentities[addr] = obj
+		if !dirty {
+			delete(s.stateIdentitiesDirty, addr)
+		}
+	})
+}
+
+func (s *StateDB) journalGlobal() {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if !s.journal.active() || s.journal.savedGlobal == s.journal.currentId() {
+		return
+	}
+	s.journal.savedGlobal = s.journal.currentId()
+	obj, dirty := s.stateGlobal, s.stateGlobalDirty
+	if obj == nil {
+		s.journal.entries = append(s.journal.entries, func() {
+			s.stateGlobal, s.stateGlobalDirty = nil, false
+		})
+		return
+	}
+	var data Global
+	copyStateObjectData(&obj.data, &data)
+	s.journal.entries = append(s.journal.entries, func() {
+		obj.data = data
+		s.stateGlobal, s.stateGlobalDirty = obj, dirty
+	})
+}
+
+func (s *StateDB) journalStatusSwitch() {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if !s.journal.active() || s.journal.savedStatusSwitch == s.journal.currentId() {
+		return
+	}
+	s.journal.savedStatusSwitch = s.journal.currentId()
+	obj, dirty := s.stateStatusSwitch, s.stateStatusSwitchDirty
+	if obj == nil {
+		s.journal.entries = append(s.journal.entries, func() {
+			s.stateStatusSwitch, s.stateStatusSwitchDirty = nil, false
+		})
+		return
+	}
+	var data IdentityStatusSwitch
+	copyStateObjectData(&obj.data, &data)
+	deleted := obj.deleted
+	s.journal.entries = append(s.journal.entries, func() {
+		obj.data, obj.deleted = data, deleted
+		s.stateStatusSwitch, s.stateStatusSwitchDirty = obj, dirty
+	})
+}
+
+func (s *StateDB) journalConsensusParams() {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if !s.journal.active() || s.journal.savedConsensusParams == s.journal.currentId() {
+		return
+	}
+	s.journal.savedConsensusParams = s.journal.currentId()
+	obj, dirty := s.stateConsensusParams, s.stateConsensusParamsDirty
+	if obj == nil {
+		s.journal.entries = append(s.journal.entries, func() {
+			s.stateConsensusParams, s.stateConsensusParamsDirty = nil, false
+		})
+		return
+	}
+	var data ConsensusParams
+	copyStateObjectData(&obj.data, &data)
+	deleted := obj.deleted
+	s.journal.entries = append(s.journal.entries, func() {
+		obj.data, obj.deleted = data, deleted
+		s.stateConsensusParams, s.stateConsensusParamsDirty = obj, dirty
+	})
+}
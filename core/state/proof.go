@@ -0,0 +1,56 @@
+package state
+
+import (
+	"bytes"
+	"github.com/idena-network/idena-go/common"
+	"github.com/pkg/errors"
+	"github.com/tendermint/iavl"
+	"github.com/tendermint/tendermint/crypto/merkle"
+)
+
+var (
+	ErrIdentityNotFound = errors.New("identity is not found")
+	ErrInvalidProof     = errors.New("merkle proof is invalid")
+)
+
+// GenerateMerkleProof returns inclusion proof of identity against state root,
+// proof consists of encoded identity and encoded iavl value operation
+func (s *StateDB) GenerateMerkleProof(addr common.Address) ([][]byte, error) {
+	key := append(identityPrefix, addr[:]...)
+	value, proof, err := s.tree.GetImmutable().GetWithProof(key)
+	if err != nil {
+		return nil, err
+	}
+	if value == nil {
+		return nil, ErrIdentityNotFound
+	}
+	op := iavl.NewValueOp(key, proof).ProofOp()
+	return [][]byte{value, op.Data}, nil
+}
+
+// VerifyMerkleProof checks identity proof generated by GenerateMerkleProof against given state root
+func VerifyMerkleProof(root common.Hash, addr common.Address, proof [][]byte) (Identity, error) {
+	var identity Identity
+	if len(proof) != 2 {
+		return identity, ErrInvalidProof
+	}
+	op, err := iavl.ValueOpDecoder(merkle.ProofOp{
+		Type: iavl.ProofOpIAVLValue,
+		Key:  append(identityPrefix, addr[:]...),
+		Data: proof[1],
+	})
+	if err != nil {
+		return identity, errors.Wrap(ErrInvalidProof, err.Error())
+	}
+	res, err := op.Run([][]byte{proof[0]})
+	if err != nil {
+		return identity, errors.Wrap(ErrInvalidProof, err.Error())
+	}
+	if len(res) != 1 || !bytes.Equal(res[0], root[:]) {
+		return identity, errors.Wrap(ErrInvalidProof, "root mismatch")
+	}
+	if err := identity.FromBytes(proof[0]); err != nil {
+		return identity, errors.Wrap(ErrInvalidProof, err.Error())
+	}
+	return identity, nil
+}
@@ -9,6 +9,7 @@ import (
 	models "github.com/idena-network/idena-go/protobuf"
 	math2 "math"
 	"math/big"
+	"sort"
 )
 
 type IdentityState uint8
@@ -27,6 +28,9 @@ const (
 	MaxInvitesAmount    = math.MaxUint8
 	EmptyBlocksBitsSize = 25
 
+	// Number of latest blocks the recent empty blocks are counted over
+	RecentBlocksWindow = 100
+
 	AdditionalVerifiedFlips = 1
 	AdditionalHumanFlips    = 2
 
@@ -85,6 +89,20 @@ type stateStatusSwitch struct {
 	onDirty func()
 }
 
+type stateConsensusParams struct {
+	data ConsensusParams
+
+	deleted bool
+	onDirty func()
+}
+
+type IdentityStateChange struct {
+	Address  common.Address
+	OldState IdentityState
+	NewState IdentityState
+	TxHash   common.Hash
+}
+
 type ValidationPeriod uint32
 
 const (
@@ -118,6 +136,63 @@ func (s *IdentityStatusSwitch) FromBytes(data []byte) error {
 	return nil
 }
 
+// ConsensusParams keeps consensus params changed by governance and votes of the epoch for their changes,
+// params are sorted by key, votes are sorted by key and voter
+type ConsensusParams struct {
+	Params []ConsensusParam
+	Votes  []ConsensusParamVote
+}
+
+type ConsensusParam struct {
+	Key   string
+	Value []byte
+}
+
+type ConsensusParamVote struct {
+	Key   string
+	Value []byte
+	Voter common.Address
+}
+
+func (s *ConsensusParams) ToBytes() ([]byte, error) {
+	protoObj := new(models.ProtoStateConsensusParams)
+	for _, item := range s.Params {
+		protoObj.Params = append(protoObj.Params, &models.ProtoStateConsensusParams_Param{
+			Key:   item.Key,
+			Value: item.Value,
+		})
+	}
+	for _, item := range s.Votes {
+		protoObj.Votes = append(protoObj.Votes, &models.ProtoStateConsensusParams_Vote{
+			Key:   item.Key,
+			Value: item.Value,
+			Voter: item.Voter[:],
+		})
+	}
+	return proto.Marshal(protoObj)
+}
+
+func (s *ConsensusParams) FromBytes(data []byte) error {
+	protoObj := new(models.ProtoStateConsensusParams)
+	if err := proto.Unmarshal(data, protoObj); err != nil {
+		return err
+	}
+	for _, item := range protoObj.Params {
+		s.Params = append(s.Params, ConsensusParam{
+			Key:   item.Key,
+			Value: item.Value,
+		})
+	}
+	for _, item := range protoObj.Votes {
+		s.Votes = append(s.Votes, ConsensusParamVote{
+			Key:   item.Key,
+			Value: item.Value,
+			Voter: common.BytesToAddress(item.Voter),
+		})
+	}
+	return nil
+}
+
 type Global struct {
 	Epoch                         uint16
 	NextValidationTime            int64
@@ -131,6 +206,17 @@ type Global struct {
 	EmptyBlocksBits               *big.Int
 	GodAddressInvites             uint16
 	BlocksCntWithoutCeremonialTxs byte
+	PendingWithdrawals            []PendingWithdrawal `rlp:"nil"`
+	ConsecutiveEmptyBlocks        uint32
+	RecentEmptyBlocksBits         *big.Int
+	CommitteeRewardPool           *big.Int
+	PatchVersion                  uint32
+}
+
+type PendingWithdrawal struct {
+	Addr        common.Address
+	Amount      *big.Int
+	UnlockBlock uint64
 }
 
 func (s *Global) ToBytes() ([]byte, error) {
@@ -147,6 +233,17 @@ func (s *Global) ToBytes() ([]byte, error) {
 		EmptyBlocksBits:               common.BigIntBytesOrNil(s.EmptyBlocksBits),
 		GodAddressInvites:             uint32(s.GodAddressInvites),
 		BlocksCntWithoutCeremonialTxs: uint32(s.BlocksCntWithoutCeremonialTxs),
+		ConsecutiveEmptyBlocks:        s.ConsecutiveEmptyBlocks,
+		RecentEmptyBlocksBits:         common.BigIntBytesOrNil(s.RecentEmptyBlocksBits),
+		CommitteeRewardPool:           common.BigIntBytesOrNil(s.CommitteeRewardPool),
+		PatchVersion:                  s.PatchVersion,
+	}
+	for _, item := range s.PendingWithdrawals {
+		protoAnswer.PendingWithdrawals = append(protoAnswer.PendingWithdrawals, &models.ProtoStateGlobal_PendingWithdrawal{
+			Address:     item.Addr[:],
+			Amount:      common.BigIntBytesOrNil(item.Amount),
+			UnlockBlock: item.UnlockBlock,
+		})
 	}
 	return proto.Marshal(protoAnswer)
 }
@@ -169,15 +266,33 @@ func (s *Global) FromBytes(data []byte) error {
 	s.EmptyBlocksBits = common.BigIntOrNil(protoGlobal.EmptyBlocksBits)
 	s.GodAddressInvites = uint16(protoGlobal.GodAddressInvites)
 	s.BlocksCntWithoutCeremonialTxs = byte(protoGlobal.BlocksCntWithoutCeremonialTxs)
+	s.ConsecutiveEmptyBlocks = protoGlobal.ConsecutiveEmptyBlocks
+	s.RecentEmptyBlocksBits = common.BigIntOrNil(protoGlobal.RecentEmptyBlocksBits)
+	s.CommitteeRewardPool = common.BigIntOrNil(protoGlobal.CommitteeRewardPool)
+	s.PatchVersion = protoGlobal.PatchVersion
+	for _, item := range protoGlobal.PendingWithdrawals {
+		s.PendingWithdrawals = append(s.PendingWithdrawals, PendingWithdrawal{
+			Addr:        common.BytesToAddress(item.Address),
+			Amount:      common.BigIntOrNil(item.Amount),
+			UnlockBlock: item.UnlockBlock,
+		})
+	}
 	return nil
 }
 
 // Account is the Idena consensus representation of accounts.
 // These objects are stored in the main account trie.
 type Account struct {
-	Nonce   uint32
-	Epoch   uint16
-	Balance *big.Int
+	Nonce          uint32
+	Epoch          uint16
+	Balance        *big.Int
+	PendingRewards []PendingReward
+}
+
+// PendingReward is a reward which was not delivered to the account in the epoch and can be claimed by ClaimRewardsTx
+type PendingReward struct {
+	Amount *big.Int
+	Epoch  uint16
 }
 
 func (a *Account) ToBytes() ([]byte, error) {
@@ -186,6 +301,12 @@ func (a *Account) ToBytes() ([]byte, error) {
 		Epoch:   uint32(a.Epoch),
 		Balance: common.BigIntBytesOrNil(a.Balance),
 	}
+	for _, item := range a.PendingRewards {
+		protoAcc.PendingRewards = append(protoAcc.PendingRewards, &models.ProtoStateAccount_PendingReward{
+			Amount: common.BigIntBytesOrNil(item.Amount),
+			Epoch:  uint32(item.Epoch),
+		})
+	}
 	return proto.Marshal(protoAcc)
 }
 
@@ -197,6 +318,12 @@ func (a *Account) FromBytes(data []byte) error {
 	a.Balance = common.BigIntOrNil(protoAcc.Balance)
 	a.Epoch = uint16(protoAcc.Epoch)
 	a.Nonce = protoAcc.Nonce
+	for _, item := range protoAcc.PendingRewards {
+		a.PendingRewards = append(a.PendingRewards, PendingReward{
+			Amount: common.BigIntOrNil(item.Amount),
+			Epoch:  uint16(item.Epoch),
+		})
+	}
 	return nil
 }
 
@@ -213,6 +340,16 @@ const (
 	AllFlipsNotQualified
 )
 
+// StakeSource identifies the kind of reward credited to the identity stake
+type StakeSource uint8
+
+const (
+	StakeFromBlockRewards StakeSource = iota
+	StakeFromFeeRewards
+	StakeFromCommitteeRewards
+	StakeFromInvites
+)
+
 type Identity struct {
 	ProfileHash    []byte `rlp:"nil"`
 	Stake          *big.Int
@@ -232,6 +369,21 @@ type Identity struct {
 	Penalty              *big.Int
 	ValidationTxsBits    byte
 	LastValidationStatus ValidationStatusFlag
+	// identity voting in committee on behalf of this identity during current epoch
+	Delegatee *common.Address `rlp:"nil"`
+	// height of the block the identity was invited at, zero for identities invited before it was tracked
+	CreatedAtBlock uint64
+	// hash of the flip key submitted by SubmitFlipKeyTx during current epoch
+	FlipKeyHash []byte `rlp:"nil"`
+	// cumulative stake credited by each tracked source, stake withdrawals and penalties do not decrease them
+	StakeFromBlockRewards     *big.Int
+	StakeFromFeeRewards       *big.Int
+	StakeFromCommitteeRewards *big.Int
+	StakeFromInvites          *big.Int
+	// coins transferred to the identity by InviteTx, only this amount is refunded to the inviter of an unused invite
+	InviteAmount *big.Int
+	// count of identities delegating to this identity during current epoch
+	Delegators uint32
 }
 
 type TxAddr struct {
@@ -255,6 +407,15 @@ func (i *Identity) ToBytes() ([]byte, error) {
 		ValidationBits:   uint32(i.ValidationTxsBits),
 		ValidationStatus: uint32(i.LastValidationStatus),
 		ProfileHash:      i.ProfileHash,
+		CreatedAtBlock:   i.CreatedAtBlock,
+		FlipKeyHash:      i.FlipKeyHash,
+
+		StakeFromBlockRewards:     common.BigIntBytesOrNil(i.StakeFromBlockRewards),
+		StakeFromFeeRewards:       common.BigIntBytesOrNil(i.StakeFromFeeRewards),
+		StakeFromCommitteeRewards: common.BigIntBytesOrNil(i.StakeFromCommitteeRewards),
+		StakeFromInvites:          common.BigIntBytesOrNil(i.StakeFromInvites),
+		InviteAmount:              common.BigIntBytesOrNil(i.InviteAmount),
+		Delegators:                i.Delegators,
 	}
 	for idx := range i.Flips {
 		protoIdentity.Flips = append(protoIdentity.Flips, &models.ProtoStateIdentity_Flip{
@@ -274,6 +435,9 @@ func (i *Identity) ToBytes() ([]byte, error) {
 			Address: i.Inviter.Address[:],
 		}
 	}
+	if i.Delegatee != nil {
+		protoIdentity.Delegatee = i.Delegatee.Bytes()
+	}
 	return proto.Marshal(protoIdentity)
 }
 
@@ -296,6 +460,14 @@ func (i *Identity) FromBytes(data []byte) error {
 	i.ValidationTxsBits = byte(protoIdentity.ValidationBits)
 	i.LastValidationStatus = ValidationStatusFlag(protoIdentity.ValidationStatus)
 	i.ProfileHash = protoIdentity.ProfileHash
+	i.CreatedAtBlock = protoIdentity.CreatedAtBlock
+	i.FlipKeyHash = protoIdentity.FlipKeyHash
+	i.StakeFromBlockRewards = common.BigIntOrNil(protoIdentity.StakeFromBlockRewards)
+	i.StakeFromFeeRewards = common.BigIntOrNil(protoIdentity.StakeFromFeeRewards)
+	i.StakeFromCommitteeRewards = common.BigIntOrNil(protoIdentity.StakeFromCommitteeRewards)
+	i.StakeFromInvites = common.BigIntOrNil(protoIdentity.StakeFromInvites)
+	i.InviteAmount = common.BigIntOrNil(protoIdentity.InviteAmount)
+	i.Delegators = protoIdentity.Delegators
 
 	for idx := range protoIdentity.Flips {
 		i.Flips = append(i.Flips, IdentityFlip{
@@ -318,6 +490,11 @@ func (i *Identity) FromBytes(data []byte) error {
 		}
 	}
 
+	if len(protoIdentity.Delegatee) > 0 {
+		delegatee := common.BytesToAddress(protoIdentity.Delegatee)
+		i.Delegatee = &delegatee
+	}
+
 	return nil
 }
 
@@ -351,8 +528,9 @@ func (i *Identity) GetMaximumAvailableFlips() uint8 {
 }
 
 type ApprovedIdentity struct {
-	Approved bool
-	Online   bool
+	Approved  bool
+	Online    bool
+	Delegatee *common.Address
 }
 
 func (s *ApprovedIdentity) ToBytes() ([]byte, error) {
@@ -360,6 +538,9 @@ func (s *ApprovedIdentity) ToBytes() ([]byte, error) {
 		Approved: s.Approved,
 		Online:   s.Online,
 	}
+	if s.Delegatee != nil {
+		protoAnswer.Delegatee = s.Delegatee.Bytes()
+	}
 	return proto.Marshal(protoAnswer)
 }
 
@@ -370,6 +551,10 @@ func (s *ApprovedIdentity) FromBytes(data []byte) error {
 	}
 	s.Approved = protoIdentity.Approved
 	s.Online = protoIdentity.Online
+	if len(protoIdentity.Delegatee) > 0 {
+		delegatee := common.BytesToAddress(protoIdentity.Delegatee)
+		s.Delegatee = &delegatee
+	}
 	return nil
 }
 
@@ -411,6 +596,13 @@ func newStatusSwitchObject(data IdentityStatusSwitch, onDirty func()) *stateStat
 	}
 }
 
+func newConsensusParamsObject(data ConsensusParams, onDirty func()) *stateConsensusParams {
+	return &stateConsensusParams{
+		data:    data,
+		onDirty: onDirty,
+	}
+}
+
 func newApprovedIdentityObject(address common.Address, data ApprovedIdentity, onDirty func(addr common.Address)) *stateApprovedIdentity {
 	return &stateApprovedIdentity{
 		address: address,
@@ -465,7 +657,7 @@ func (s *stateAccount) deepCopy(db *StateDB, onDirty func(addr common.Address))
 
 // empty returns whether the account is considered empty.
 func (s *stateAccount) empty() bool {
-	return s.Balance().Sign() == 0 && s.data.Nonce == 0
+	return s.Balance().Sign() == 0 && s.data.Nonce == 0 && len(s.data.PendingRewards) == 0
 }
 
 // Returns the address of the contract/account
@@ -506,6 +698,35 @@ func (s *stateAccount) Epoch() uint16 {
 	return s.data.Epoch
 }
 
+func (s *stateAccount) PendingRewards() []PendingReward {
+	return s.data.PendingRewards
+}
+
+// AddPendingReward accumulates the amount in the entry of the epoch
+func (s *stateAccount) AddPendingReward(amount *big.Int, epoch uint16) {
+	rewards := make([]PendingReward, 0, len(s.data.PendingRewards)+1)
+	added := false
+	for _, item := range s.data.PendingRewards {
+		if item.Epoch == epoch {
+			item.Amount = new(big.Int).Add(item.Amount, amount)
+			added = true
+		}
+		rewards = append(rewards, item)
+	}
+	if !added {
+		rewards = append(rewards, PendingReward{
+			Amount: new(big.Int).Set(amount),
+			Epoch:  epoch,
+		})
+	}
+	s.SetPendingRewards(rewards)
+}
+
+func (s *stateAccount) SetPendingRewards(rewards []PendingReward) {
+	s.data.PendingRewards = rewards
+	s.touch()
+}
+
 // Returns the address of the contract/account
 func (s *stateIdentity) Address() common.Address {
 	return s.address
@@ -672,11 +893,70 @@ func (s *stateIdentity) GetInviter() *TxAddr {
 	return s.data.Inviter
 }
 
+func (s *stateIdentity) SetCreatedAtBlock(height uint64) {
+	s.data.CreatedAtBlock = height
+	s.touch()
+}
+
+func (s *stateIdentity) SetInviteAmount(amount *big.Int) {
+	s.data.InviteAmount = amount
+	s.touch()
+}
+
+func (s *stateIdentity) SetFlipKeyHash(hash []byte) {
+	s.data.FlipKeyHash = hash
+	s.touch()
+}
+
+func (s *stateIdentity) AddStakeFromSource(amount *big.Int, source StakeSource) {
+	var counter **big.Int
+	switch source {
+	case StakeFromBlockRewards:
+		counter = &s.data.StakeFromBlockRewards
+	case StakeFromFeeRewards:
+		counter = &s.data.StakeFromFeeRewards
+	case StakeFromCommitteeRewards:
+		counter = &s.data.StakeFromCommitteeRewards
+	case StakeFromInvites:
+		counter = &s.data.StakeFromInvites
+	default:
+		return
+	}
+	if *counter == nil {
+		*counter = new(big.Int)
+	}
+	*counter = new(big.Int).Add(*counter, amount)
+	s.touch()
+}
+
 func (s *stateIdentity) ResetInviter() {
 	s.data.Inviter = nil
 	s.touch()
 }
 
+func (s *stateIdentity) SetDelegatee(delegatee common.Address) {
+	s.data.Delegatee = &delegatee
+	s.touch()
+}
+
+func (s *stateIdentity) GetDelegatee() *common.Address {
+	return s.data.Delegatee
+}
+
+func (s *stateIdentity) ResetDelegatee() {
+	s.data.Delegatee = nil
+	s.touch()
+}
+
+func (s *stateIdentity) Delegators() uint32 {
+	return s.data.Delegators
+}
+
+func (s *stateIdentity) SetDelegators(count uint32) {
+	s.data.Delegators = count
+	s.touch()
+}
+
 func (s *stateIdentity) AddInvitee(address common.Address, txHash common.Hash) {
 	s.data.Invitees = append(s.data.Invitees, TxAddr{
 		TxHash:  txHash,
@@ -803,6 +1083,35 @@ func (s *stateGlobal) AddBlockBit(empty bool) {
 	s.touch()
 }
 
+// AddRecentBlockBit shifts the window of the latest RecentBlocksWindow blocks, set bits mark empty blocks
+func (s *stateGlobal) AddRecentBlockBit(empty bool) {
+	bits := new(big.Int)
+	if s.data.RecentEmptyBlocksBits != nil {
+		bits.Lsh(s.data.RecentEmptyBlocksBits, 1)
+	}
+	if empty {
+		bits.SetBit(bits, 0, 1)
+	}
+	bits.SetBit(bits, RecentBlocksWindow, 0)
+	s.data.RecentEmptyBlocksBits = bits
+	s.touch()
+}
+
+func (s *stateGlobal) RecentEmptyBlocksCount() int {
+	if s.data.RecentEmptyBlocksBits == nil {
+		return 0
+	}
+	cnt := 0
+	for i := 0; i < RecentBlocksWindow; i++ {
+		cnt += int(s.data.RecentEmptyBlocksBits.Bit(i))
+	}
+	return cnt
+}
+
+func (s *stateGlobal) RecentEmptyBlocksBits() *big.Int {
+	return s.data.RecentEmptyBlocksBits
+}
+
 func (s *stateGlobal) EmptyBlocksCount() int {
 	bits := s.EmptyBlocksBits()
 	cnt := 0
@@ -912,6 +1221,41 @@ func (s *stateGlobal) SetGodAddressInvites(count uint16) {
 	s.touch()
 }
 
+func (s *stateGlobal) PendingWithdrawals() []PendingWithdrawal {
+	return s.data.PendingWithdrawals
+}
+
+func (s *stateGlobal) AddPendingWithdrawal(withdrawal PendingWithdrawal) {
+	s.data.PendingWithdrawals = append(s.data.PendingWithdrawals, withdrawal)
+	s.touch()
+}
+
+func (s *stateGlobal) SetPendingWithdrawals(withdrawals []PendingWithdrawal) {
+	s.data.PendingWithdrawals = withdrawals
+	s.touch()
+}
+
+func (s *stateGlobal) CommitteeRewardPool() *big.Int {
+	if s.data.CommitteeRewardPool == nil {
+		return big.NewInt(0)
+	}
+	return s.data.CommitteeRewardPool
+}
+
+func (s *stateGlobal) SetCommitteeRewardPool(amount *big.Int) {
+	s.data.CommitteeRewardPool = amount
+	s.touch()
+}
+
+func (s *stateGlobal) PatchVersion() uint32 {
+	return s.data.PatchVersion
+}
+
+func (s *stateGlobal) SetPatchVersion(version uint32) {
+	s.data.PatchVersion = version
+	s.touch()
+}
+
 func (s *stateGlobal) BlocksCntWithoutCeremonialTxs() byte {
 	return s.data.BlocksCntWithoutCeremonialTxs
 }
@@ -926,6 +1270,20 @@ func (s *stateGlobal) ResetBlocksCntWithoutCeremonialTxs() {
 	s.touch()
 }
 
+func (s *stateGlobal) ConsecutiveEmptyBlocks() uint32 {
+	return s.data.ConsecutiveEmptyBlocks
+}
+
+func (s *stateGlobal) IncConsecutiveEmptyBlocks() {
+	s.data.ConsecutiveEmptyBlocks++
+	s.touch()
+}
+
+func (s *stateGlobal) ResetConsecutiveEmptyBlocks() {
+	s.data.ConsecutiveEmptyBlocks = 0
+	s.touch()
+}
+
 func (s *stateApprovedIdentity) Address() common.Address {
 	return s.address
 }
@@ -956,6 +1314,11 @@ func (s *stateApprovedIdentity) SetOnline(online bool) {
 	s.touch()
 }
 
+func (s *stateApprovedIdentity) SetDelegatee(delegatee *common.Address) {
+	s.data.Delegatee = delegatee
+	s.touch()
+}
+
 func IsCeremonyCandidate(identity Identity) bool {
 	state := identity.State
 	return (state == Candidate || state.NewbieOrBetter() || state == Suspended ||
@@ -1001,6 +1364,69 @@ func (s *stateStatusSwitch) touch() {
 	}
 }
 
+func (s *stateConsensusParams) empty() bool {
+	return len(s.data.Params) == 0 && len(s.data.Votes) == 0
+}
+
+func (s *stateConsensusParams) Params() []ConsensusParam {
+	return s.data.Params
+}
+
+func (s *stateConsensusParams) SetParam(key string, value []byte) {
+	defer s.touch()
+	idx := sort.Search(len(s.data.Params), func(i int) bool {
+		return s.data.Params[i].Key >= key
+	})
+	if idx < len(s.data.Params) && s.data.Params[idx].Key == key {
+		s.data.Params[idx].Value = value
+		return
+	}
+	s.data.Params = append(s.data.Params, ConsensusParam{})
+	copy(s.data.Params[idx+1:], s.data.Params[idx:])
+	s.data.Params[idx] = ConsensusParam{Key: key, Value: value}
+}
+
+func (s *stateConsensusParams) Votes() []ConsensusParamVote {
+	return s.data.Votes
+}
+
+// SetVote replaces the previous vote of the voter for the param
+func (s *stateConsensusParams) SetVote(key string, value []byte, voter common.Address) {
+	defer s.touch()
+	notBefore := func(i int) bool {
+		vote := s.data.Votes[i]
+		return vote.Key > key || vote.Key == key && bytes.Compare(vote.Voter[:], voter[:]) >= 0
+	}
+	idx := sort.Search(len(s.data.Votes), notBefore)
+	if idx < len(s.data.Votes) && s.data.Votes[idx].Key == key && s.data.Votes[idx].Voter == voter {
+		s.data.Votes[idx].Value = value
+		return
+	}
+	s.data.Votes = append(s.data.Votes, ConsensusParamVote{})
+	copy(s.data.Votes[idx+1:], s.data.Votes[idx:])
+	s.data.Votes[idx] = ConsensusParamVote{Key: key, Value: value, Voter: voter}
+}
+
+// RemoveVotes removes votes matching the filter
+func (s *stateConsensusParams) RemoveVotes(filter func(vote ConsensusParamVote) bool) {
+	var rest []ConsensusParamVote
+	for _, vote := range s.data.Votes {
+		if !filter(vote) {
+			rest = append(rest, vote)
+		}
+	}
+	if len(rest) != len(s.data.Votes) {
+		s.data.Votes = rest
+		s.touch()
+	}
+}
+
+func (s *stateConsensusParams) touch() {
+	if s.onDirty != nil {
+		s.onDirty()
+	}
+}
+
 func (f ValidationStatusFlag) HasFlag(flag ValidationStatusFlag) bool {
 	return f&flag != 0
 }
@@ -58,6 +58,7 @@ var (
 	identityPrefix                      = []byte("i")
 	globalKey                           = []byte("global")
 	statusSwitchKey                     = []byte("status-switch")
+	consensusParamsKey                  = []byte("consensus-params")
 	currentStateDbPrefixKey             = []byte("statedb-prefix")
 	currentIdentityStateDbPrefixKey     = []byte("id-statedb-prefix")
 	preliminaryIdentityStateDbPrefixKey = []byte("pre-id-statedb-prefix")
@@ -79,6 +80,19 @@ type StateDB struct {
 	stateStatusSwitch      *stateStatusSwitch
 	stateStatusSwitchDirty bool
 
+	stateConsensusParams      *stateConsensusParams
+	stateConsensusParamsDirty bool
+
+	// identity state changes since the last commit, changes made by txs are marked with txHash
+	txHash               common.Hash
+	identityStateChanges []*IdentityStateChange
+
+	// coins minted and burnt since the last commit, they are not part of the state, the chain keeps their totals in the repo
+	mintedCoins *big.Int
+	burntCoins  *big.Int
+
+	journal journal
+
 	log  log.Logger
 	lock sync.Mutex
 }
@@ -132,6 +146,72 @@ func (s *StateDB) ForCheck(height uint64) (*StateDB, error) {
 	}, nil
 }
 
+// ErrPrecommittedChanges is returned by Copy, changes written to the working tree can't be copied
+var ErrPrecommittedChanges = errors.New("state has precommitted changes")
+
+// Copy returns an independent state loaded at the current version with all changes which are not precommitted yet
+func (s *StateDB) Copy() (*StateDB, error) {
+	if s.tree.WorkingHash() != s.tree.Hash() {
+		return nil, ErrPrecommittedChanges
+	}
+	copied, err := s.ForCheck(uint64(s.tree.Version()))
+	if err != nil {
+		return nil, err
+	}
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	for addr := range s.stateAccountsDirty {
+		var data Account
+		copyStateData(&s.stateAccounts[addr].data, &data)
+		copied.stateAccounts[addr] = newAccountObject(addr, data, nil)
+		copied.stateAccountsDirty[addr] = struct{}{}
+	}
+	for addr := range s.stateIdentitiesDirty {
+		var data Identity
+		copyStateData(&s.stateIdentities[addr].data, &data)
+		copied.stateIdentities[addr] = newIdentityObject(addr, data, nil)
+		copied.stateIdentitiesDirty[addr] = struct{}{}
+	}
+	if s.stateGlobalDirty {
+		var data Global
+		copyStateData(&s.stateGlobal.data, &data)
+		copied.stateGlobal = newGlobalObject(data, nil)
+		copied.stateGlobalDirty = true
+	}
+	if s.stateStatusSwitchDirty {
+		var data IdentityStatusSwitch
+		copyStateData(&s.stateStatusSwitch.data, &data)
+		copied.stateStatusSwitch = newStatusSwitchObject(data, nil)
+		copied.stateStatusSwitchDirty = true
+	}
+	if s.stateConsensusParamsDirty {
+		var data ConsensusParams
+		copyStateData(&s.stateConsensusParams.data, &data)
+		copied.stateConsensusParams = newConsensusParamsObject(data, nil)
+		copied.stateConsensusParamsDirty = true
+	}
+	copied.txHash = s.txHash
+	copied.identityStateChanges = append([]*IdentityStateChange(nil), s.identityStateChanges...)
+	copied.mintedCoins, copied.burntCoins = s.mintedCoins, s.burntCoins
+	return copied, nil
+}
+
+type stateData interface {
+	ToBytes() ([]byte, error)
+	FromBytes(data []byte) error
+}
+
+// copyStateData deep copies src to dst through the same encoding the state tree uses
+func copyStateData(src, dst stateData) {
+	data, err := src.ToBytes()
+	if err != nil {
+		panic(fmt.Errorf("can't encode state object, %v", err))
+	}
+	if err := dst.FromBytes(data); err != nil {
+		panic(fmt.Errorf("can't decode state object, %v", err))
+	}
+}
+
 func (s *StateDB) Readonly(height int64) (*StateDB, error) {
 	tree := NewMutableTreeWithOpts(s.db, s.tree.RecentDb(), s.tree.KeepEvery(), s.tree.KeepRecent())
 	if _, err := tree.LazyLoad(height); err != nil {
@@ -164,6 +244,12 @@ func (s *StateDB) Clear() {
 	s.stateGlobalDirty = false
 	s.stateStatusSwitch = nil
 	s.stateStatusSwitchDirty = false
+	s.stateConsensusParams = nil
+	s.stateConsensusParamsDirty = false
+	s.txHash = common.Hash{}
+	s.identityStateChanges = nil
+	s.mintedCoins, s.burntCoins = nil, nil
+	s.journal = journal{}
 }
 
 func (s *StateDB) Version() int64 {
@@ -297,12 +383,39 @@ func (s *StateDB) AddStake(address common.Address, intStake *big.Int) {
 	s.GetOrNewIdentityObject(address).AddStake(intStake)
 }
 
+// AddStakeWithSource adds stake like AddStake and accounts the amount to the given source
+func (s *StateDB) AddStakeWithSource(address common.Address, amount *big.Int, source StakeSource) {
+	stateObject := s.GetOrNewIdentityObject(address)
+	stateObject.AddStake(amount)
+	if amount.Sign() != 0 {
+		stateObject.AddStakeFromSource(amount, source)
+	}
+}
+
 func (s *StateDB) SubStake(addr common.Address, amount *big.Int) {
 	s.GetOrNewIdentityObject(addr).SubStake(amount)
 }
 
 func (s *StateDB) SetState(address common.Address, state IdentityState) {
-	s.GetOrNewIdentityObject(address).SetState(state)
+	stateObject := s.GetOrNewIdentityObject(address)
+	if prevState := stateObject.State(); prevState != state {
+		s.identityStateChanges = append(s.identityStateChanges, &IdentityStateChange{
+			Address:  address,
+			OldState: prevState,
+			NewState: state,
+			TxHash:   s.txHash,
+		})
+	}
+	stateObject.SetState(state)
+}
+
+// SetTxHash sets hash of the tx being applied, the hash is attached to identity state changes
+func (s *StateDB) SetTxHash(hash common.Hash) {
+	s.txHash = hash
+}
+
+func (s *StateDB) IdentityStateChanges() []*IdentityStateChange {
+	return s.identityStateChanges
 }
 
 func (s *StateDB) SetGeneticCode(address common.Address, generation uint32, code []byte) {
@@ -373,10 +486,72 @@ func (s *StateDB) GetInviter(address common.Address) *TxAddr {
 	return s.GetOrNewIdentityObject(address).GetInviter()
 }
 
+func (s *StateDB) SetCreatedAtBlock(address common.Address, height uint64) {
+	s.GetOrNewIdentityObject(address).SetCreatedAtBlock(height)
+}
+
+func (s *StateDB) GetInviteAmount(address common.Address) *big.Int {
+	stateObject := s.getStateIdentity(address)
+	if stateObject != nil {
+		return stateObject.data.InviteAmount
+	}
+	return nil
+}
+
+func (s *StateDB) SetInviteAmount(address common.Address, amount *big.Int) {
+	s.GetOrNewIdentityObject(address).SetInviteAmount(amount)
+}
+
+func (s *StateDB) GetFlipKeyHash(address common.Address) []byte {
+	stateObject := s.getStateIdentity(address)
+	if stateObject != nil {
+		return stateObject.data.FlipKeyHash
+	}
+	return nil
+}
+
+func (s *StateDB) SetFlipKeyHash(address common.Address, hash []byte) {
+	s.GetOrNewIdentityObject(address).SetFlipKeyHash(hash)
+}
+
 func (s *StateDB) ResetInviter(address common.Address) {
 	s.GetOrNewIdentityObject(address).ResetInviter()
 }
 
+// SetDelegatee records the delegatee of the address and keeps delegator counts of the previous and the new delegatee
+func (s *StateDB) SetDelegatee(address, delegatee common.Address) {
+	s.ResetDelegatee(address)
+	s.GetOrNewIdentityObject(address).SetDelegatee(delegatee)
+	obj := s.GetOrNewIdentityObject(delegatee)
+	obj.SetDelegators(obj.Delegators() + 1)
+}
+
+func (s *StateDB) GetDelegatee(address common.Address) *common.Address {
+	return s.GetOrNewIdentityObject(address).GetDelegatee()
+}
+
+func (s *StateDB) ResetDelegatee(address common.Address) {
+	obj := s.GetOrNewIdentityObject(address)
+	delegatee := obj.GetDelegatee()
+	if delegatee == nil {
+		return
+	}
+	obj.ResetDelegatee()
+	if delegateeObj := s.GetOrNewIdentityObject(*delegatee); delegateeObj.Delegators() > 0 {
+		delegateeObj.SetDelegators(delegateeObj.Delegators() - 1)
+	}
+}
+
+// IsDelegatee returns true if some identity delegates to the address, unlike the validators cache it includes
+// delegations of the current block
+func (s *StateDB) IsDelegatee(address common.Address) bool {
+	return s.GetOrNewIdentityObject(address).Delegators() > 0
+}
+
+func (s *StateDB) ResetDelegators(address common.Address) {
+	s.GetOrNewIdentityObject(address).SetDelegators(0)
+}
+
 func (s *StateDB) AddInvitee(address, inviteeAddress common.Address, txHash common.Hash) {
 	s.GetOrNewIdentityObject(address).AddInvitee(inviteeAddress, txHash)
 }
@@ -453,6 +628,14 @@ func (s *StateDB) AddBlockBit(empty bool) {
 	s.GetOrNewGlobalObject().AddBlockBit(empty)
 }
 
+func (s *StateDB) AddRecentBlockBit(empty bool) {
+	s.GetOrNewGlobalObject().AddRecentBlockBit(empty)
+}
+
+func (s *StateDB) RecentEmptyBlocksCount() int {
+	return s.GetOrNewGlobalObject().RecentEmptyBlocksCount()
+}
+
 func (s *StateDB) EmptyBlocksCount() int {
 	return s.GetOrNewGlobalObject().EmptyBlocksCount()
 }
@@ -493,6 +676,108 @@ func (s *StateDB) SetGodAddressInvites(count uint16) {
 	s.GetOrNewGlobalObject().SetGodAddressInvites(count)
 }
 
+func (s *StateDB) PendingWithdrawals() []PendingWithdrawal {
+	return s.GetOrNewGlobalObject().PendingWithdrawals()
+}
+
+func (s *StateDB) AddPendingWithdrawal(addr common.Address, amount *big.Int, unlockBlock uint64) {
+	s.GetOrNewGlobalObject().AddPendingWithdrawal(PendingWithdrawal{
+		Addr:        addr,
+		Amount:      amount,
+		UnlockBlock: unlockBlock,
+	})
+}
+
+func (s *StateDB) HasPendingWithdrawal(addr common.Address) bool {
+	for _, item := range s.PendingWithdrawals() {
+		if item.Addr == addr {
+			return true
+		}
+	}
+	return false
+}
+
+// ReleasePendingWithdrawals removes withdrawals unlocked at given height and returns them
+func (s *StateDB) ReleasePendingWithdrawals(height uint64) []PendingWithdrawal {
+	var released, locked []PendingWithdrawal
+	for _, item := range s.PendingWithdrawals() {
+		if item.UnlockBlock <= height {
+			released = append(released, item)
+		} else {
+			locked = append(locked, item)
+		}
+	}
+	if len(released) > 0 {
+		s.GetOrNewGlobalObject().SetPendingWithdrawals(locked)
+	}
+	return released
+}
+
+func (s *StateDB) AddPendingReward(addr common.Address, amount *big.Int, epoch uint16) {
+	s.GetOrNewAccountObject(addr).AddPendingReward(amount, epoch)
+}
+
+// GetPendingReward returns the sum of pending rewards of the address
+func (s *StateDB) GetPendingReward(addr common.Address) *big.Int {
+	res := big.NewInt(0)
+	stateObject := s.getStateAccount(addr)
+	if stateObject == nil {
+		return res
+	}
+	for _, item := range stateObject.PendingRewards() {
+		res.Add(res, item.Amount)
+	}
+	return res
+}
+
+// ClaimPendingRewards removes pending rewards of the address and returns their sum
+func (s *StateDB) ClaimPendingRewards(addr common.Address) *big.Int {
+	claimed := s.GetPendingReward(addr)
+	if claimed.Sign() > 0 {
+		s.GetOrNewAccountObject(addr).SetPendingRewards(nil)
+	}
+	return claimed
+}
+
+// ExpirePendingRewards removes pending rewards added before the epoch from all accounts and returns their sum
+func (s *StateDB) ExpirePendingRewards(epoch uint16) *big.Int {
+	expired := big.NewInt(0)
+	s.IterateOverAccounts(func(addr common.Address, account Account) {
+		if len(account.PendingRewards) == 0 {
+			return
+		}
+		var rest []PendingReward
+		for _, item := range account.PendingRewards {
+			if item.Epoch < epoch {
+				expired.Add(expired, item.Amount)
+			} else {
+				rest = append(rest, item)
+			}
+		}
+		if len(rest) != len(account.PendingRewards) {
+			s.GetOrNewAccountObject(addr).SetPendingRewards(rest)
+		}
+	})
+	return expired
+}
+
+func (s *StateDB) CommitteeRewardPool() *big.Int {
+	return s.GetOrNewGlobalObject().CommitteeRewardPool()
+}
+
+func (s *StateDB) SetCommitteeRewardPool(amount *big.Int) {
+	s.GetOrNewGlobalObject().SetCommitteeRewardPool(amount)
+}
+
+// PatchVersion returns version of the last applied genesis patch
+func (s *StateDB) PatchVersion() uint32 {
+	return s.GetOrNewGlobalObject().PatchVersion()
+}
+
+func (s *StateDB) SetPatchVersion(version uint32) {
+	s.GetOrNewGlobalObject().SetPatchVersion(version)
+}
+
 func (s *StateDB) BlocksCntWithoutCeremonialTxs() byte {
 	return s.GetOrNewGlobalObject().BlocksCntWithoutCeremonialTxs()
 }
@@ -505,6 +790,18 @@ func (s *StateDB) ResetBlocksCntWithoutCeremonialTxs() {
 	s.GetOrNewGlobalObject().ResetBlocksCntWithoutCeremonialTxs()
 }
 
+func (s *StateDB) ConsecutiveEmptyBlocks() uint32 {
+	return s.GetOrNewGlobalObject().ConsecutiveEmptyBlocks()
+}
+
+func (s *StateDB) IncConsecutiveEmptyBlocks() {
+	s.GetOrNewGlobalObject().IncConsecutiveEmptyBlocks()
+}
+
+func (s *StateDB) ResetConsecutiveEmptyBlocks() {
+	s.GetOrNewGlobalObject().ResetConsecutiveEmptyBlocks()
+}
+
 //
 // Setting, updating & deleting state object methods
 //
@@ -551,6 +848,15 @@ func (s *StateDB) updateStateStatusSwitchObject(stateObject *stateStatusSwitch)
 	s.tree.Set(statusSwitchKey, data)
 }
 
+func (s *StateDB) updateStateConsensusParamsObject(stateObject *stateConsensusParams) {
+	data, err := stateObject.data.ToBytes()
+	if err != nil {
+		panic(fmt.Errorf("can't encode consensus params object, %v", err))
+	}
+
+	s.tree.Set(consensusParamsKey, data)
+}
+
 // deleteStateAccountObject removes the given object from the state trie.
 func (s *StateDB) deleteStateAccountObject(stateObject *stateAccount) {
 	stateObject.deleted = true
@@ -575,6 +881,7 @@ func (s *StateDB) deleteStateStatusSwitchObject(stateObject *stateStatusSwitch)
 
 // Retrieve a state account given my the address. Returns nil if not found.
 func (s *StateDB) getStateAccount(addr common.Address) (stateObject *stateAccount) {
+	s.journalAccount(addr)
 	// Prefer 'live' objects.
 	s.lock.Lock()
 	if obj := s.stateAccounts[addr]; obj != nil {
@@ -603,6 +910,7 @@ func (s *StateDB) getStateAccount(addr common.Address) (stateObject *stateAccoun
 
 // Retrieve a state account given my the address. Returns nil if not found.
 func (s *StateDB) getStateIdentity(addr common.Address) (stateObject *stateIdentity) {
+	s.journalIdentity(addr)
 	// Prefer 'live' objects.
 	s.lock.Lock()
 	if obj := s.stateIdentities[addr]; obj != nil {
@@ -632,6 +940,7 @@ func (s *StateDB) getStateIdentity(addr common.Address) (stateObject *stateIdent
 
 // Retrieve a state account given my the address. Returns nil if not found.
 func (s *StateDB) getStateGlobal() (stateObject *stateGlobal) {
+	s.journalGlobal()
 	// Prefer 'live' objects.
 	if obj := s.stateGlobal; obj != nil {
 		return obj
@@ -654,6 +963,7 @@ func (s *StateDB) getStateGlobal() (stateObject *stateGlobal) {
 }
 
 func (s *StateDB) getStateStatusSwitch() (stateObject *stateStatusSwitch) {
+	s.journalStatusSwitch()
 	// Prefer 'live' objects.
 	if obj := s.stateStatusSwitch; obj != nil {
 		if obj.deleted {
@@ -678,6 +988,32 @@ func (s *StateDB) getStateStatusSwitch() (stateObject *stateStatusSwitch) {
 	return obj
 }
 
+func (s *StateDB) getStateConsensusParams() (stateObject *stateConsensusParams) {
+	s.journalConsensusParams()
+	// Prefer 'live' objects.
+	if obj := s.stateConsensusParams; obj != nil {
+		if obj.deleted {
+			return nil
+		}
+		return obj
+	}
+
+	// Load the object from the database.
+	_, enc := s.tree.Get(consensusParamsKey)
+	if len(enc) == 0 {
+		return nil
+	}
+	var data ConsensusParams
+	if err := data.FromBytes(enc); err != nil {
+		s.log.Error("Failed to decode state consensus params object", "err", err)
+		return nil
+	}
+	// Insert into the live set.
+	obj := newConsensusParamsObject(data, s.MarkStateConsensusParamsObjectDirty)
+	s.setStateConsensusParamsObject(obj)
+	return obj
+}
+
 func (s *StateDB) setStateAccountObject(object *stateAccount) {
 	s.lock.Lock()
 	defer s.lock.Unlock()
@@ -706,6 +1042,13 @@ func (s *StateDB) setStateStatusSwitchObject(object *stateStatusSwitch) {
 	s.stateStatusSwitch = object
 }
 
+func (s *StateDB) setStateConsensusParamsObject(object *stateConsensusParams) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.stateConsensusParams = object
+}
+
 // Retrieve a state object or create a new state object if nil
 func (s *StateDB) GetOrNewAccountObject(addr common.Address) *stateAccount {
 	stateObject := s.getStateAccount(addr)
@@ -741,6 +1084,14 @@ func (s *StateDB) GetOrNewStatusSwitchObject() *stateStatusSwitch {
 	return stateObject
 }
 
+func (s *StateDB) GetOrNewConsensusParamsObject() *stateConsensusParams {
+	stateObject := s.getStateConsensusParams()
+	if stateObject == nil || stateObject.deleted {
+		stateObject = s.createConsensusParams()
+	}
+	return stateObject
+}
+
 // MarkStateAccountObjectDirty adds the specified object to the dirty map to avoid costly
 // state object cache iteration to find a handful of modified ones.
 func (s *StateDB) MarkStateAccountObjectDirty(addr common.Address) {
@@ -775,6 +1126,13 @@ func (s *StateDB) MarkStateStatusSwitchObjectDirty() {
 	s.stateStatusSwitchDirty = true
 }
 
+func (s *StateDB) MarkStateConsensusParamsObjectDirty() {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.stateConsensusParamsDirty = true
+}
+
 func (s *StateDB) createAccount(addr common.Address) (newobj, prev *stateAccount) {
 	prev = s.getStateAccount(addr)
 	newobj = newAccountObject(addr, Account{}, s.MarkStateAccountObjectDirty)
@@ -805,6 +1163,12 @@ func (s *StateDB) createStatusSwitch() *stateStatusSwitch {
 	return stateObject
 }
 
+func (s *StateDB) createConsensusParams() *stateConsensusParams {
+	stateObject := newConsensusParamsObject(ConsensusParams{}, s.MarkStateConsensusParamsObjectDirty)
+	s.setStateConsensusParamsObject(stateObject)
+	return stateObject
+}
+
 // Commit writes the state to the underlying in-memory trie database.
 func (s *StateDB) Commit(deleteEmptyObjects bool) (root []byte, version int64, err error) {
 	s.Precommit(deleteEmptyObjects)
@@ -841,6 +1205,7 @@ func (s *StateDB) CommitTree(newVersion int64) (root []byte, version int64, err
 
 func (s *StateDB) Precommit(deleteEmptyObjects bool) {
 	s.lock.Lock()
+	s.journal = journal{}
 	// Commit account objects to the trie.
 	for _, addr := range getOrderedObjectsKeys(s.stateAccountsDirty) {
 		stateObject := s.stateAccounts[addr]
@@ -877,6 +1242,13 @@ func (s *StateDB) Precommit(deleteEmptyObjects bool) {
 		}
 		s.stateStatusSwitchDirty = false
 	}
+
+	if s.stateConsensusParamsDirty {
+		if !s.stateConsensusParams.empty() {
+			s.updateStateConsensusParamsObject(s.stateConsensusParams)
+		}
+		s.stateConsensusParamsDirty = false
+	}
 }
 
 func (s *StateDB) Reset() {
@@ -911,6 +1283,16 @@ func (s *StateDB) IterateIdentities(fn func(key []byte, value []byte) bool) bool
 	return s.tree.GetImmutable().IterateRange(start, end, true, fn)
 }
 
+// IterateAll iterates over all entries of the state tree including global objects
+func (s *StateDB) IterateAll(fn func(key []byte, value []byte) bool) bool {
+	return s.tree.GetImmutable().IterateRange(nil, nil, true, fn)
+}
+
+// SetRaw writes encoded entry directly to the state tree, it is used by state import only
+func (s *StateDB) SetRaw(key []byte, value []byte) {
+	s.tree.Set(key, value)
+}
+
 func (s *StateDB) IterateAccounts(fn func(key []byte, value []byte) bool) bool {
 	start := append(addressPrefix, common.MinAddr...)
 	end := append(addressPrefix, common.MaxAddr...)
@@ -1019,6 +1401,71 @@ func (s *StateDB) IterateOverAccounts(callback func(addr common.Address, account
 	})
 }
 
+// IterateBalances calls fn for every address holding a balance or an identity stake, stakes of killed identities
+// are burnt and reported as zero, iteration stops when fn returns true
+func (s *StateDB) IterateBalances(fn func(addr common.Address, balance, stake *big.Int) bool) {
+	type holdings struct {
+		balance, stake *big.Int
+	}
+	byAddr := make(map[common.Address]*holdings)
+	get := func(addr common.Address) *holdings {
+		h, ok := byAddr[addr]
+		if !ok {
+			h = &holdings{balance: big.NewInt(0), stake: big.NewInt(0)}
+			byAddr[addr] = h
+		}
+		return h
+	}
+	s.IterateOverAccounts(func(addr common.Address, account Account) {
+		if account.Balance != nil {
+			get(addr).balance = new(big.Int).Set(account.Balance)
+		}
+	})
+	s.IterateOverIdentities(func(addr common.Address, identity Identity) {
+		if identity.Stake != nil && identity.State != Killed {
+			get(addr).stake = new(big.Int).Set(identity.Stake)
+		}
+	})
+	addrs := make([]common.Address, 0, len(byAddr))
+	for addr := range byAddr {
+		addrs = append(addrs, addr)
+	}
+	sort.Slice(addrs, func(i, j int) bool {
+		return bytes.Compare(addrs[i][:], addrs[j][:]) < 0
+	})
+	for _, addr := range addrs {
+		if fn(addr, byAddr[addr].balance, byAddr[addr].stake) {
+			return
+		}
+	}
+}
+
+// SupplyChange returns amounts of coins issued and destroyed by the protocol since the last commit
+func (s *StateDB) SupplyChange() (minted, burnt *big.Int) {
+	return bigIntCopyOrZero(s.mintedCoins), bigIntCopyOrZero(s.burntCoins)
+}
+
+func (s *StateDB) AddMintedCoins(amount *big.Int) {
+	if amount == nil || amount.Sign() == 0 {
+		return
+	}
+	s.mintedCoins = new(big.Int).Add(bigIntCopyOrZero(s.mintedCoins), amount)
+}
+
+func (s *StateDB) AddBurntCoins(amount *big.Int) {
+	if amount == nil || amount.Sign() == 0 {
+		return
+	}
+	s.burntCoins = new(big.Int).Add(bigIntCopyOrZero(s.burntCoins), amount)
+}
+
+func bigIntCopyOrZero(value *big.Int) *big.Int {
+	if value == nil {
+		return new(big.Int)
+	}
+	return new(big.Int).Set(value)
+}
+
 func loadPrefix(db dbm.DB) []byte {
 	p, _ := db.Get(currentStateDbPrefixKey)
 	if p == nil {
@@ -1183,6 +1630,8 @@ func (s *StateDB) SetPredefinedGlobal(state *models.ProtoPredefinedState) {
 	stateObject.data.EmptyBlocksBits = common.BigIntOrNil(state.Global.EmptyBlocksBits)
 	stateObject.data.GodAddressInvites = uint16(state.Global.GodAddressInvites)
 	stateObject.data.BlocksCntWithoutCeremonialTxs = byte(state.Global.BlocksCntWithoutCeremonialTxs)
+	stateObject.data.ConsecutiveEmptyBlocks = state.Global.ConsecutiveEmptyBlocks
+	stateObject.data.RecentEmptyBlocksBits = common.BigIntOrNil(state.Global.RecentEmptyBlocksBits)
 }
 
 func (s *StateDB) SetPredefinedStatusSwitch(state *models.ProtoPredefinedState) {
@@ -1262,6 +1711,36 @@ func (s *StateDB) SwitchTree(keepEvery, keepRecent int64) error {
 	return nil
 }
 
+func (s *StateDB) SetConsensusParam(key string, value []byte) {
+	s.GetOrNewConsensusParamsObject().SetParam(key, value)
+}
+
+func (s *StateDB) ConsensusParams() []ConsensusParam {
+	return s.GetOrNewConsensusParamsObject().Params()
+}
+
+func (s *StateDB) SetConsensusParamVote(key string, value []byte, voter common.Address) {
+	s.GetOrNewConsensusParamsObject().SetVote(key, value, voter)
+}
+
+func (s *StateDB) ConsensusParamVotes() []ConsensusParamVote {
+	return s.GetOrNewConsensusParamsObject().Votes()
+}
+
+// ClearConsensusParamVotes removes votes for the param
+func (s *StateDB) ClearConsensusParamVotes(key string) {
+	s.GetOrNewConsensusParamsObject().RemoveVotes(func(vote ConsensusParamVote) bool {
+		return vote.Key == key
+	})
+}
+
+// ClearAllConsensusParamVotes removes votes for all params
+func (s *StateDB) ClearAllConsensusParamVotes() {
+	s.GetOrNewConsensusParamsObject().RemoveVotes(func(vote ConsensusParamVote) bool {
+		return true
+	})
+}
+
 func (s *StateDB) HasStatusSwitchAddresses(addr common.Address) bool {
 	statusSwitch := s.GetOrNewStatusSwitchObject()
 	return statusSwitch.HasAddress(addr)
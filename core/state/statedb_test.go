@@ -194,6 +194,102 @@ func TestStateDB_GetOrNewIdentityObject(t *testing.T) {
 	require.Equal(t, Verified, fromDb.State())
 }
 
+func TestStateDB_SetInviter(t *testing.T) {
+	require := require.New(t)
+	stateDb := NewLazy(db.NewMemDB())
+
+	key, _ := crypto.GenerateKey()
+	addr := crypto.PubkeyToAddress(key.PublicKey)
+	inviterKey, _ := crypto.GenerateKey()
+	inviter := crypto.PubkeyToAddress(inviterKey.PublicKey)
+	txHash := common.Hash{0x1}
+
+	stateDb.SetState(addr, Invite)
+	stateDb.Commit(false)
+	rootWithoutInviter := stateDb.Root()
+
+	stateDb.SetInviter(addr, inviter, txHash)
+	stateDb.Commit(false)
+	require.NotEqual(rootWithoutInviter, stateDb.Root())
+	stateDb.Clear()
+
+	require.Equal(&TxAddr{TxHash: txHash, Address: inviter}, stateDb.GetInviter(addr))
+}
+
+func TestStateDB_RevertToSnapshot(t *testing.T) {
+	require := require.New(t)
+	stateDb := NewLazy(db.NewMemDB())
+
+	key, _ := crypto.GenerateKey()
+	addr := crypto.PubkeyToAddress(key.PublicKey)
+	key2, _ := crypto.GenerateKey()
+	addr2 := crypto.PubkeyToAddress(key2.PublicKey)
+
+	stateDb.SetBalance(addr, big.NewInt(100))
+	stateDb.SetState(addr, Candidate)
+	stateDb.Commit(false)
+	root := stateDb.Root()
+
+	first := stateDb.Snapshot()
+	stateDb.SetBalance(addr, big.NewInt(50))
+	stateDb.SetNonce(addr, 1)
+	stateDb.SetState(addr, Verified)
+
+	second := stateDb.Snapshot()
+	stateDb.SetBalance(addr, big.NewInt(10))
+	stateDb.IncEpoch()
+	stateDb.SetBalance(addr2, big.NewInt(1))
+	stateDb.SetState(addr2, Invite)
+
+	stateDb.RevertToSnapshot(second)
+	require.Equal(big.NewInt(50), stateDb.GetBalance(addr))
+	require.Equal(uint32(1), stateDb.GetNonce(addr))
+	require.Equal(Verified, stateDb.GetIdentityState(addr))
+	require.Equal(uint16(0), stateDb.Epoch())
+	require.Equal(big.NewInt(0), stateDb.GetBalance(addr2))
+	require.Equal(Undefined, stateDb.GetIdentityState(addr2))
+	require.Len(stateDb.IdentityStateChanges(), 1)
+
+	stateDb.RevertToSnapshot(first)
+	require.Equal(big.NewInt(100), stateDb.GetBalance(addr))
+	require.Equal(uint32(0), stateDb.GetNonce(addr))
+	require.Equal(Candidate, stateDb.GetIdentityState(addr))
+	require.Empty(stateDb.IdentityStateChanges())
+	require.Panics(func() {
+		stateDb.RevertToSnapshot(second)
+	})
+
+	stateDb.Commit(false)
+	require.Equal(root, stateDb.Root())
+}
+
+func TestStateDB_Copy(t *testing.T) {
+	require := require.New(t)
+	stateDb := NewLazy(db.NewMemDB())
+	addr := common.Address{0x1}
+	stateDb.SetBalance(addr, big.NewInt(10))
+	stateDb.Commit(true)
+
+	stateDb.SetBalance(addr, big.NewInt(20))
+	stateDb.IncEpoch()
+	copied, err := stateDb.Copy()
+	require.NoError(err)
+	require.Equal(stateDb.Version(), copied.Version())
+	require.Equal(big.NewInt(20), copied.GetBalance(addr))
+	require.Equal(stateDb.Epoch(), copied.Epoch())
+
+	copied.SetBalance(addr, big.NewInt(30))
+	require.Equal(big.NewInt(20), stateDb.GetBalance(addr))
+
+	stateDb.Precommit(true)
+	copied.SetBalance(addr, big.NewInt(20))
+	copied.Precommit(true)
+	require.Equal(stateDb.Root(), copied.Root())
+
+	_, err = stateDb.Copy()
+	require.Equal(ErrPrecommittedChanges, err)
+}
+
 func TestStateGlobal_IncEpoch(t *testing.T) {
 	database := db.NewMemDB()
 	stateDb := NewLazy(database)
@@ -247,6 +343,39 @@ func TestStateGlobal_EmptyBlocksRatio(t *testing.T) {
 	require.Len(t, stateDb.GetOrNewGlobalObject().data.EmptyBlocksBits.Bytes(), 4)
 }
 
+func TestStateDB_PendingRewards(t *testing.T) {
+	require := require.New(t)
+	database := db.NewMemDB()
+	stateDb := NewLazy(database)
+
+	addr, addr2 := common.Address{0x1}, common.Address{0x2}
+	stateDb.AddPendingReward(addr, big.NewInt(10), 1)
+	stateDb.AddPendingReward(addr, big.NewInt(5), 1)
+	stateDb.AddPendingReward(addr, big.NewInt(7), 2)
+	stateDb.AddPendingReward(addr2, big.NewInt(3), 1)
+	_, _, err := stateDb.Commit(true)
+	require.NoError(err)
+	stateDb.Clear()
+
+	// an account without balance is kept while it has pending rewards
+	require.Equal(big.NewInt(22), stateDb.GetPendingReward(addr))
+	require.Len(stateDb.getStateAccount(addr).PendingRewards(), 2)
+	require.Equal(big.NewInt(3), stateDb.GetPendingReward(addr2))
+
+	require.Equal(big.NewInt(18), stateDb.ExpirePendingRewards(2))
+	require.Equal(big.NewInt(7), stateDb.GetPendingReward(addr))
+	require.Zero(stateDb.GetPendingReward(addr2).Sign())
+
+	require.Equal(big.NewInt(7), stateDb.ClaimPendingRewards(addr))
+	require.Zero(stateDb.ClaimPendingRewards(addr).Sign())
+	_, _, err = stateDb.Commit(true)
+	require.NoError(err)
+	stateDb.Clear()
+
+	require.Nil(stateDb.getStateAccount(addr))
+	require.Nil(stateDb.getStateAccount(addr2))
+}
+
 func TestStateDB_WriteSnapshot(t *testing.T) {
 	database := db.NewMemDB()
 	stateDb := NewLazy(database)
@@ -429,3 +558,35 @@ func TestStateDB_Set_Has_ValidationTxBit(t *testing.T) {
 	require.True(t, stateDb.HasValidationTx(addr, types.SubmitLongAnswersTx))
 	require.False(t, stateDb.HasValidationTx(addr, types.SendTx))
 }
+
+func TestStateDB_GenerateMerkleProof(t *testing.T) {
+	require := require.New(t)
+	stateDb := NewLazy(db.NewMemDB())
+
+	addr, other := common.Address{0x1}, common.Address{0x2}
+	stateDb.SetState(addr, Verified)
+	stateDb.SetState(other, Candidate)
+	stateDb.Commit(true)
+	root := stateDb.Root()
+
+	_, err := stateDb.GenerateMerkleProof(common.Address{0x3})
+	require.Equal(ErrIdentityNotFound, err)
+
+	proof, err := stateDb.GenerateMerkleProof(addr)
+	require.NoError(err)
+	identity, err := VerifyMerkleProof(root, addr, proof)
+	require.NoError(err)
+	require.Equal(Verified, identity.State)
+
+	_, err = VerifyMerkleProof(root, other, proof)
+	require.Error(err)
+
+	stateDb.SetState(addr, Suspended)
+	stateDb.Commit(true)
+	require.NotEqual(root, stateDb.Root())
+
+	_, err = VerifyMerkleProof(stateDb.Root(), addr, proof)
+	require.Error(err)
+	_, err = VerifyMerkleProof(root, addr, proof)
+	require.NoError(err)
+}
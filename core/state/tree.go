@@ -266,6 +266,11 @@ func (t *ImmutableTree) IterateRangeInclusive(start, end []byte, ascending bool,
 	return t.tree.IterateRangeInclusive(start, end, ascending, fn)
 }
 
+// GetWithProof returns value with its existence or absence proof
+func (t *ImmutableTree) GetWithProof(key []byte) ([]byte, *iavl.RangeProof, error) {
+	return t.tree.GetWithProof(key)
+}
+
 func (t *ImmutableTree) Version() int64 {
 	return t.tree.Version()
 }
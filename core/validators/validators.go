@@ -13,51 +13,78 @@ import (
 	"math/rand"
 	"sort"
 	"sync"
+	"sync/atomic"
 )
 
+// ValidatorsCache keeps validators in an immutable snapshot, refresh builds a new snapshot and swaps it atomically
+// so readers never wait for the identity state iteration
 type ValidatorsCache struct {
-	identityState    *state.IdentityStateDB
+	identityState *state.IdentityStateDB
+	current       atomic.Value
+	log           log.Logger
+	// mutex serializes snapshot rebuilds, readers don't use it
+	mutex sync.Mutex
+}
+
+type validatorsSnapshot struct {
 	validOnlineNodes []common.Address
 	nodesSet         mapset.Set
 	onlineNodesSet   mapset.Set
-	log              log.Logger
+	delegations      map[common.Address]common.Address
 	god              common.Address
-	mutex            sync.Mutex
 	height           uint64
+	sortedNodes      []common.Address
 }
 
 func NewValidatorsCache(identityState *state.IdentityStateDB, godAddress common.Address) *ValidatorsCache {
-	return &ValidatorsCache{
-		identityState:  identityState,
+	v := &ValidatorsCache{
+		identityState: identityState,
+		log:           log.New(),
+	}
+	v.current.Store(&validatorsSnapshot{
 		nodesSet:       mapset.NewSet(),
 		onlineNodesSet: mapset.NewSet(),
-		log:            log.New(),
+		delegations:    make(map[common.Address]common.Address),
 		god:            godAddress,
-	}
+	})
+	return v
+}
+
+func (v *ValidatorsCache) snapshot() *validatorsSnapshot {
+	return v.current.Load().(*validatorsSnapshot)
 }
 
 func (v *ValidatorsCache) Load() {
 	v.mutex.Lock()
 	defer v.mutex.Unlock()
 
-	v.loadValidNodes()
+	v.current.Store(v.loadValidNodes(v.snapshot().god))
+}
+
+// Rebuild discards the current snapshot and loads validators from the identity state from scratch
+func (v *ValidatorsCache) Rebuild(godAddress common.Address) {
+	v.mutex.Lock()
+	defer v.mutex.Unlock()
+
+	v.current.Store(v.loadValidNodes(godAddress))
 }
 
 func (v *ValidatorsCache) GetOnlineValidators(seed types.Seed, round uint64, step uint8, limit int) mapset.Set {
+	snapshot := v.snapshot()
 
 	set := mapset.NewSet()
-	if v.OnlineSize() == 0 {
-		set.Add(v.god)
+	if snapshot.onlineNodesSet.Cardinality() == 0 {
+		set.Add(snapshot.god)
 		return set
 	}
-	if len(v.validOnlineNodes) == limit {
-		for _, n := range v.validOnlineNodes {
+	if len(snapshot.validOnlineNodes) == limit {
+		for _, n := range snapshot.validOnlineNodes {
 			set.Add(n)
 		}
 		return set
 	}
 
-	if len(v.validOnlineNodes) < limit {
+	if len(snapshot.validOnlineNodes) < limit {
 		return nil
 	}
 
@@ -65,52 +92,96 @@ func (v *ValidatorsCache) GetOnlineValidators(seed types.Seed, round uint64, ste
 	randSeed := binary.LittleEndian.Uint64(rndSeed[:])
 	random := rand.New(rand.NewSource(int64(randSeed)))
 
-	indexes := random.Perm(len(v.validOnlineNodes))
+	indexes := random.Perm(len(snapshot.validOnlineNodes))
 
 	for i := 0; i < limit; i++ {
-		set.Add(v.validOnlineNodes[indexes[i]])
+		set.Add(snapshot.validOnlineNodes[indexes[i]])
 	}
 
 	return set
 }
 
 func (v *ValidatorsCache) NetworkSize() int {
-	return v.nodesSet.Cardinality()
+	return v.snapshot().nodesSet.Cardinality()
 }
 
 func (v *ValidatorsCache) OnlineSize() int {
-	return v.onlineNodesSet.Cardinality()
+	return v.snapshot().onlineNodesSet.Cardinality()
 }
 
 func (v *ValidatorsCache) Contains(addr common.Address) bool {
-	return v.nodesSet.Contains(addr)
+	return v.snapshot().nodesSet.Contains(addr)
+}
+
+// List returns all validators sorted by address bytes in ascending order
+func (v *ValidatorsCache) List() []common.Address {
+	sortedNodes := v.snapshot().sortedNodes
+	return append(sortedNodes[:0:0], sortedNodes...)
 }
 
 func (v *ValidatorsCache) IsOnlineIdentity(addr common.Address) bool {
-	return v.onlineNodesSet.Contains(addr)
+	return v.snapshot().onlineNodesSet.Contains(addr)
+}
+
+// Delegatee returns identity voting on behalf of addr
+func (v *ValidatorsCache) Delegatee(addr common.Address) (common.Address, bool) {
+	delegatee, ok := v.snapshot().delegations[addr]
+	return delegatee, ok
+}
+
+func (v *ValidatorsCache) IsDelegatee(addr common.Address) bool {
+	for _, delegatee := range v.snapshot().delegations {
+		if delegatee == addr {
+			return true
+		}
+	}
+	return false
+}
+
+// VoteWeight returns count of committee seats the voter is able to vote for: own seat and seats of its offline delegators
+func (v *ValidatorsCache) VoteWeight(committee mapset.Set, voter common.Address) int {
+	snapshot := v.snapshot()
+	weight := 0
+	if committee.Contains(voter) {
+		weight++
+	}
+	for delegator, delegatee := range snapshot.delegations {
+		if delegatee == voter && committee.Contains(delegator) && !snapshot.onlineNodesSet.Contains(delegator) {
+			weight++
+		}
+	}
+	return weight
 }
 
 func (v *ValidatorsCache) GetAllOnlineValidators() mapset.Set {
-	return v.onlineNodesSet.Clone()
+	return v.snapshot().onlineNodesSet.Clone()
 }
 
+// RefreshIfUpdated rebuilds validators if the block updated identities, readers keep using the previous snapshot until
+// the new one is swapped in, so the call returns with the cache matching the block
 func (v *ValidatorsCache) RefreshIfUpdated(godAddress common.Address, block *types.Block) {
 	v.mutex.Lock()
 	defer v.mutex.Unlock()
 
+	var next *validatorsSnapshot
 	if block.Header.Flags().HasFlag(types.IdentityUpdate) {
-		v.loadValidNodes()
-		v.log.Info("Validators updated", "total", v.nodesSet.Cardinality(), "online", v.onlineNodesSet.Cardinality())
+		next = v.loadValidNodes(godAddress)
+		v.log.Info("Validators updated", "total", next.nodesSet.Cardinality(), "online", next.onlineNodesSet.Cardinality())
+	} else {
+		current := *v.snapshot()
+		next = &current
+		next.god = godAddress
 	}
-	v.god = godAddress
-	v.height = block.Height()
+	next.height = block.Height()
+	v.current.Store(next)
 }
 
-func (v *ValidatorsCache) loadValidNodes() {
+func (v *ValidatorsCache) loadValidNodes(god common.Address) *validatorsSnapshot {
 
 	var onlineNodes []common.Address
-	v.nodesSet.Clear()
-	v.onlineNodesSet.Clear()
+	nodesSet := mapset.NewSet()
+	onlineNodesSet := mapset.NewSet()
+	delegations := make(map[common.Address]common.Address)
 
 	v.identityState.IterateIdentities(func(key []byte, value []byte) bool {
 		if key == nil {
@@ -125,36 +196,62 @@ func (v *ValidatorsCache) loadValidNodes() {
 		}
 
 		if data.Online {
-			v.onlineNodesSet.Add(addr)
+			onlineNodesSet.Add(addr)
 			onlineNodes = append(onlineNodes, addr)
 		}
+		if data.Delegatee != nil {
+			delegations[addr] = *data.Delegatee
+		}
 
-		v.nodesSet.Add(addr)
+		nodesSet.Add(addr)
 
 		return false
 	})
 
-	v.validOnlineNodes = sortValidNodes(onlineNodes)
-	v.height = v.identityState.Version()
+	validDelegations := make(map[common.Address]common.Address)
+	for delegator, delegatee := range delegations {
+		if !onlineNodesSet.Contains(delegatee) {
+			continue
+		}
+		validDelegations[delegator] = delegatee
+		// offline delegator is present in committee through its delegatee
+		if !onlineNodesSet.Contains(delegator) {
+			onlineNodes = append(onlineNodes, delegator)
+		}
+	}
+
+	sortedNodes := make([]common.Address, 0, nodesSet.Cardinality())
+	for _, item := range nodesSet.ToSlice() {
+		sortedNodes = append(sortedNodes, item.(common.Address))
+	}
+	sort.Slice(sortedNodes, func(i, j int) bool {
+		return bytes.Compare(sortedNodes[i][:], sortedNodes[j][:]) < 0
+	})
+
+	return &validatorsSnapshot{
+		validOnlineNodes: sortValidNodes(onlineNodes),
+		nodesSet:         nodesSet,
+		onlineNodesSet:   onlineNodesSet,
+		delegations:      validDelegations,
+		god:              god,
+		height:           v.identityState.Version(),
+		sortedNodes:      sortedNodes,
+	}
 }
 
+// Clone returns a cache sharing the current snapshot, snapshots are never modified so refreshes of the clone and
+// the original don't affect each other
 func (v *ValidatorsCache) Clone() *ValidatorsCache {
-	v.mutex.Lock()
-	defer v.mutex.Unlock()
-
-	return &ValidatorsCache{
-		height:           v.height,
-		identityState:    v.identityState,
-		god:              v.god,
-		log:              v.log,
-		validOnlineNodes: append(v.validOnlineNodes[:0:0], v.validOnlineNodes...),
-		nodesSet:         v.nodesSet.Clone(),
-		onlineNodesSet:   v.onlineNodesSet.Clone(),
+	clone := &ValidatorsCache{
+		identityState: v.identityState,
+		log:           v.log,
 	}
+	clone.current.Store(v.snapshot())
+	return clone
 }
 
 func (v *ValidatorsCache) Height() uint64 {
-	return v.height
+	return v.snapshot().height
 }
 
 func sortValidNodes(nodes []common.Address) []common.Address {
@@ -1,6 +1,9 @@
 package validators
 
 import (
+	"bytes"
+	"github.com/deckarep/golang-set"
+	"github.com/idena-network/idena-go/blockchain/types"
 	"github.com/idena-network/idena-go/common"
 	"github.com/idena-network/idena-go/core/state"
 	"github.com/idena-network/idena-go/crypto"
@@ -81,11 +84,172 @@ func TestValidatorsCache_Clone(t *testing.T) {
 	clone := vCache.Clone()
 
 
-	require.Equal(vCache.height, clone.height)
-	require.Equal(vCache.god, clone.god)
-	require.Equal(vCache.validOnlineNodes, clone.validOnlineNodes)
-	require.Equal(vCache.onlineNodesSet, clone.onlineNodesSet)
-	require.Equal(vCache.nodesSet, clone.nodesSet)
-	require.False(vCache.onlineNodesSet == clone.onlineNodesSet)
-	require.False(vCache.nodesSet == clone.nodesSet)
+	require.Equal(vCache.Height(), clone.Height())
+	require.Equal(vCache.snapshot().god, clone.snapshot().god)
+	require.Equal(vCache.snapshot().validOnlineNodes, clone.snapshot().validOnlineNodes)
+	require.Equal(vCache.OnlineSize(), clone.OnlineSize())
+	require.Equal(vCache.NetworkSize(), clone.NetworkSize())
+
+	key, _ := crypto.GenerateKey()
+	identityStateDB.GetOrNewIdentityObject(crypto.PubkeyToAddress(key.PublicKey)).SetState(true)
+	identityStateDB.Commit(false)
+	vCache.RefreshIfUpdated(common.Address{0x2}, &types.Block{Header: &types.Header{
+		EmptyBlockHeader: &types.EmptyBlockHeader{Height: 10, Flags: types.IdentityUpdate},
+	}})
+	require.Equal(countAll+1, vCache.NetworkSize())
+	require.Equal(countAll, clone.NetworkSize())
+	require.Equal(common.Address{0x1}, clone.snapshot().god)
+	require.NotEqual(vCache.Height(), clone.Height())
+}
+
+func TestValidatorsCache_Delegations(t *testing.T) {
+	require := require.New(t)
+	database := db.NewMemDB()
+	identityStateDB := state.NewLazyIdentityState(database)
+
+	addr := func() common.Address {
+		key, _ := crypto.GenerateKey()
+		return crypto.PubkeyToAddress(key.PublicKey)
+	}
+	online, offlineDelegator, offline, offlineToOffline := addr(), addr(), addr(), addr()
+	for _, a := range []common.Address{online, offlineDelegator, offline, offlineToOffline} {
+		identityStateDB.GetOrNewIdentityObject(a).SetState(true)
+	}
+	identityStateDB.SetOnline(online, true)
+	identityStateDB.SetDelegatee(offlineDelegator, &online)
+	identityStateDB.SetDelegatee(offlineToOffline, &offline)
+	identityStateDB.Commit(false)
+
+	vCache := NewValidatorsCache(identityStateDB, common.Address{})
+	vCache.Load()
+
+	delegatee, ok := vCache.Delegatee(offlineDelegator)
+	require.True(ok)
+	require.Equal(online, delegatee)
+	_, ok = vCache.Delegatee(offlineToOffline)
+	require.False(ok)
+	require.True(vCache.IsDelegatee(online))
+	require.False(vCache.IsDelegatee(offline))
+
+	require.Equal(1, vCache.OnlineSize())
+	require.Len(vCache.snapshot().validOnlineNodes, 2)
+
+	require.Equal(2, vCache.VoteWeight(mapset.NewSet(online, offlineDelegator), online))
+	require.Equal(1, vCache.VoteWeight(mapset.NewSet(offlineDelegator), online))
+	require.Equal(0, vCache.VoteWeight(mapset.NewSet(online), offlineDelegator))
+
+	clone := vCache.Clone()
+	require.Equal(2, clone.VoteWeight(mapset.NewSet(online, offlineDelegator), online))
+}
+
+func TestValidatorsCache_List(t *testing.T) {
+	require := require.New(t)
+	identityStateDB := state.NewLazyIdentityState(db.NewMemDB())
+
+	for j := 0; j < 50; j++ {
+		key, _ := crypto.GenerateKey()
+		identityStateDB.GetOrNewIdentityObject(crypto.PubkeyToAddress(key.PublicKey)).SetState(true)
+	}
+	identityStateDB.Commit(false)
+
+	vCache := NewValidatorsCache(identityStateDB, common.Address{})
+	vCache.Load()
+
+	list := vCache.List()
+	require.Len(list, vCache.NetworkSize())
+	for i := 1; i < len(list); i++ {
+		require.True(bytes.Compare(list[i-1][:], list[i][:]) < 0)
+	}
+	list[0] = common.Address{}
+	require.NotEqual(common.Address{}, vCache.List()[0])
+
+	key, _ := crypto.GenerateKey()
+	identityStateDB.GetOrNewIdentityObject(crypto.PubkeyToAddress(key.PublicKey)).SetState(true)
+	identityStateDB.Commit(false)
+	vCache.RefreshIfUpdated(common.Address{}, &types.Block{Header: &types.Header{
+		EmptyBlockHeader: &types.EmptyBlockHeader{Flags: types.IdentityUpdate},
+	}})
+	require.Len(vCache.List(), 51)
+}
+
+func TestValidatorsCache_Rebuild(t *testing.T) {
+	require := require.New(t)
+	identityStateDB := state.NewLazyIdentityState(db.NewMemDB())
+
+	for j := 0; j < 20; j++ {
+		key, _ := crypto.GenerateKey()
+		addr := crypto.PubkeyToAddress(key.PublicKey)
+		identityStateDB.GetOrNewIdentityObject(addr).SetState(true)
+		identityStateDB.SetOnline(addr, j%2 == 0)
+	}
+	identityStateDB.Commit(false)
+
+	vCache := NewValidatorsCache(identityStateDB, common.Address{})
+	vCache.Load()
+	require.Equal(20, vCache.NetworkSize())
+
+	// corrupt the cache
+	vCache.current.Store(&validatorsSnapshot{
+		nodesSet:       mapset.NewSet(),
+		onlineNodesSet: mapset.NewSet(),
+		delegations:    make(map[common.Address]common.Address),
+	})
+	require.Zero(vCache.NetworkSize())
+
+	vCache.Rebuild(common.Address{0x1})
+
+	fresh := NewValidatorsCache(identityStateDB, common.Address{0x1})
+	fresh.Load()
+	require.Equal(fresh.NetworkSize(), vCache.NetworkSize())
+	require.Equal(fresh.OnlineSize(), vCache.OnlineSize())
+	require.Equal(fresh.List(), vCache.List())
+	require.True(fresh.GetAllOnlineValidators().Equal(vCache.GetAllOnlineValidators()))
+	require.Equal(common.Address{0x1}, vCache.snapshot().god)
+}
+
+func BenchmarkValidatorsCache_ReadDuringRefresh(b *testing.B) {
+	identityStateDB := state.NewLazyIdentityState(db.NewMemDB())
+	var addrs []common.Address
+	for j := 0; j < 5000; j++ {
+		key, _ := crypto.GenerateKey()
+		addr := crypto.PubkeyToAddress(key.PublicKey)
+		identityStateDB.GetOrNewIdentityObject(addr).SetState(true)
+		identityStateDB.SetOnline(addr, j%2 == 0)
+		addrs = append(addrs, addr)
+	}
+	identityStateDB.Commit(false)
+
+	vCache := NewValidatorsCache(identityStateDB, common.Address{})
+	vCache.Load()
+
+	block := &types.Block{Header: &types.Header{
+		EmptyBlockHeader: &types.EmptyBlockHeader{Flags: types.IdentityUpdate},
+	}}
+	stop := make(chan struct{})
+	refreshed := make(chan struct{})
+	go func() {
+		defer close(refreshed)
+		for {
+			select {
+			case <-stop:
+				return
+			default:
+				vCache.RefreshIfUpdated(common.Address{}, block)
+			}
+		}
+	}()
+
+	b.ResetTimer()
+	b.SetParallelism(4)
+	b.RunParallel(func(pb *testing.PB) {
+		i := 0
+		for pb.Next() {
+			vCache.IsOnlineIdentity(addrs[i%len(addrs)])
+			vCache.NetworkSize()
+			i++
+		}
+	})
+	b.StopTimer()
+	close(stop)
+	<-refreshed
 }
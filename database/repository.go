@@ -1,6 +1,7 @@
 package database
 
 import (
+	"bytes"
 	"encoding/binary"
 	"github.com/golang/protobuf/proto"
 	"github.com/idena-network/idena-go/blockchain/types"
@@ -8,6 +9,8 @@ import (
 	"github.com/idena-network/idena-go/common/math"
 	"github.com/idena-network/idena-go/log"
 	models "github.com/idena-network/idena-go/protobuf"
+	"github.com/idena-network/idena-go/rlp"
+	"github.com/pkg/errors"
 	dbm "github.com/tendermint/tm-db"
 	"math/big"
 	"sort"
@@ -72,6 +75,31 @@ func burntCoinsKey(height uint64, hash common.Hash) []byte {
 	return append(key, hash[:]...)
 }
 
+func feeRecordKey(height uint64) []byte {
+	return append(feeRecordPrefix, encodeUint64Number(height)...)
+}
+
+func proposerRecordKey(slot uint64) []byte {
+	return append(proposerRecordPrefix, encodeUint64Number(slot)...)
+}
+
+func committeeSizeRecordKey(slot uint64) []byte {
+	return append(committeeSizeRecordPrefix, encodeUint64Number(slot)...)
+}
+
+func blockSeedKey(height uint64) []byte {
+	return append(blockSeedPrefix, encodeUint64Number(height)...)
+}
+
+func supplyRecordKey(height uint64) []byte {
+	return append(supplyRecordPrefix, encodeUint64Number(height)...)
+}
+
+func identityStateChangeKey(address common.Address, height uint64) []byte {
+	key := append(identityStateChangePrefix, address[:]...)
+	return append(key, encodeUint64Number(height)...)
+}
+
 func burntCoinsMinKey() []byte {
 	return burntCoinsKey(0, common.BytesToHash(common.MinHash[:]))
 }
@@ -163,11 +191,71 @@ func (r *Repo) ReadCanonicalHash(height uint64) common.Hash {
 	return common.BytesToHash(data)
 }
 
+// ReadCanonicalHashes returns canonical hashes for heights [from; to] ordered by height, all heights must be present
+func (r *Repo) ReadCanonicalHashes(from, to uint64) ([]common.Hash, error) {
+	if to < from {
+		return nil, errors.Errorf("invalid range [%v; %v]", from, to)
+	}
+	it, err := r.db.Iterator(headerHashKey(from), append(headerHashKey(to), 0x0))
+	assertNoError(err)
+	defer it.Close()
+
+	keyLength := len(headerHashKey(0))
+	result := make([]common.Hash, 0, to-from+1)
+	expectedHeight := from
+	for ; it.Valid(); it.Next() {
+		key := it.Key()
+		// header keys share the same prefix, skip them
+		if len(key) != keyLength || !bytes.HasSuffix(key, headerHashSuffix) {
+			continue
+		}
+		height := binary.BigEndian.Uint64(key[len(headerPrefix) : len(headerPrefix)+8])
+		if height != expectedHeight {
+			return nil, errors.Errorf("canonical hash is missing, height: %v", expectedHeight)
+		}
+		result = append(result, common.BytesToHash(it.Value()))
+		expectedHeight++
+	}
+	if uint64(len(result)) != to-from+1 {
+		return nil, errors.Errorf("canonical hash is missing, height: %v", expectedHeight)
+	}
+	return result, nil
+}
+
 func (r *Repo) WriteFinalConsensus(hash common.Hash) {
 	key := finalConsensusKey(hash)
 	r.db.Set(key, []byte{0x1})
 }
 
+func (r *Repo) ReadFinalConsensus(hash common.Hash) bool {
+	data, err := r.db.Get(finalConsensusKey(hash))
+	assertNoError(err)
+	return len(data) > 0
+}
+
+func (r *Repo) WriteFinalCommittee(hash common.Hash, committee []common.Address) {
+	data, err := rlp.EncodeToBytes(committee)
+	if err != nil {
+		log.Crit("failed to rlp encode final committee", "err", err)
+		return
+	}
+	r.db.Set(append(finalCommitteePrefix, hash.Bytes()...), data)
+}
+
+func (r *Repo) ReadFinalCommittee(hash common.Hash) []common.Address {
+	data, err := r.db.Get(append(finalCommitteePrefix, hash.Bytes()...))
+	assertNoError(err)
+	if data == nil {
+		return nil
+	}
+	committee := make([]common.Address, 0)
+	if err := rlp.DecodeBytes(data, &committee); err != nil {
+		log.Error("invalid final committee rlp", "err", err)
+		return nil
+	}
+	return committee
+}
+
 func (r *Repo) SetHead(batch dbm.Batch, height uint64) {
 	hash := r.ReadCanonicalHash(height)
 	if hash != (common.Hash{}) {
@@ -187,6 +275,10 @@ func (r *Repo) WriteTxIndex(txHash common.Hash, index *types.TransactionIndex) {
 	r.db.Set(txIndexKey(txHash), data)
 }
 
+func (r *Repo) RemoveTxIndex(hash common.Hash) {
+	assertNoError(r.db.Delete(txIndexKey(hash)))
+}
+
 func (r *Repo) ReadTxIndex(hash common.Hash) *types.TransactionIndex {
 	key := txIndexKey(hash)
 	data, err := r.db.Get(key)
@@ -297,6 +389,16 @@ func (r *Repo) ReadIdentityStateDiff(height uint64) []byte {
 	return data
 }
 
+func (r *Repo) WriteGenesisPatch(patch []byte) {
+	assertNoError(r.db.Set(genesisPatchKey, patch))
+}
+
+func (r *Repo) ReadGenesisPatch() []byte {
+	data, err := r.db.Get(genesisPatchKey)
+	assertNoError(err)
+	return data
+}
+
 func (r *Repo) WritePreliminaryHead(header *types.Header) {
 	data, err := header.ToBytes()
 	if err != nil {
@@ -306,6 +408,32 @@ func (r *Repo) WritePreliminaryHead(header *types.Header) {
 	assertNoError(r.db.Set(preliminaryHeadKey, data))
 }
 
+func (r *Repo) WriteImportedGenesisHeight(height uint64) {
+	assertNoError(r.db.Set(importedGenesisKey, encodeUint64Number(height)))
+}
+
+func (r *Repo) ReadImportedGenesisHeight() uint64 {
+	data, err := r.db.Get(importedGenesisKey)
+	assertNoError(err)
+	if len(data) != 8 {
+		return 0
+	}
+	return binary.BigEndian.Uint64(data)
+}
+
+func (r *Repo) WritePrunedHeight(height uint64) {
+	assertNoError(r.db.Set(prunedHeightKey, encodeUint64Number(height)))
+}
+
+func (r *Repo) ReadPrunedHeight() uint64 {
+	data, err := r.db.Get(prunedHeightKey)
+	assertNoError(err)
+	if len(data) != 8 {
+		return 0
+	}
+	return binary.BigEndian.Uint64(data)
+}
+
 func (r *Repo) ReadPreliminaryHead() *types.Header {
 	data, err := r.db.Get(preliminaryHeadKey)
 	assertNoError(err)
@@ -423,6 +551,162 @@ func (r *Repo) SaveBurntCoins(blockHeight uint64, txHash common.Hash, address co
 	r.db.Set(burntCoinsKey(blockHeight, txHash), data)
 }
 
+func (r *Repo) WriteFeeRecord(record *types.FeeRecord) {
+	data, err := record.ToBytes()
+	if err != nil {
+		log.Crit("failed to proto encode fee record", "err", err)
+		return
+	}
+	r.db.Set(feeRecordKey(record.Height), data)
+}
+
+// ReadFeeRecords returns stored fee records for heights [from; to] ordered by height, missing heights are skipped
+func (r *Repo) ReadFeeRecords(from, to uint64) []*types.FeeRecord {
+	it, err := r.db.Iterator(feeRecordKey(from), append(feeRecordKey(to), 0x0))
+	assertNoError(err)
+	defer it.Close()
+	var res []*types.FeeRecord
+	for ; it.Valid(); it.Next() {
+		record := new(types.FeeRecord)
+		if err := record.FromBytes(it.Value()); err != nil {
+			log.Error("cannot parse fee record", "key", it.Key())
+			continue
+		}
+		res = append(res, record)
+	}
+	return res
+}
+
+func (r *Repo) DeleteOutdatedFeeRecords(blockHeight uint64, blockRange uint64) {
+	if blockHeight <= blockRange {
+		return
+	}
+	it, err := r.db.Iterator(feeRecordKey(0), feeRecordKey(blockHeight-blockRange))
+	assertNoError(err)
+	defer it.Close()
+	var keys [][]byte
+	for ; it.Valid(); it.Next() {
+		keys = append(keys, it.Key())
+	}
+	for _, key := range keys {
+		r.db.Delete(key)
+	}
+}
+
+func (r *Repo) WriteProposerRecord(slot uint64, record *types.ProposerRecord) {
+	data, err := rlp.EncodeToBytes(record)
+	if err != nil {
+		log.Crit("failed to rlp encode proposer record", "err", err)
+		return
+	}
+	r.db.Set(proposerRecordKey(slot), data)
+}
+
+func (r *Repo) ReadProposerRecord(slot uint64) *types.ProposerRecord {
+	data, err := r.db.Get(proposerRecordKey(slot))
+	assertNoError(err)
+	if data == nil {
+		return nil
+	}
+	record := new(types.ProposerRecord)
+	if err := rlp.DecodeBytes(data, record); err != nil {
+		log.Error("invalid proposer record rlp", "err", err)
+		return nil
+	}
+	return record
+}
+
+func (r *Repo) WriteCommitteeSizeRecord(slot uint64, record *types.CommitteeSizeRecord) {
+	data, err := rlp.EncodeToBytes(record)
+	if err != nil {
+		log.Crit("failed to rlp encode committee size record", "err", err)
+		return
+	}
+	r.db.Set(committeeSizeRecordKey(slot), data)
+}
+
+func (r *Repo) ReadCommitteeSizeRecord(slot uint64) *types.CommitteeSizeRecord {
+	data, err := r.db.Get(committeeSizeRecordKey(slot))
+	assertNoError(err)
+	if data == nil {
+		return nil
+	}
+	record := new(types.CommitteeSizeRecord)
+	if err := rlp.DecodeBytes(data, record); err != nil {
+		log.Error("invalid committee size record rlp", "err", err)
+		return nil
+	}
+	return record
+}
+
+func (r *Repo) WriteBlockSeed(height uint64, seed types.Seed) {
+	r.db.Set(blockSeedKey(height), seed.Bytes())
+}
+
+func (r *Repo) ReadBlockSeed(height uint64) (types.Seed, bool) {
+	data, err := r.db.Get(blockSeedKey(height))
+	assertNoError(err)
+	var seed types.Seed
+	if len(data) != len(seed) {
+		return seed, false
+	}
+	seed.SetBytes(data)
+	return seed, true
+}
+
+func (r *Repo) RemoveBlockSeed(height uint64) {
+	r.db.Delete(blockSeedKey(height))
+}
+
+func (r *Repo) WriteSupplyRecord(height uint64, record *types.SupplyRecord) {
+	data, err := rlp.EncodeToBytes(record)
+	if err != nil {
+		log.Crit("failed to rlp encode supply record", "err", err)
+		return
+	}
+	r.db.Set(supplyRecordKey(height), data)
+}
+
+func (r *Repo) ReadSupplyRecord(height uint64) *types.SupplyRecord {
+	data, err := r.db.Get(supplyRecordKey(height))
+	assertNoError(err)
+	if data == nil {
+		return nil
+	}
+	record := new(types.SupplyRecord)
+	if err := rlp.DecodeBytes(data, record); err != nil {
+		log.Error("invalid supply record rlp", "err", err)
+		return nil
+	}
+	return record
+}
+
+func (r *Repo) WriteIdentityStateChanges(address common.Address, height uint64, changes []*types.IdentityStateChange) {
+	data, err := rlp.EncodeToBytes(changes)
+	if err != nil {
+		log.Crit("failed to rlp encode identity state changes", "err", err)
+		return
+	}
+	r.db.Set(identityStateChangeKey(address, height), data)
+}
+
+// ReadIdentityStateChanges returns state changes of the identity ordered by height
+func (r *Repo) ReadIdentityStateChanges(address common.Address) []*types.IdentityStateChange {
+	it, err := r.db.Iterator(identityStateChangeKey(address, 0), append(identityStateChangeKey(address, math.MaxUint64), 0x0))
+	assertNoError(err)
+	defer it.Close()
+	var res []*types.IdentityStateChange
+	for ; it.Valid(); it.Next() {
+		var changes []*types.IdentityStateChange
+		if err := rlp.DecodeBytes(it.Value(), &changes); err != nil {
+			log.Error("invalid identity state changes rlp", "key", it.Key(), "err", err)
+			continue
+		}
+		res = append(res, changes...)
+	}
+	return res
+}
+
 func (r *Repo) GetTotalBurntCoins() []*types.BurntCoins {
 	it, err := r.db.Iterator(burntCoinsMinKey(), burntCoinsKey(math.MaxUint64, common.BytesToHash(common.MaxHash[:])))
 	assertNoError(err)
@@ -461,3 +745,18 @@ func (r *Repo) GetTotalBurntCoins() []*types.BurntCoins {
 
 	return res
 }
+
+// CopyTo copies all chain data to dst
+func (r *Repo) CopyTo(dst dbm.DB) error {
+	it, err := r.db.Iterator(nil, nil)
+	if err != nil {
+		return err
+	}
+	defer it.Close()
+	batch := dst.NewBatch()
+	defer batch.Close()
+	for ; it.Valid(); it.Next() {
+		batch.Set(it.Key(), it.Value())
+	}
+	return batch.WriteSync()
+}
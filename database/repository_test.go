@@ -6,6 +6,7 @@ import (
 	"github.com/idena-network/idena-go/common"
 	"github.com/stretchr/testify/require"
 	"github.com/tendermint/tm-db"
+	"math/big"
 	"testing"
 	"time"
 )
@@ -73,3 +74,56 @@ func TestRepo_WritActivityMonitor(t *testing.T) {
 	require.Equal(monitor.Data[0].Addr, readActivity.Data[0].Addr)
 	require.Equal(monitor.Data[0].Time.Unix(), readActivity.Data[0].Time.Unix())
 }
+
+func TestRepo_ReadCanonicalHashes(t *testing.T) {
+	database := db.NewMemDB()
+	repo := NewRepo(database)
+
+	var hashes []common.Hash
+	for i := uint64(1); i <= 300; i++ {
+		hash := getRandHash()
+		repo.WriteCanonicalHash(i, hash)
+		repo.WriteBlockHeader(&types.Header{EmptyBlockHeader: &types.EmptyBlockHeader{Height: i}})
+		hashes = append(hashes, hash)
+	}
+
+	res, err := repo.ReadCanonicalHashes(1, 300)
+	require.NoError(t, err)
+	require.Equal(t, hashes, res)
+
+	res, err = repo.ReadCanonicalHashes(255, 257)
+	require.NoError(t, err)
+	require.Equal(t, hashes[254:257], res)
+
+	_, err = repo.ReadCanonicalHashes(10, 9)
+	require.Error(t, err)
+
+	_, err = repo.ReadCanonicalHashes(299, 301)
+	require.Error(t, err)
+
+	repo.RemoveCanonicalHash(100)
+	_, err = repo.ReadCanonicalHashes(99, 101)
+	require.Error(t, err)
+}
+
+func TestRepo_FeeRecords(t *testing.T) {
+	database := db.NewMemDB()
+	repo := NewRepo(database)
+
+	for i := uint64(1); i <= 10; i++ {
+		repo.WriteFeeRecord(&types.FeeRecord{Height: i, TotalFee: big.NewInt(int64(i)), BurnedFee: big.NewInt(1), TxCount: int(i)})
+	}
+
+	records := repo.ReadFeeRecords(3, 5)
+	require.Len(t, records, 3)
+	for i, record := range records {
+		require.Equal(t, uint64(i+3), record.Height)
+		require.Equal(t, big.NewInt(int64(i+3)), record.TotalFee)
+		require.Equal(t, i+3, record.TxCount)
+	}
+
+	repo.DeleteOutdatedFeeRecords(10, 4)
+	records = repo.ReadFeeRecords(1, 10)
+	require.Len(t, records, 5)
+	require.Equal(t, uint64(6), records[0].Height)
+}
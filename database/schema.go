@@ -12,12 +12,26 @@ var (
 
 	finalConsensusPrefix = []byte("f")
 
+	finalCommitteePrefix = []byte("committee")
+
 	transactionIndexPrefix = []byte("ti")
 
 	ownTransactionIndexPrefix = []byte("oti")
 
 	burntCoinsPrefix = []byte("bc")
 
+	feeRecordPrefix = []byte("bfr") // feeRecordPrefix + num (uint64 big endian) -> fee record
+
+	proposerRecordPrefix = []byte("pr") // proposerRecordPrefix + slot (uint64 big endian) -> proposer record
+
+	committeeSizeRecordPrefix = []byte("csr") // committeeSizeRecordPrefix + slot (uint64 big endian) -> committee size record
+
+	blockSeedPrefix = []byte("bs") // blockSeedPrefix + num (uint64 big endian) -> block seed
+
+	supplyRecordPrefix = []byte("sr") // supplyRecordPrefix + num (uint64 big endian) -> supply record
+
+	identityStateChangePrefix = []byte("isc") // identityStateChangePrefix + address + num (uint64 big endian) -> identity state changes
+
 	certPrefix = []byte("c")
 
 	flipEncryptionPrefix = []byte("key")
@@ -26,9 +40,15 @@ var (
 
 	lastSnapshotKey = []byte("last-snapshot")
 
+	importedGenesisKey = []byte("imported-genesis") // height of genesis block created by state import
+
+	prunedHeightKey = []byte("pruned-height") // blocks below this height have no bodies and tx indices
+
 	identityStateDiffPrefix = []byte("id-diff")
 
 	preliminaryHeadKey = []byte("preliminary-head")
 
 	activityMonitorKey = []byte("activity")
+
+	genesisPatchKey = []byte("genesis-patch") // the last scheduled genesis patch
 )
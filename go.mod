@@ -13,6 +13,7 @@ require (
 	github.com/go-stack/stack v1.8.0
 	github.com/golang/protobuf v1.4.2
 	github.com/google/tink/go v0.0.0-20200401233402-a389e601043a
+	github.com/hashicorp/golang-lru v0.5.4
 	github.com/ipfs/fs-repo-migrations v1.6.3
 	github.com/ipfs/go-blockservice v0.1.3
 	github.com/ipfs/go-cid v0.0.7
@@ -43,6 +44,7 @@ require (
 	github.com/stretchr/testify v1.6.1
 	github.com/syndtr/goleveldb v1.0.1-0.20190923125748-758128399b1d
 	github.com/tendermint/iavl v0.13.2
+	github.com/tendermint/tendermint v0.33.4
 	github.com/tendermint/tm-db v0.5.1
 	github.com/ulikunitz/xz v0.5.7 // indirect
 	github.com/urfave/cli v1.22.4
@@ -1,6 +1,7 @@
 package node
 
 import (
+	"encoding/json"
 	"fmt"
 	"github.com/idena-network/idena-go/api"
 	"github.com/idena-network/idena-go/blockchain"
@@ -25,10 +26,13 @@ import (
 	"github.com/idena-network/idena-go/stats/collector"
 	"github.com/pkg/errors"
 	"net"
+	"net/http"
 	"os"
+	"os/signal"
 	"path/filepath"
 	"strings"
 	"sync"
+	"syscall"
 
 	"github.com/syndtr/goleveldb/leveldb/filter"
 	"github.com/syndtr/goleveldb/leveldb/opt"
@@ -60,6 +64,7 @@ type Node struct {
 	offlineDetector *blockchain.OfflineDetector
 	appVersion      string
 	profileManager  *profile.Manager
+	db              db.DB
 }
 
 type NodeCtx struct {
@@ -154,10 +159,13 @@ func NewNodeWithInjections(config *config.Config, bus eventbus.Bus, statsCollect
 	offlineDetector := blockchain.NewOfflineDetector(config, db, appState, secStore, bus)
 	votes := pengings.NewVotes(appState, bus, offlineDetector)
 
-	txpool := mempool.NewTxPool(appState, bus, config.Mempool)
+	txpool := mempool.NewTxPool(appState, bus, config.Mempool, config.Consensus)
 	flipKeyPool := mempool.NewKeysPool(db, appState, bus, secStore)
 
-	chain := blockchain.NewBlockchain(config, db, txpool, appState, ipfsProxy, secStore, bus, offlineDetector, keyStore)
+	chain, err := blockchain.NewBlockchain(config, db, blockchain.WithRequiredDefaults(txpool, appState, ipfsProxy, secStore, bus, offlineDetector, keyStore))
+	if err != nil {
+		return nil, err
+	}
 	proposals, pendingProofs := pengings.NewProposals(chain, appState, offlineDetector)
 	flipper := flip.NewFlipper(db, ipfsProxy, flipKeyPool, txpool, secStore, appState, bus)
 	pm := protocol.NewIdenaGossipHandler(ipfsProxy.Host(), config.P2P, chain, proposals, votes, txpool, flipper, bus, flipKeyPool, appVersion, &ceremonyChecker{
@@ -190,6 +198,7 @@ func NewNodeWithInjections(config *config.Config, bus eventbus.Bus, statsCollect
 		votes:           votes,
 		appVersion:      appVersion,
 		profileManager:  profileManager,
+		db:              db,
 	}
 	return &NodeCtx{
 		Node:            node,
@@ -222,7 +231,7 @@ func (node *Node) StartWithHeight(height uint64) {
 		return
 	}
 
-	if err := node.appState.Initialize(node.blockchain.Head.Height()); err != nil {
+	if err := node.appState.Initialize(node.blockchain.GetCurrentHead().Height()); err != nil {
 		if err := node.appState.Initialize(0); err != nil {
 			node.log.Error("Cannot initialize state", "error", err.Error())
 		}
@@ -233,20 +242,23 @@ func (node *Node) StartWithHeight(height uint64) {
 		return
 	}
 
-	if height > 0 && node.blockchain.Head.Height() > height {
+	if height > 0 && node.blockchain.GetCurrentHead().Height() > height {
 		if err := node.blockchain.ResetTo(height); err != nil {
 			node.log.Error(fmt.Sprintf("Cannot reset blockchain to %d", height), "error", err.Error())
 			return
 		}
 	}
 
-	node.txpool.Initialize(node.blockchain.Head, node.secStore.GetAddress())
-	node.flipKeyPool.Initialize(node.blockchain.Head)
-	node.votes.Initialize(node.blockchain.Head)
+	node.txpool.Initialize(node.blockchain.GetCurrentHead(), node.secStore.GetAddress())
+	if err := node.txpool.LoadPersisted(node.db); err != nil {
+		node.log.Warn("Failed to load persisted txs", "err", err)
+	}
+	node.flipKeyPool.Initialize(node.blockchain.GetCurrentHead())
+	node.votes.Initialize(node.blockchain.GetCurrentHead())
 	node.fp.Initialize()
-	node.ceremony.Initialize(node.blockchain.GetBlock(node.blockchain.Head.Hash()))
+	node.ceremony.Initialize(node.blockchain.GetBlock(node.blockchain.GetCurrentHead().Hash()))
 	node.blockchain.ProvideApplyNewEpochFunc(node.ceremony.ApplyNewEpoch)
-	node.offlineDetector.Start(node.blockchain.Head)
+	node.offlineDetector.Start(node.blockchain.GetCurrentHead())
 	node.consensusEngine.Start()
 	node.pm.Start()
 
@@ -257,7 +269,15 @@ func (node *Node) StartWithHeight(height uint64) {
 }
 
 func (node *Node) WaitForStop() {
-	<-node.stop
+	sigs := make(chan os.Signal, 1)
+	signal.Notify(sigs, os.Interrupt, syscall.SIGTERM)
+	select {
+	case <-node.stop:
+	case <-sigs:
+	}
+	if err := node.txpool.Persist(node.db); err != nil {
+		node.log.Warn("Failed to persist txs", "err", err)
+	}
 	node.secStore.Destroy()
 }
 
@@ -286,6 +306,7 @@ func (node *Node) startHTTP(endpoint string, apis []rpc.API, modules []string, c
 	if err != nil {
 		return err
 	}
+	handler.RegisterHTTPHandler("/debug/metrics", http.HandlerFunc(node.serveMetrics))
 	node.log.Info("HTTP endpoint opened", "url", fmt.Sprintf("http://%s", endpoint), "cors", strings.Join(cors, ","), "vhosts", strings.Join(vhosts, ","))
 
 	node.httpListener = listener
@@ -294,6 +315,14 @@ func (node *Node) startHTTP(endpoint string, apis []rpc.API, modules []string, c
 	return nil
 }
 
+// serveMetrics writes block processing counters of the chain as JSON
+func (node *Node) serveMetrics(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("content-type", "application/json")
+	if err := json.NewEncoder(w).Encode(node.blockchain.Metrics().Snapshot()); err != nil {
+		node.log.Warn("Failed to write metrics", "err", err)
+	}
+}
+
 // stopHTTP terminates the HTTP RPC endpoint.
 func (node *Node) stopHTTP() {
 	if node.httpListener != nil {
@@ -320,7 +349,7 @@ func OpenDatabase(datadir string, name string, cache int, handles int) (db.DB, e
 // apis returns the collection of RPC descriptors this node offers.
 func (node *Node) apis() []rpc.API {
 
-	baseApi := api.NewBaseApi(node.consensusEngine, node.txpool, node.keyStore, node.secStore)
+	baseApi := api.NewBaseApi(node.consensusEngine, node.blockchain, node.txpool, node.keyStore, node.secStore)
 
 	return []rpc.API{
 		{
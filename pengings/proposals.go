@@ -176,7 +176,7 @@ func (proposals *Proposals) ProcessPendingProofs() []*types.ProofProposal {
 func (proposals *Proposals) ProcessPendingBlocks() []*types.BlockProposal {
 	var result []*types.BlockProposal
 
-	checkState, err := proposals.appState.ForCheck(proposals.chain.Head.Height())
+	checkState, err := proposals.appState.ForCheck(proposals.chain.GetCurrentHead().Height())
 	if err != nil {
 		proposals.log.Warn("failed to create checkState", "err", err)
 	}
@@ -235,7 +235,7 @@ func (proposals *Proposals) AddProposedBlock(proposal *types.BlockProposal, peer
 			return false, false
 		}
 
-		if err := proposals.offlineDetector.ValidateBlock(proposals.chain.Head, block); err != nil {
+		if err := proposals.offlineDetector.ValidateBlock(proposals.chain.GetCurrentHead(), block); err != nil {
 			log.Warn("Failed block offline proposing", "err", err.Error())
 			return false, false
 		}
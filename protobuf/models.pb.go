@@ -1731,6 +1731,77 @@ func (x *ProtoBurntCoins) GetAmount() []byte {
 	return nil
 }
 
+type ProtoFeeRecord struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Height    uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	TotalFee  []byte `protobuf:"bytes,2,opt,name=totalFee,proto3" json:"totalFee,omitempty"`
+	BurnedFee []byte `protobuf:"bytes,3,opt,name=burnedFee,proto3" json:"burnedFee,omitempty"`
+	TxCount   uint32 `protobuf:"varint,4,opt,name=txCount,proto3" json:"txCount,omitempty"`
+}
+
+func (x *ProtoFeeRecord) Reset() {
+	*x = ProtoFeeRecord{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_models_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProtoFeeRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProtoFeeRecord) ProtoMessage() {}
+
+func (x *ProtoFeeRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_models_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProtoFeeRecord.ProtoReflect.Descriptor instead.
+func (*ProtoFeeRecord) Descriptor() ([]byte, []int) {
+	return file_protobuf_models_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ProtoFeeRecord) GetHeight() uint64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *ProtoFeeRecord) GetTotalFee() []byte {
+	if x != nil {
+		return x.TotalFee
+	}
+	return nil
+}
+
+func (x *ProtoFeeRecord) GetBurnedFee() []byte {
+	if x != nil {
+		return x.BurnedFee
+	}
+	return nil
+}
+
+func (x *ProtoFeeRecord) GetTxCount() uint32 {
+	if x != nil {
+		return x.TxCount
+	}
+	return 0
+}
+
 type ProtoSavedTransaction struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1745,7 +1816,7 @@ type ProtoSavedTransaction struct {
 func (x *ProtoSavedTransaction) Reset() {
 	*x = ProtoSavedTransaction{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[30]
+		mi := &file_protobuf_models_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1758,7 +1829,7 @@ func (x *ProtoSavedTransaction) String() string {
 func (*ProtoSavedTransaction) ProtoMessage() {}
 
 func (x *ProtoSavedTransaction) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[30]
+	mi := &file_protobuf_models_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1771,7 +1842,7 @@ func (x *ProtoSavedTransaction) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProtoSavedTransaction.ProtoReflect.Descriptor instead.
 func (*ProtoSavedTransaction) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{30}
+	return file_protobuf_models_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *ProtoSavedTransaction) GetTx() *ProtoTransaction {
@@ -1814,7 +1885,7 @@ type ProtoActivityMonitor struct {
 func (x *ProtoActivityMonitor) Reset() {
 	*x = ProtoActivityMonitor{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[31]
+		mi := &file_protobuf_models_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1827,7 +1898,7 @@ func (x *ProtoActivityMonitor) String() string {
 func (*ProtoActivityMonitor) ProtoMessage() {}
 
 func (x *ProtoActivityMonitor) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[31]
+	mi := &file_protobuf_models_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1840,7 +1911,7 @@ func (x *ProtoActivityMonitor) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProtoActivityMonitor.ProtoReflect.Descriptor instead.
 func (*ProtoActivityMonitor) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{31}
+	return file_protobuf_models_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *ProtoActivityMonitor) GetTimestamp() int64 {
@@ -1869,7 +1940,7 @@ type ProtoShortAnswerAttachment struct {
 func (x *ProtoShortAnswerAttachment) Reset() {
 	*x = ProtoShortAnswerAttachment{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[32]
+		mi := &file_protobuf_models_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1882,7 +1953,7 @@ func (x *ProtoShortAnswerAttachment) String() string {
 func (*ProtoShortAnswerAttachment) ProtoMessage() {}
 
 func (x *ProtoShortAnswerAttachment) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[32]
+	mi := &file_protobuf_models_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1895,7 +1966,7 @@ func (x *ProtoShortAnswerAttachment) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProtoShortAnswerAttachment.ProtoReflect.Descriptor instead.
 func (*ProtoShortAnswerAttachment) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{32}
+	return file_protobuf_models_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *ProtoShortAnswerAttachment) GetAnswers() []byte {
@@ -1926,7 +1997,7 @@ type ProtoLongAnswerAttachment struct {
 func (x *ProtoLongAnswerAttachment) Reset() {
 	*x = ProtoLongAnswerAttachment{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[33]
+		mi := &file_protobuf_models_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1939,7 +2010,7 @@ func (x *ProtoLongAnswerAttachment) String() string {
 func (*ProtoLongAnswerAttachment) ProtoMessage() {}
 
 func (x *ProtoLongAnswerAttachment) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[33]
+	mi := &file_protobuf_models_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1952,7 +2023,7 @@ func (x *ProtoLongAnswerAttachment) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProtoLongAnswerAttachment.ProtoReflect.Descriptor instead.
 func (*ProtoLongAnswerAttachment) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{33}
+	return file_protobuf_models_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *ProtoLongAnswerAttachment) GetAnswers() []byte {
@@ -1995,7 +2066,7 @@ type ProtoFlipSubmitAttachment struct {
 func (x *ProtoFlipSubmitAttachment) Reset() {
 	*x = ProtoFlipSubmitAttachment{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[34]
+		mi := &file_protobuf_models_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2008,7 +2079,7 @@ func (x *ProtoFlipSubmitAttachment) String() string {
 func (*ProtoFlipSubmitAttachment) ProtoMessage() {}
 
 func (x *ProtoFlipSubmitAttachment) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[34]
+	mi := &file_protobuf_models_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2021,7 +2092,7 @@ func (x *ProtoFlipSubmitAttachment) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProtoFlipSubmitAttachment.ProtoReflect.Descriptor instead.
 func (*ProtoFlipSubmitAttachment) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{34}
+	return file_protobuf_models_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *ProtoFlipSubmitAttachment) GetCid() []byte {
@@ -2049,7 +2120,7 @@ type ProtoOnlineStatusAttachment struct {
 func (x *ProtoOnlineStatusAttachment) Reset() {
 	*x = ProtoOnlineStatusAttachment{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[35]
+		mi := &file_protobuf_models_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2062,7 +2133,7 @@ func (x *ProtoOnlineStatusAttachment) String() string {
 func (*ProtoOnlineStatusAttachment) ProtoMessage() {}
 
 func (x *ProtoOnlineStatusAttachment) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[35]
+	mi := &file_protobuf_models_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2075,7 +2146,7 @@ func (x *ProtoOnlineStatusAttachment) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProtoOnlineStatusAttachment.ProtoReflect.Descriptor instead.
 func (*ProtoOnlineStatusAttachment) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{35}
+	return file_protobuf_models_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *ProtoOnlineStatusAttachment) GetOnline() bool {
@@ -2096,7 +2167,7 @@ type ProtoBurnAttachment struct {
 func (x *ProtoBurnAttachment) Reset() {
 	*x = ProtoBurnAttachment{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[36]
+		mi := &file_protobuf_models_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2109,7 +2180,7 @@ func (x *ProtoBurnAttachment) String() string {
 func (*ProtoBurnAttachment) ProtoMessage() {}
 
 func (x *ProtoBurnAttachment) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[36]
+	mi := &file_protobuf_models_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2122,7 +2193,7 @@ func (x *ProtoBurnAttachment) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProtoBurnAttachment.ProtoReflect.Descriptor instead.
 func (*ProtoBurnAttachment) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{36}
+	return file_protobuf_models_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *ProtoBurnAttachment) GetKey() string {
@@ -2143,7 +2214,7 @@ type ProtoChangeProfileAttachment struct {
 func (x *ProtoChangeProfileAttachment) Reset() {
 	*x = ProtoChangeProfileAttachment{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[37]
+		mi := &file_protobuf_models_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2156,7 +2227,7 @@ func (x *ProtoChangeProfileAttachment) String() string {
 func (*ProtoChangeProfileAttachment) ProtoMessage() {}
 
 func (x *ProtoChangeProfileAttachment) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[37]
+	mi := &file_protobuf_models_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2169,7 +2240,7 @@ func (x *ProtoChangeProfileAttachment) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProtoChangeProfileAttachment.ProtoReflect.Descriptor instead.
 func (*ProtoChangeProfileAttachment) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{37}
+	return file_protobuf_models_proto_rawDescGZIP(), []int{38}
 }
 
 func (x *ProtoChangeProfileAttachment) GetHash() []byte {
@@ -2190,7 +2261,7 @@ type ProtoDeleteFlipAttachment struct {
 func (x *ProtoDeleteFlipAttachment) Reset() {
 	*x = ProtoDeleteFlipAttachment{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[38]
+		mi := &file_protobuf_models_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2203,7 +2274,7 @@ func (x *ProtoDeleteFlipAttachment) String() string {
 func (*ProtoDeleteFlipAttachment) ProtoMessage() {}
 
 func (x *ProtoDeleteFlipAttachment) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[38]
+	mi := &file_protobuf_models_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2216,7 +2287,7 @@ func (x *ProtoDeleteFlipAttachment) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProtoDeleteFlipAttachment.ProtoReflect.Descriptor instead.
 func (*ProtoDeleteFlipAttachment) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{38}
+	return file_protobuf_models_proto_rawDescGZIP(), []int{39}
 }
 
 func (x *ProtoDeleteFlipAttachment) GetCid() []byte {
@@ -2226,20 +2297,76 @@ func (x *ProtoDeleteFlipAttachment) GetCid() []byte {
 	return nil
 }
 
+type ProtoParamChangeAttachment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key   string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value []byte `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *ProtoParamChangeAttachment) Reset() {
+	*x = ProtoParamChangeAttachment{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_models_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProtoParamChangeAttachment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProtoParamChangeAttachment) ProtoMessage() {}
+
+func (x *ProtoParamChangeAttachment) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_models_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProtoParamChangeAttachment.ProtoReflect.Descriptor instead.
+func (*ProtoParamChangeAttachment) Descriptor() ([]byte, []int) {
+	return file_protobuf_models_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ProtoParamChangeAttachment) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *ProtoParamChangeAttachment) GetValue() []byte {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
 type ProtoStateAccount struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Nonce   uint32 `protobuf:"varint,1,opt,name=nonce,proto3" json:"nonce,omitempty"`
-	Epoch   uint32 `protobuf:"varint,2,opt,name=epoch,proto3" json:"epoch,omitempty"`
-	Balance []byte `protobuf:"bytes,3,opt,name=balance,proto3" json:"balance,omitempty"`
+	Nonce          uint32                             `protobuf:"varint,1,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	Epoch          uint32                             `protobuf:"varint,2,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Balance        []byte                             `protobuf:"bytes,3,opt,name=balance,proto3" json:"balance,omitempty"`
+	PendingRewards []*ProtoStateAccount_PendingReward `protobuf:"bytes,4,rep,name=pendingRewards,proto3" json:"pendingRewards,omitempty"`
 }
 
 func (x *ProtoStateAccount) Reset() {
 	*x = ProtoStateAccount{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[39]
+		mi := &file_protobuf_models_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2252,7 +2379,7 @@ func (x *ProtoStateAccount) String() string {
 func (*ProtoStateAccount) ProtoMessage() {}
 
 func (x *ProtoStateAccount) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[39]
+	mi := &file_protobuf_models_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2265,7 +2392,7 @@ func (x *ProtoStateAccount) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProtoStateAccount.ProtoReflect.Descriptor instead.
 func (*ProtoStateAccount) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{39}
+	return file_protobuf_models_proto_rawDescGZIP(), []int{41}
 }
 
 func (x *ProtoStateAccount) GetNonce() uint32 {
@@ -2289,34 +2416,50 @@ func (x *ProtoStateAccount) GetBalance() []byte {
 	return nil
 }
 
+func (x *ProtoStateAccount) GetPendingRewards() []*ProtoStateAccount_PendingReward {
+	if x != nil {
+		return x.PendingRewards
+	}
+	return nil
+}
+
 type ProtoStateIdentity struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Stake            []byte                       `protobuf:"bytes,1,opt,name=stake,proto3" json:"stake,omitempty"`
-	Invites          uint32                       `protobuf:"varint,2,opt,name=invites,proto3" json:"invites,omitempty"`
-	Birthday         uint32                       `protobuf:"varint,3,opt,name=birthday,proto3" json:"birthday,omitempty"`
-	State            uint32                       `protobuf:"varint,4,opt,name=state,proto3" json:"state,omitempty"`
-	QualifiedFlips   uint32                       `protobuf:"varint,5,opt,name=qualifiedFlips,proto3" json:"qualifiedFlips,omitempty"`
-	ShortFlipPoints  uint32                       `protobuf:"varint,6,opt,name=shortFlipPoints,proto3" json:"shortFlipPoints,omitempty"`
-	PubKey           []byte                       `protobuf:"bytes,7,opt,name=pubKey,proto3" json:"pubKey,omitempty"`
-	RequiredFlips    uint32                       `protobuf:"varint,8,opt,name=requiredFlips,proto3" json:"requiredFlips,omitempty"`
-	Flips            []*ProtoStateIdentity_Flip   `protobuf:"bytes,9,rep,name=flips,proto3" json:"flips,omitempty"`
-	Generation       uint32                       `protobuf:"varint,10,opt,name=generation,proto3" json:"generation,omitempty"`
-	Code             []byte                       `protobuf:"bytes,11,opt,name=code,proto3" json:"code,omitempty"`
-	Invitees         []*ProtoStateIdentity_TxAddr `protobuf:"bytes,12,rep,name=invitees,proto3" json:"invitees,omitempty"`
-	Inviter          *ProtoStateIdentity_TxAddr   `protobuf:"bytes,13,opt,name=inviter,proto3" json:"inviter,omitempty"`
-	Penalty          []byte                       `protobuf:"bytes,14,opt,name=penalty,proto3" json:"penalty,omitempty"`
-	ValidationBits   uint32                       `protobuf:"varint,15,opt,name=validationBits,proto3" json:"validationBits,omitempty"`
-	ValidationStatus uint32                       `protobuf:"varint,16,opt,name=validationStatus,proto3" json:"validationStatus,omitempty"`
-	ProfileHash      []byte                       `protobuf:"bytes,17,opt,name=profileHash,proto3" json:"profileHash,omitempty"`
+	Stake                     []byte                       `protobuf:"bytes,1,opt,name=stake,proto3" json:"stake,omitempty"`
+	Invites                   uint32                       `protobuf:"varint,2,opt,name=invites,proto3" json:"invites,omitempty"`
+	Birthday                  uint32                       `protobuf:"varint,3,opt,name=birthday,proto3" json:"birthday,omitempty"`
+	State                     uint32                       `protobuf:"varint,4,opt,name=state,proto3" json:"state,omitempty"`
+	QualifiedFlips            uint32                       `protobuf:"varint,5,opt,name=qualifiedFlips,proto3" json:"qualifiedFlips,omitempty"`
+	ShortFlipPoints           uint32                       `protobuf:"varint,6,opt,name=shortFlipPoints,proto3" json:"shortFlipPoints,omitempty"`
+	PubKey                    []byte                       `protobuf:"bytes,7,opt,name=pubKey,proto3" json:"pubKey,omitempty"`
+	RequiredFlips             uint32                       `protobuf:"varint,8,opt,name=requiredFlips,proto3" json:"requiredFlips,omitempty"`
+	Flips                     []*ProtoStateIdentity_Flip   `protobuf:"bytes,9,rep,name=flips,proto3" json:"flips,omitempty"`
+	Generation                uint32                       `protobuf:"varint,10,opt,name=generation,proto3" json:"generation,omitempty"`
+	Code                      []byte                       `protobuf:"bytes,11,opt,name=code,proto3" json:"code,omitempty"`
+	Invitees                  []*ProtoStateIdentity_TxAddr `protobuf:"bytes,12,rep,name=invitees,proto3" json:"invitees,omitempty"`
+	Inviter                   *ProtoStateIdentity_TxAddr   `protobuf:"bytes,13,opt,name=inviter,proto3" json:"inviter,omitempty"`
+	Penalty                   []byte                       `protobuf:"bytes,14,opt,name=penalty,proto3" json:"penalty,omitempty"`
+	ValidationBits            uint32                       `protobuf:"varint,15,opt,name=validationBits,proto3" json:"validationBits,omitempty"`
+	ValidationStatus          uint32                       `protobuf:"varint,16,opt,name=validationStatus,proto3" json:"validationStatus,omitempty"`
+	ProfileHash               []byte                       `protobuf:"bytes,17,opt,name=profileHash,proto3" json:"profileHash,omitempty"`
+	Delegatee                 []byte                       `protobuf:"bytes,18,opt,name=delegatee,proto3" json:"delegatee,omitempty"`
+	CreatedAtBlock            uint64                       `protobuf:"varint,19,opt,name=createdAtBlock,proto3" json:"createdAtBlock,omitempty"`
+	FlipKeyHash               []byte                       `protobuf:"bytes,20,opt,name=flipKeyHash,proto3" json:"flipKeyHash,omitempty"`
+	StakeFromBlockRewards     []byte                       `protobuf:"bytes,21,opt,name=stakeFromBlockRewards,proto3" json:"stakeFromBlockRewards,omitempty"`
+	StakeFromFeeRewards       []byte                       `protobuf:"bytes,22,opt,name=stakeFromFeeRewards,proto3" json:"stakeFromFeeRewards,omitempty"`
+	StakeFromCommitteeRewards []byte                       `protobuf:"bytes,23,opt,name=stakeFromCommitteeRewards,proto3" json:"stakeFromCommitteeRewards,omitempty"`
+	StakeFromInvites          []byte                       `protobuf:"bytes,24,opt,name=stakeFromInvites,proto3" json:"stakeFromInvites,omitempty"`
+	InviteAmount              []byte                       `protobuf:"bytes,25,opt,name=inviteAmount,proto3" json:"inviteAmount,omitempty"`
+	Delegators                uint32                       `protobuf:"varint,26,opt,name=delegators,proto3" json:"delegators,omitempty"`
 }
 
 func (x *ProtoStateIdentity) Reset() {
 	*x = ProtoStateIdentity{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[40]
+		mi := &file_protobuf_models_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2329,7 +2472,7 @@ func (x *ProtoStateIdentity) String() string {
 func (*ProtoStateIdentity) ProtoMessage() {}
 
 func (x *ProtoStateIdentity) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[40]
+	mi := &file_protobuf_models_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2342,7 +2485,7 @@ func (x *ProtoStateIdentity) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProtoStateIdentity.ProtoReflect.Descriptor instead.
 func (*ProtoStateIdentity) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{40}
+	return file_protobuf_models_proto_rawDescGZIP(), []int{42}
 }
 
 func (x *ProtoStateIdentity) GetStake() []byte {
@@ -2464,29 +2607,97 @@ func (x *ProtoStateIdentity) GetProfileHash() []byte {
 	return nil
 }
 
+func (x *ProtoStateIdentity) GetDelegatee() []byte {
+	if x != nil {
+		return x.Delegatee
+	}
+	return nil
+}
+
+func (x *ProtoStateIdentity) GetCreatedAtBlock() uint64 {
+	if x != nil {
+		return x.CreatedAtBlock
+	}
+	return 0
+}
+
+func (x *ProtoStateIdentity) GetFlipKeyHash() []byte {
+	if x != nil {
+		return x.FlipKeyHash
+	}
+	return nil
+}
+
+func (x *ProtoStateIdentity) GetStakeFromBlockRewards() []byte {
+	if x != nil {
+		return x.StakeFromBlockRewards
+	}
+	return nil
+}
+
+func (x *ProtoStateIdentity) GetStakeFromFeeRewards() []byte {
+	if x != nil {
+		return x.StakeFromFeeRewards
+	}
+	return nil
+}
+
+func (x *ProtoStateIdentity) GetStakeFromCommitteeRewards() []byte {
+	if x != nil {
+		return x.StakeFromCommitteeRewards
+	}
+	return nil
+}
+
+func (x *ProtoStateIdentity) GetStakeFromInvites() []byte {
+	if x != nil {
+		return x.StakeFromInvites
+	}
+	return nil
+}
+
+func (x *ProtoStateIdentity) GetInviteAmount() []byte {
+	if x != nil {
+		return x.InviteAmount
+	}
+	return nil
+}
+
+func (x *ProtoStateIdentity) GetDelegators() uint32 {
+	if x != nil {
+		return x.Delegators
+	}
+	return 0
+}
+
 type ProtoStateGlobal struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Epoch                         uint32 `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
-	NextValidationTime            int64  `protobuf:"varint,2,opt,name=nextValidationTime,proto3" json:"nextValidationTime,omitempty"`
-	ValidationPeriod              uint32 `protobuf:"varint,3,opt,name=validationPeriod,proto3" json:"validationPeriod,omitempty"`
-	GodAddress                    []byte `protobuf:"bytes,4,opt,name=godAddress,proto3" json:"godAddress,omitempty"`
-	WordsSeed                     []byte `protobuf:"bytes,5,opt,name=wordsSeed,proto3" json:"wordsSeed,omitempty"`
-	LastSnapshot                  uint64 `protobuf:"varint,6,opt,name=lastSnapshot,proto3" json:"lastSnapshot,omitempty"`
-	EpochBlock                    uint64 `protobuf:"varint,7,opt,name=epochBlock,proto3" json:"epochBlock,omitempty"`
-	FeePerByte                    []byte `protobuf:"bytes,8,opt,name=feePerByte,proto3" json:"feePerByte,omitempty"`
-	VrfProposerThreshold          uint64 `protobuf:"varint,9,opt,name=vrfProposerThreshold,proto3" json:"vrfProposerThreshold,omitempty"`
-	EmptyBlocksBits               []byte `protobuf:"bytes,10,opt,name=emptyBlocksBits,proto3" json:"emptyBlocksBits,omitempty"`
-	GodAddressInvites             uint32 `protobuf:"varint,11,opt,name=godAddressInvites,proto3" json:"godAddressInvites,omitempty"`
-	BlocksCntWithoutCeremonialTxs uint32 `protobuf:"varint,12,opt,name=blocksCntWithoutCeremonialTxs,proto3" json:"blocksCntWithoutCeremonialTxs,omitempty"`
+	Epoch                         uint32                                `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	NextValidationTime            int64                                 `protobuf:"varint,2,opt,name=nextValidationTime,proto3" json:"nextValidationTime,omitempty"`
+	ValidationPeriod              uint32                                `protobuf:"varint,3,opt,name=validationPeriod,proto3" json:"validationPeriod,omitempty"`
+	GodAddress                    []byte                                `protobuf:"bytes,4,opt,name=godAddress,proto3" json:"godAddress,omitempty"`
+	WordsSeed                     []byte                                `protobuf:"bytes,5,opt,name=wordsSeed,proto3" json:"wordsSeed,omitempty"`
+	LastSnapshot                  uint64                                `protobuf:"varint,6,opt,name=lastSnapshot,proto3" json:"lastSnapshot,omitempty"`
+	EpochBlock                    uint64                                `protobuf:"varint,7,opt,name=epochBlock,proto3" json:"epochBlock,omitempty"`
+	FeePerByte                    []byte                                `protobuf:"bytes,8,opt,name=feePerByte,proto3" json:"feePerByte,omitempty"`
+	VrfProposerThreshold          uint64                                `protobuf:"varint,9,opt,name=vrfProposerThreshold,proto3" json:"vrfProposerThreshold,omitempty"`
+	EmptyBlocksBits               []byte                                `protobuf:"bytes,10,opt,name=emptyBlocksBits,proto3" json:"emptyBlocksBits,omitempty"`
+	GodAddressInvites             uint32                                `protobuf:"varint,11,opt,name=godAddressInvites,proto3" json:"godAddressInvites,omitempty"`
+	BlocksCntWithoutCeremonialTxs uint32                                `protobuf:"varint,12,opt,name=blocksCntWithoutCeremonialTxs,proto3" json:"blocksCntWithoutCeremonialTxs,omitempty"`
+	PendingWithdrawals            []*ProtoStateGlobal_PendingWithdrawal `protobuf:"bytes,13,rep,name=pendingWithdrawals,proto3" json:"pendingWithdrawals,omitempty"`
+	ConsecutiveEmptyBlocks        uint32                                `protobuf:"varint,14,opt,name=consecutiveEmptyBlocks,proto3" json:"consecutiveEmptyBlocks,omitempty"`
+	RecentEmptyBlocksBits         []byte                                `protobuf:"bytes,17,opt,name=recentEmptyBlocksBits,proto3" json:"recentEmptyBlocksBits,omitempty"`
+	CommitteeRewardPool           []byte                                `protobuf:"bytes,19,opt,name=committeeRewardPool,proto3" json:"committeeRewardPool,omitempty"`
+	PatchVersion                  uint32                                `protobuf:"varint,20,opt,name=patchVersion,proto3" json:"patchVersion,omitempty"`
 }
 
 func (x *ProtoStateGlobal) Reset() {
 	*x = ProtoStateGlobal{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[41]
+		mi := &file_protobuf_models_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2499,7 +2710,7 @@ func (x *ProtoStateGlobal) String() string {
 func (*ProtoStateGlobal) ProtoMessage() {}
 
 func (x *ProtoStateGlobal) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[41]
+	mi := &file_protobuf_models_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2512,7 +2723,7 @@ func (x *ProtoStateGlobal) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProtoStateGlobal.ProtoReflect.Descriptor instead.
 func (*ProtoStateGlobal) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{41}
+	return file_protobuf_models_proto_rawDescGZIP(), []int{43}
 }
 
 func (x *ProtoStateGlobal) GetEpoch() uint32 {
@@ -2599,19 +2810,55 @@ func (x *ProtoStateGlobal) GetBlocksCntWithoutCeremonialTxs() uint32 {
 	return 0
 }
 
+func (x *ProtoStateGlobal) GetPendingWithdrawals() []*ProtoStateGlobal_PendingWithdrawal {
+	if x != nil {
+		return x.PendingWithdrawals
+	}
+	return nil
+}
+
+func (x *ProtoStateGlobal) GetConsecutiveEmptyBlocks() uint32 {
+	if x != nil {
+		return x.ConsecutiveEmptyBlocks
+	}
+	return 0
+}
+
+func (x *ProtoStateGlobal) GetRecentEmptyBlocksBits() []byte {
+	if x != nil {
+		return x.RecentEmptyBlocksBits
+	}
+	return nil
+}
+
+func (x *ProtoStateGlobal) GetCommitteeRewardPool() []byte {
+	if x != nil {
+		return x.CommitteeRewardPool
+	}
+	return nil
+}
+
+func (x *ProtoStateGlobal) GetPatchVersion() uint32 {
+	if x != nil {
+		return x.PatchVersion
+	}
+	return 0
+}
+
 type ProtoStateApprovedIdentity struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Approved bool `protobuf:"varint,1,opt,name=approved,proto3" json:"approved,omitempty"`
-	Online   bool `protobuf:"varint,2,opt,name=online,proto3" json:"online,omitempty"`
+	Approved  bool   `protobuf:"varint,1,opt,name=approved,proto3" json:"approved,omitempty"`
+	Online    bool   `protobuf:"varint,2,opt,name=online,proto3" json:"online,omitempty"`
+	Delegatee []byte `protobuf:"bytes,3,opt,name=delegatee,proto3" json:"delegatee,omitempty"`
 }
 
 func (x *ProtoStateApprovedIdentity) Reset() {
 	*x = ProtoStateApprovedIdentity{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[42]
+		mi := &file_protobuf_models_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2624,7 +2871,7 @@ func (x *ProtoStateApprovedIdentity) String() string {
 func (*ProtoStateApprovedIdentity) ProtoMessage() {}
 
 func (x *ProtoStateApprovedIdentity) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[42]
+	mi := &file_protobuf_models_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2637,7 +2884,7 @@ func (x *ProtoStateApprovedIdentity) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProtoStateApprovedIdentity.ProtoReflect.Descriptor instead.
 func (*ProtoStateApprovedIdentity) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{42}
+	return file_protobuf_models_proto_rawDescGZIP(), []int{44}
 }
 
 func (x *ProtoStateApprovedIdentity) GetApproved() bool {
@@ -2654,6 +2901,13 @@ func (x *ProtoStateApprovedIdentity) GetOnline() bool {
 	return false
 }
 
+func (x *ProtoStateApprovedIdentity) GetDelegatee() []byte {
+	if x != nil {
+		return x.Delegatee
+	}
+	return nil
+}
+
 type ProtoStateIdentityStatusSwitch struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -2665,7 +2919,7 @@ type ProtoStateIdentityStatusSwitch struct {
 func (x *ProtoStateIdentityStatusSwitch) Reset() {
 	*x = ProtoStateIdentityStatusSwitch{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[43]
+		mi := &file_protobuf_models_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2678,7 +2932,7 @@ func (x *ProtoStateIdentityStatusSwitch) String() string {
 func (*ProtoStateIdentityStatusSwitch) ProtoMessage() {}
 
 func (x *ProtoStateIdentityStatusSwitch) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[43]
+	mi := &file_protobuf_models_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2691,7 +2945,7 @@ func (x *ProtoStateIdentityStatusSwitch) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProtoStateIdentityStatusSwitch.ProtoReflect.Descriptor instead.
 func (*ProtoStateIdentityStatusSwitch) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{43}
+	return file_protobuf_models_proto_rawDescGZIP(), []int{45}
 }
 
 func (x *ProtoStateIdentityStatusSwitch) GetAddresses() [][]byte {
@@ -2701,6 +2955,61 @@ func (x *ProtoStateIdentityStatusSwitch) GetAddresses() [][]byte {
 	return nil
 }
 
+type ProtoStateConsensusParams struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Params []*ProtoStateConsensusParams_Param `protobuf:"bytes,1,rep,name=params,proto3" json:"params,omitempty"`
+	Votes  []*ProtoStateConsensusParams_Vote  `protobuf:"bytes,2,rep,name=votes,proto3" json:"votes,omitempty"`
+}
+
+func (x *ProtoStateConsensusParams) Reset() {
+	*x = ProtoStateConsensusParams{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_models_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProtoStateConsensusParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProtoStateConsensusParams) ProtoMessage() {}
+
+func (x *ProtoStateConsensusParams) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_models_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProtoStateConsensusParams.ProtoReflect.Descriptor instead.
+func (*ProtoStateConsensusParams) Descriptor() ([]byte, []int) {
+	return file_protobuf_models_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ProtoStateConsensusParams) GetParams() []*ProtoStateConsensusParams_Param {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
+func (x *ProtoStateConsensusParams) GetVotes() []*ProtoStateConsensusParams_Vote {
+	if x != nil {
+		return x.Votes
+	}
+	return nil
+}
+
 type ProtoPredefinedState struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -2718,7 +3027,7 @@ type ProtoPredefinedState struct {
 func (x *ProtoPredefinedState) Reset() {
 	*x = ProtoPredefinedState{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[44]
+		mi := &file_protobuf_models_proto_msgTypes[47]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2731,7 +3040,7 @@ func (x *ProtoPredefinedState) String() string {
 func (*ProtoPredefinedState) ProtoMessage() {}
 
 func (x *ProtoPredefinedState) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[44]
+	mi := &file_protobuf_models_proto_msgTypes[47]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2744,7 +3053,7 @@ func (x *ProtoPredefinedState) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProtoPredefinedState.ProtoReflect.Descriptor instead.
 func (*ProtoPredefinedState) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{44}
+	return file_protobuf_models_proto_rawDescGZIP(), []int{47}
 }
 
 func (x *ProtoPredefinedState) GetBlock() uint64 {
@@ -2801,20 +3110,22 @@ type ProtoTransaction_Data struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Nonce   uint32 `protobuf:"varint,1,opt,name=nonce,proto3" json:"nonce,omitempty"`
-	Epoch   uint32 `protobuf:"varint,2,opt,name=epoch,proto3" json:"epoch,omitempty"`
-	Type    uint32 `protobuf:"varint,3,opt,name=type,proto3" json:"type,omitempty"`
-	To      []byte `protobuf:"bytes,4,opt,name=to,proto3" json:"to,omitempty"`
-	Amount  []byte `protobuf:"bytes,5,opt,name=amount,proto3" json:"amount,omitempty"`
-	MaxFee  []byte `protobuf:"bytes,6,opt,name=maxFee,proto3" json:"maxFee,omitempty"`
-	Tips    []byte `protobuf:"bytes,7,opt,name=tips,proto3" json:"tips,omitempty"`
-	Payload []byte `protobuf:"bytes,8,opt,name=payload,proto3" json:"payload,omitempty"`
+	Nonce    uint32 `protobuf:"varint,1,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	Epoch    uint32 `protobuf:"varint,2,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Type     uint32 `protobuf:"varint,3,opt,name=type,proto3" json:"type,omitempty"`
+	To       []byte `protobuf:"bytes,4,opt,name=to,proto3" json:"to,omitempty"`
+	Amount   []byte `protobuf:"bytes,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	MaxFee   []byte `protobuf:"bytes,6,opt,name=maxFee,proto3" json:"maxFee,omitempty"`
+	Tips     []byte `protobuf:"bytes,7,opt,name=tips,proto3" json:"tips,omitempty"`
+	Payload  []byte `protobuf:"bytes,8,opt,name=payload,proto3" json:"payload,omitempty"`
+	MaxBlock uint64 `protobuf:"varint,9,opt,name=maxBlock,proto3" json:"maxBlock,omitempty"`
+	RefundTo []byte `protobuf:"bytes,10,opt,name=refundTo,proto3" json:"refundTo,omitempty"`
 }
 
 func (x *ProtoTransaction_Data) Reset() {
 	*x = ProtoTransaction_Data{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[45]
+		mi := &file_protobuf_models_proto_msgTypes[48]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2827,7 +3138,7 @@ func (x *ProtoTransaction_Data) String() string {
 func (*ProtoTransaction_Data) ProtoMessage() {}
 
 func (x *ProtoTransaction_Data) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[45]
+	mi := &file_protobuf_models_proto_msgTypes[48]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2899,6 +3210,20 @@ func (x *ProtoTransaction_Data) GetPayload() []byte {
 	return nil
 }
 
+func (x *ProtoTransaction_Data) GetMaxBlock() uint64 {
+	if x != nil {
+		return x.MaxBlock
+	}
+	return 0
+}
+
+func (x *ProtoTransaction_Data) GetRefundTo() []byte {
+	if x != nil {
+		return x.RefundTo
+	}
+	return nil
+}
+
 type ProtoBlockHeader_Proposed struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -2919,12 +3244,14 @@ type ProtoBlockHeader_Proposed struct {
 	FeePerByte     []byte `protobuf:"bytes,13,opt,name=feePerByte,proto3" json:"feePerByte,omitempty"`
 	Upgrade        uint32 `protobuf:"varint,14,opt,name=upgrade,proto3" json:"upgrade,omitempty"`
 	SeedProof      []byte `protobuf:"bytes,15,opt,name=seedProof,proto3" json:"seedProof,omitempty"`
+	ExtraData      []byte `protobuf:"bytes,16,opt,name=extraData,proto3" json:"extraData,omitempty"`
+	BodyRoot       []byte `protobuf:"bytes,17,opt,name=bodyRoot,proto3" json:"bodyRoot,omitempty"`
 }
 
 func (x *ProtoBlockHeader_Proposed) Reset() {
 	*x = ProtoBlockHeader_Proposed{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[46]
+		mi := &file_protobuf_models_proto_msgTypes[49]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2937,7 +3264,7 @@ func (x *ProtoBlockHeader_Proposed) String() string {
 func (*ProtoBlockHeader_Proposed) ProtoMessage() {}
 
 func (x *ProtoBlockHeader_Proposed) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[46]
+	mi := &file_protobuf_models_proto_msgTypes[49]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3058,24 +3385,41 @@ func (x *ProtoBlockHeader_Proposed) GetSeedProof() []byte {
 	return nil
 }
 
+func (x *ProtoBlockHeader_Proposed) GetExtraData() []byte {
+	if x != nil {
+		return x.ExtraData
+	}
+	return nil
+}
+
+func (x *ProtoBlockHeader_Proposed) GetBodyRoot() []byte {
+	if x != nil {
+		return x.BodyRoot
+	}
+	return nil
+}
+
 type ProtoBlockHeader_Empty struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ParentHash   []byte `protobuf:"bytes,1,opt,name=parentHash,proto3" json:"parentHash,omitempty"`
-	Height       uint64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
-	Root         []byte `protobuf:"bytes,3,opt,name=root,proto3" json:"root,omitempty"`
-	IdentityRoot []byte `protobuf:"bytes,4,opt,name=identityRoot,proto3" json:"identityRoot,omitempty"`
-	Timestamp    int64  `protobuf:"varint,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	BlockSeed    []byte `protobuf:"bytes,6,opt,name=blockSeed,proto3" json:"blockSeed,omitempty"`
-	Flags        uint32 `protobuf:"varint,7,opt,name=flags,proto3" json:"flags,omitempty"`
+	ParentHash     []byte `protobuf:"bytes,1,opt,name=parentHash,proto3" json:"parentHash,omitempty"`
+	Height         uint64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	Root           []byte `protobuf:"bytes,3,opt,name=root,proto3" json:"root,omitempty"`
+	IdentityRoot   []byte `protobuf:"bytes,4,opt,name=identityRoot,proto3" json:"identityRoot,omitempty"`
+	Timestamp      int64  `protobuf:"varint,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	BlockSeed      []byte `protobuf:"bytes,6,opt,name=blockSeed,proto3" json:"blockSeed,omitempty"`
+	Flags          uint32 `protobuf:"varint,7,opt,name=flags,proto3" json:"flags,omitempty"`
+	ProposerPubKey []byte `protobuf:"bytes,8,opt,name=proposerPubKey,proto3" json:"proposerPubKey,omitempty"`
+	SeedProof      []byte `protobuf:"bytes,9,opt,name=seedProof,proto3" json:"seedProof,omitempty"`
+	ExtraData      []byte `protobuf:"bytes,10,opt,name=extraData,proto3" json:"extraData,omitempty"`
 }
 
 func (x *ProtoBlockHeader_Empty) Reset() {
 	*x = ProtoBlockHeader_Empty{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[47]
+		mi := &file_protobuf_models_proto_msgTypes[50]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3088,7 +3432,7 @@ func (x *ProtoBlockHeader_Empty) String() string {
 func (*ProtoBlockHeader_Empty) ProtoMessage() {}
 
 func (x *ProtoBlockHeader_Empty) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[47]
+	mi := &file_protobuf_models_proto_msgTypes[50]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3153,6 +3497,27 @@ func (x *ProtoBlockHeader_Empty) GetFlags() uint32 {
 	return 0
 }
 
+func (x *ProtoBlockHeader_Empty) GetProposerPubKey() []byte {
+	if x != nil {
+		return x.ProposerPubKey
+	}
+	return nil
+}
+
+func (x *ProtoBlockHeader_Empty) GetSeedProof() []byte {
+	if x != nil {
+		return x.SeedProof
+	}
+	return nil
+}
+
+func (x *ProtoBlockHeader_Empty) GetExtraData() []byte {
+	if x != nil {
+		return x.ExtraData
+	}
+	return nil
+}
+
 type ProtoBlockProposal_Data struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -3166,7 +3531,7 @@ type ProtoBlockProposal_Data struct {
 func (x *ProtoBlockProposal_Data) Reset() {
 	*x = ProtoBlockProposal_Data{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[48]
+		mi := &file_protobuf_models_proto_msgTypes[51]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3179,7 +3544,7 @@ func (x *ProtoBlockProposal_Data) String() string {
 func (*ProtoBlockProposal_Data) ProtoMessage() {}
 
 func (x *ProtoBlockProposal_Data) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[48]
+	mi := &file_protobuf_models_proto_msgTypes[51]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3229,7 +3594,7 @@ type ProtoBlockCert_Signature struct {
 func (x *ProtoBlockCert_Signature) Reset() {
 	*x = ProtoBlockCert_Signature{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[49]
+		mi := &file_protobuf_models_proto_msgTypes[52]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3242,7 +3607,7 @@ func (x *ProtoBlockCert_Signature) String() string {
 func (*ProtoBlockCert_Signature) ProtoMessage() {}
 
 func (x *ProtoBlockCert_Signature) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[49]
+	mi := &file_protobuf_models_proto_msgTypes[52]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3292,7 +3657,7 @@ type ProtoIdentityStateDiff_IdentityStateDiffValue struct {
 func (x *ProtoIdentityStateDiff_IdentityStateDiffValue) Reset() {
 	*x = ProtoIdentityStateDiff_IdentityStateDiffValue{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[50]
+		mi := &file_protobuf_models_proto_msgTypes[53]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3305,7 +3670,7 @@ func (x *ProtoIdentityStateDiff_IdentityStateDiffValue) String() string {
 func (*ProtoIdentityStateDiff_IdentityStateDiffValue) ProtoMessage() {}
 
 func (x *ProtoIdentityStateDiff_IdentityStateDiffValue) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[50]
+	mi := &file_protobuf_models_proto_msgTypes[53]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3354,7 +3719,7 @@ type ProtoSnapshotBlock_KeyValue struct {
 func (x *ProtoSnapshotBlock_KeyValue) Reset() {
 	*x = ProtoSnapshotBlock_KeyValue{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[51]
+		mi := &file_protobuf_models_proto_msgTypes[54]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3367,7 +3732,7 @@ func (x *ProtoSnapshotBlock_KeyValue) String() string {
 func (*ProtoSnapshotBlock_KeyValue) ProtoMessage() {}
 
 func (x *ProtoSnapshotBlock_KeyValue) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[51]
+	mi := &file_protobuf_models_proto_msgTypes[54]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3410,7 +3775,7 @@ type ProtoGossipBlockRange_Block struct {
 func (x *ProtoGossipBlockRange_Block) Reset() {
 	*x = ProtoGossipBlockRange_Block{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[52]
+		mi := &file_protobuf_models_proto_msgTypes[55]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3423,7 +3788,7 @@ func (x *ProtoGossipBlockRange_Block) String() string {
 func (*ProtoGossipBlockRange_Block) ProtoMessage() {}
 
 func (x *ProtoGossipBlockRange_Block) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[52]
+	mi := &file_protobuf_models_proto_msgTypes[55]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3472,7 +3837,7 @@ type ProtoProposeProof_Data struct {
 func (x *ProtoProposeProof_Data) Reset() {
 	*x = ProtoProposeProof_Data{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[53]
+		mi := &file_protobuf_models_proto_msgTypes[56]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3485,7 +3850,7 @@ func (x *ProtoProposeProof_Data) String() string {
 func (*ProtoProposeProof_Data) ProtoMessage() {}
 
 func (x *ProtoProposeProof_Data) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[53]
+	mi := &file_protobuf_models_proto_msgTypes[56]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3531,7 +3896,7 @@ type ProtoVote_Data struct {
 func (x *ProtoVote_Data) Reset() {
 	*x = ProtoVote_Data{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[54]
+		mi := &file_protobuf_models_proto_msgTypes[57]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3544,7 +3909,7 @@ func (x *ProtoVote_Data) String() string {
 func (*ProtoVote_Data) ProtoMessage() {}
 
 func (x *ProtoVote_Data) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[54]
+	mi := &file_protobuf_models_proto_msgTypes[57]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3614,7 +3979,7 @@ type ProtoFlipKey_Data struct {
 func (x *ProtoFlipKey_Data) Reset() {
 	*x = ProtoFlipKey_Data{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[55]
+		mi := &file_protobuf_models_proto_msgTypes[58]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3627,7 +3992,7 @@ func (x *ProtoFlipKey_Data) String() string {
 func (*ProtoFlipKey_Data) ProtoMessage() {}
 
 func (x *ProtoFlipKey_Data) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[55]
+	mi := &file_protobuf_models_proto_msgTypes[58]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3669,7 +4034,7 @@ type ProtoPrivateFlipKeysPackage_Data struct {
 func (x *ProtoPrivateFlipKeysPackage_Data) Reset() {
 	*x = ProtoPrivateFlipKeysPackage_Data{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[56]
+		mi := &file_protobuf_models_proto_msgTypes[59]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3682,7 +4047,7 @@ func (x *ProtoPrivateFlipKeysPackage_Data) String() string {
 func (*ProtoPrivateFlipKeysPackage_Data) ProtoMessage() {}
 
 func (x *ProtoPrivateFlipKeysPackage_Data) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[56]
+	mi := &file_protobuf_models_proto_msgTypes[59]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3724,7 +4089,7 @@ type ProtoAnswersDb_Answer struct {
 func (x *ProtoAnswersDb_Answer) Reset() {
 	*x = ProtoAnswersDb_Answer{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[57]
+		mi := &file_protobuf_models_proto_msgTypes[60]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3737,7 +4102,7 @@ func (x *ProtoAnswersDb_Answer) String() string {
 func (*ProtoAnswersDb_Answer) ProtoMessage() {}
 
 func (x *ProtoAnswersDb_Answer) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[57]
+	mi := &file_protobuf_models_proto_msgTypes[60]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3779,20 +4144,241 @@ type ProtoActivityMonitor_Activity struct {
 func (x *ProtoActivityMonitor_Activity) Reset() {
 	*x = ProtoActivityMonitor_Activity{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[58]
+		mi := &file_protobuf_models_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProtoActivityMonitor_Activity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProtoActivityMonitor_Activity) ProtoMessage() {}
+
+func (x *ProtoActivityMonitor_Activity) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_models_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProtoActivityMonitor_Activity.ProtoReflect.Descriptor instead.
+func (*ProtoActivityMonitor_Activity) Descriptor() ([]byte, []int) {
+	return file_protobuf_models_proto_rawDescGZIP(), []int{32, 0}
+}
+
+func (x *ProtoActivityMonitor_Activity) GetAddress() []byte {
+	if x != nil {
+		return x.Address
+	}
+	return nil
+}
+
+func (x *ProtoActivityMonitor_Activity) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+type ProtoStateAccount_PendingReward struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Amount []byte `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	Epoch  uint32 `protobuf:"varint,2,opt,name=epoch,proto3" json:"epoch,omitempty"`
+}
+
+func (x *ProtoStateAccount_PendingReward) Reset() {
+	*x = ProtoStateAccount_PendingReward{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_models_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProtoStateAccount_PendingReward) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProtoStateAccount_PendingReward) ProtoMessage() {}
+
+func (x *ProtoStateAccount_PendingReward) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_models_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProtoStateAccount_PendingReward.ProtoReflect.Descriptor instead.
+func (*ProtoStateAccount_PendingReward) Descriptor() ([]byte, []int) {
+	return file_protobuf_models_proto_rawDescGZIP(), []int{41, 0}
+}
+
+func (x *ProtoStateAccount_PendingReward) GetAmount() []byte {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *ProtoStateAccount_PendingReward) GetEpoch() uint32 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+type ProtoStateIdentity_Flip struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Cid  []byte `protobuf:"bytes,1,opt,name=cid,proto3" json:"cid,omitempty"`
+	Pair uint32 `protobuf:"varint,2,opt,name=pair,proto3" json:"pair,omitempty"`
+}
+
+func (x *ProtoStateIdentity_Flip) Reset() {
+	*x = ProtoStateIdentity_Flip{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_models_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProtoStateIdentity_Flip) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProtoStateIdentity_Flip) ProtoMessage() {}
+
+func (x *ProtoStateIdentity_Flip) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_models_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProtoStateIdentity_Flip.ProtoReflect.Descriptor instead.
+func (*ProtoStateIdentity_Flip) Descriptor() ([]byte, []int) {
+	return file_protobuf_models_proto_rawDescGZIP(), []int{42, 0}
+}
+
+func (x *ProtoStateIdentity_Flip) GetCid() []byte {
+	if x != nil {
+		return x.Cid
+	}
+	return nil
+}
+
+func (x *ProtoStateIdentity_Flip) GetPair() uint32 {
+	if x != nil {
+		return x.Pair
+	}
+	return 0
+}
+
+type ProtoStateIdentity_TxAddr struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Hash    []byte `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	Address []byte `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (x *ProtoStateIdentity_TxAddr) Reset() {
+	*x = ProtoStateIdentity_TxAddr{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_models_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProtoStateIdentity_TxAddr) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProtoStateIdentity_TxAddr) ProtoMessage() {}
+
+func (x *ProtoStateIdentity_TxAddr) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_models_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProtoStateIdentity_TxAddr.ProtoReflect.Descriptor instead.
+func (*ProtoStateIdentity_TxAddr) Descriptor() ([]byte, []int) {
+	return file_protobuf_models_proto_rawDescGZIP(), []int{42, 1}
+}
+
+func (x *ProtoStateIdentity_TxAddr) GetHash() []byte {
+	if x != nil {
+		return x.Hash
+	}
+	return nil
+}
+
+func (x *ProtoStateIdentity_TxAddr) GetAddress() []byte {
+	if x != nil {
+		return x.Address
+	}
+	return nil
+}
+
+type ProtoStateGlobal_PendingWithdrawal struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address     []byte `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Amount      []byte `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	UnlockBlock uint64 `protobuf:"varint,3,opt,name=unlockBlock,proto3" json:"unlockBlock,omitempty"`
+}
+
+func (x *ProtoStateGlobal_PendingWithdrawal) Reset() {
+	*x = ProtoStateGlobal_PendingWithdrawal{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_models_proto_msgTypes[65]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ProtoActivityMonitor_Activity) String() string {
+func (x *ProtoStateGlobal_PendingWithdrawal) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProtoActivityMonitor_Activity) ProtoMessage() {}
+func (*ProtoStateGlobal_PendingWithdrawal) ProtoMessage() {}
 
-func (x *ProtoActivityMonitor_Activity) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[58]
+func (x *ProtoStateGlobal_PendingWithdrawal) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_models_proto_msgTypes[65]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3803,51 +4389,58 @@ func (x *ProtoActivityMonitor_Activity) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProtoActivityMonitor_Activity.ProtoReflect.Descriptor instead.
-func (*ProtoActivityMonitor_Activity) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{31, 0}
+// Deprecated: Use ProtoStateGlobal_PendingWithdrawal.ProtoReflect.Descriptor instead.
+func (*ProtoStateGlobal_PendingWithdrawal) Descriptor() ([]byte, []int) {
+	return file_protobuf_models_proto_rawDescGZIP(), []int{43, 0}
 }
 
-func (x *ProtoActivityMonitor_Activity) GetAddress() []byte {
+func (x *ProtoStateGlobal_PendingWithdrawal) GetAddress() []byte {
 	if x != nil {
 		return x.Address
 	}
 	return nil
 }
 
-func (x *ProtoActivityMonitor_Activity) GetTimestamp() int64 {
+func (x *ProtoStateGlobal_PendingWithdrawal) GetAmount() []byte {
 	if x != nil {
-		return x.Timestamp
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *ProtoStateGlobal_PendingWithdrawal) GetUnlockBlock() uint64 {
+	if x != nil {
+		return x.UnlockBlock
 	}
 	return 0
 }
 
-type ProtoStateIdentity_Flip struct {
+type ProtoStateConsensusParams_Param struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Cid  []byte `protobuf:"bytes,1,opt,name=cid,proto3" json:"cid,omitempty"`
-	Pair uint32 `protobuf:"varint,2,opt,name=pair,proto3" json:"pair,omitempty"`
+	Key   string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value []byte `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
 }
 
-func (x *ProtoStateIdentity_Flip) Reset() {
-	*x = ProtoStateIdentity_Flip{}
+func (x *ProtoStateConsensusParams_Param) Reset() {
+	*x = ProtoStateConsensusParams_Param{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[59]
+		mi := &file_protobuf_models_proto_msgTypes[66]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ProtoStateIdentity_Flip) String() string {
+func (x *ProtoStateConsensusParams_Param) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProtoStateIdentity_Flip) ProtoMessage() {}
+func (*ProtoStateConsensusParams_Param) ProtoMessage() {}
 
-func (x *ProtoStateIdentity_Flip) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[59]
+func (x *ProtoStateConsensusParams_Param) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_models_proto_msgTypes[66]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3858,51 +4451,52 @@ func (x *ProtoStateIdentity_Flip) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProtoStateIdentity_Flip.ProtoReflect.Descriptor instead.
-func (*ProtoStateIdentity_Flip) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{40, 0}
+// Deprecated: Use ProtoStateConsensusParams_Param.ProtoReflect.Descriptor instead.
+func (*ProtoStateConsensusParams_Param) Descriptor() ([]byte, []int) {
+	return file_protobuf_models_proto_rawDescGZIP(), []int{46, 0}
 }
 
-func (x *ProtoStateIdentity_Flip) GetCid() []byte {
+func (x *ProtoStateConsensusParams_Param) GetKey() string {
 	if x != nil {
-		return x.Cid
+		return x.Key
 	}
-	return nil
+	return ""
 }
 
-func (x *ProtoStateIdentity_Flip) GetPair() uint32 {
+func (x *ProtoStateConsensusParams_Param) GetValue() []byte {
 	if x != nil {
-		return x.Pair
+		return x.Value
 	}
-	return 0
+	return nil
 }
 
-type ProtoStateIdentity_TxAddr struct {
+type ProtoStateConsensusParams_Vote struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Hash    []byte `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
-	Address []byte `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Key   string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value []byte `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	Voter []byte `protobuf:"bytes,3,opt,name=voter,proto3" json:"voter,omitempty"`
 }
 
-func (x *ProtoStateIdentity_TxAddr) Reset() {
-	*x = ProtoStateIdentity_TxAddr{}
+func (x *ProtoStateConsensusParams_Vote) Reset() {
+	*x = ProtoStateConsensusParams_Vote{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[60]
+		mi := &file_protobuf_models_proto_msgTypes[67]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ProtoStateIdentity_TxAddr) String() string {
+func (x *ProtoStateConsensusParams_Vote) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProtoStateIdentity_TxAddr) ProtoMessage() {}
+func (*ProtoStateConsensusParams_Vote) ProtoMessage() {}
 
-func (x *ProtoStateIdentity_TxAddr) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[60]
+func (x *ProtoStateConsensusParams_Vote) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_models_proto_msgTypes[67]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3913,21 +4507,28 @@ func (x *ProtoStateIdentity_TxAddr) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProtoStateIdentity_TxAddr.ProtoReflect.Descriptor instead.
-func (*ProtoStateIdentity_TxAddr) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{40, 1}
+// Deprecated: Use ProtoStateConsensusParams_Vote.ProtoReflect.Descriptor instead.
+func (*ProtoStateConsensusParams_Vote) Descriptor() ([]byte, []int) {
+	return file_protobuf_models_proto_rawDescGZIP(), []int{46, 1}
 }
 
-func (x *ProtoStateIdentity_TxAddr) GetHash() []byte {
+func (x *ProtoStateConsensusParams_Vote) GetKey() string {
 	if x != nil {
-		return x.Hash
+		return x.Key
+	}
+	return ""
+}
+
+func (x *ProtoStateConsensusParams_Vote) GetValue() []byte {
+	if x != nil {
+		return x.Value
 	}
 	return nil
 }
 
-func (x *ProtoStateIdentity_TxAddr) GetAddress() []byte {
+func (x *ProtoStateConsensusParams_Vote) GetVoter() []byte {
 	if x != nil {
-		return x.Address
+		return x.Voter
 	}
 	return nil
 }
@@ -3949,12 +4550,14 @@ type ProtoPredefinedState_Global struct {
 	EmptyBlocksBits               []byte `protobuf:"bytes,10,opt,name=emptyBlocksBits,proto3" json:"emptyBlocksBits,omitempty"`
 	GodAddressInvites             uint32 `protobuf:"varint,11,opt,name=godAddressInvites,proto3" json:"godAddressInvites,omitempty"`
 	BlocksCntWithoutCeremonialTxs uint32 `protobuf:"varint,12,opt,name=blocksCntWithoutCeremonialTxs,proto3" json:"blocksCntWithoutCeremonialTxs,omitempty"`
+	ConsecutiveEmptyBlocks        uint32 `protobuf:"varint,13,opt,name=consecutiveEmptyBlocks,proto3" json:"consecutiveEmptyBlocks,omitempty"`
+	RecentEmptyBlocksBits         []byte `protobuf:"bytes,14,opt,name=recentEmptyBlocksBits,proto3" json:"recentEmptyBlocksBits,omitempty"`
 }
 
 func (x *ProtoPredefinedState_Global) Reset() {
 	*x = ProtoPredefinedState_Global{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[61]
+		mi := &file_protobuf_models_proto_msgTypes[68]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3967,7 +4570,7 @@ func (x *ProtoPredefinedState_Global) String() string {
 func (*ProtoPredefinedState_Global) ProtoMessage() {}
 
 func (x *ProtoPredefinedState_Global) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[61]
+	mi := &file_protobuf_models_proto_msgTypes[68]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3980,7 +4583,7 @@ func (x *ProtoPredefinedState_Global) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProtoPredefinedState_Global.ProtoReflect.Descriptor instead.
 func (*ProtoPredefinedState_Global) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{44, 0}
+	return file_protobuf_models_proto_rawDescGZIP(), []int{47, 0}
 }
 
 func (x *ProtoPredefinedState_Global) GetEpoch() uint32 {
@@ -4067,6 +4670,20 @@ func (x *ProtoPredefinedState_Global) GetBlocksCntWithoutCeremonialTxs() uint32
 	return 0
 }
 
+func (x *ProtoPredefinedState_Global) GetConsecutiveEmptyBlocks() uint32 {
+	if x != nil {
+		return x.ConsecutiveEmptyBlocks
+	}
+	return 0
+}
+
+func (x *ProtoPredefinedState_Global) GetRecentEmptyBlocksBits() []byte {
+	if x != nil {
+		return x.RecentEmptyBlocksBits
+	}
+	return nil
+}
+
 type ProtoPredefinedState_StatusSwitch struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -4078,7 +4695,7 @@ type ProtoPredefinedState_StatusSwitch struct {
 func (x *ProtoPredefinedState_StatusSwitch) Reset() {
 	*x = ProtoPredefinedState_StatusSwitch{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[62]
+		mi := &file_protobuf_models_proto_msgTypes[69]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4091,7 +4708,7 @@ func (x *ProtoPredefinedState_StatusSwitch) String() string {
 func (*ProtoPredefinedState_StatusSwitch) ProtoMessage() {}
 
 func (x *ProtoPredefinedState_StatusSwitch) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[62]
+	mi := &file_protobuf_models_proto_msgTypes[69]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4104,7 +4721,7 @@ func (x *ProtoPredefinedState_StatusSwitch) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use ProtoPredefinedState_StatusSwitch.ProtoReflect.Descriptor instead.
 func (*ProtoPredefinedState_StatusSwitch) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{44, 1}
+	return file_protobuf_models_proto_rawDescGZIP(), []int{47, 1}
 }
 
 func (x *ProtoPredefinedState_StatusSwitch) GetAddresses() [][]byte {
@@ -4128,7 +4745,7 @@ type ProtoPredefinedState_Account struct {
 func (x *ProtoPredefinedState_Account) Reset() {
 	*x = ProtoPredefinedState_Account{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[63]
+		mi := &file_protobuf_models_proto_msgTypes[70]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4141,7 +4758,7 @@ func (x *ProtoPredefinedState_Account) String() string {
 func (*ProtoPredefinedState_Account) ProtoMessage() {}
 
 func (x *ProtoPredefinedState_Account) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[63]
+	mi := &file_protobuf_models_proto_msgTypes[70]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4154,7 +4771,7 @@ func (x *ProtoPredefinedState_Account) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProtoPredefinedState_Account.ProtoReflect.Descriptor instead.
 func (*ProtoPredefinedState_Account) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{44, 2}
+	return file_protobuf_models_proto_rawDescGZIP(), []int{47, 2}
 }
 
 func (x *ProtoPredefinedState_Account) GetAddress() []byte {
@@ -4213,7 +4830,7 @@ type ProtoPredefinedState_Identity struct {
 func (x *ProtoPredefinedState_Identity) Reset() {
 	*x = ProtoPredefinedState_Identity{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[64]
+		mi := &file_protobuf_models_proto_msgTypes[71]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4226,7 +4843,7 @@ func (x *ProtoPredefinedState_Identity) String() string {
 func (*ProtoPredefinedState_Identity) ProtoMessage() {}
 
 func (x *ProtoPredefinedState_Identity) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[64]
+	mi := &file_protobuf_models_proto_msgTypes[71]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4239,7 +4856,7 @@ func (x *ProtoPredefinedState_Identity) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProtoPredefinedState_Identity.ProtoReflect.Descriptor instead.
 func (*ProtoPredefinedState_Identity) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{44, 3}
+	return file_protobuf_models_proto_rawDescGZIP(), []int{47, 3}
 }
 
 func (x *ProtoPredefinedState_Identity) GetAddress() []byte {
@@ -4381,7 +4998,7 @@ type ProtoPredefinedState_ApprovedIdentity struct {
 func (x *ProtoPredefinedState_ApprovedIdentity) Reset() {
 	*x = ProtoPredefinedState_ApprovedIdentity{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[65]
+		mi := &file_protobuf_models_proto_msgTypes[72]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4394,7 +5011,7 @@ func (x *ProtoPredefinedState_ApprovedIdentity) String() string {
 func (*ProtoPredefinedState_ApprovedIdentity) ProtoMessage() {}
 
 func (x *ProtoPredefinedState_ApprovedIdentity) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[65]
+	mi := &file_protobuf_models_proto_msgTypes[72]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4407,7 +5024,7 @@ func (x *ProtoPredefinedState_ApprovedIdentity) ProtoReflect() protoreflect.Mess
 
 // Deprecated: Use ProtoPredefinedState_ApprovedIdentity.ProtoReflect.Descriptor instead.
 func (*ProtoPredefinedState_ApprovedIdentity) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{44, 4}
+	return file_protobuf_models_proto_rawDescGZIP(), []int{47, 4}
 }
 
 func (x *ProtoPredefinedState_ApprovedIdentity) GetAddress() []byte {
@@ -4443,7 +5060,7 @@ type ProtoPredefinedState_Identity_Flip struct {
 func (x *ProtoPredefinedState_Identity_Flip) Reset() {
 	*x = ProtoPredefinedState_Identity_Flip{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[66]
+		mi := &file_protobuf_models_proto_msgTypes[73]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4456,7 +5073,7 @@ func (x *ProtoPredefinedState_Identity_Flip) String() string {
 func (*ProtoPredefinedState_Identity_Flip) ProtoMessage() {}
 
 func (x *ProtoPredefinedState_Identity_Flip) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[66]
+	mi := &file_protobuf_models_proto_msgTypes[73]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4469,7 +5086,7 @@ func (x *ProtoPredefinedState_Identity_Flip) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use ProtoPredefinedState_Identity_Flip.ProtoReflect.Descriptor instead.
 func (*ProtoPredefinedState_Identity_Flip) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{44, 3, 0}
+	return file_protobuf_models_proto_rawDescGZIP(), []int{47, 3, 0}
 }
 
 func (x *ProtoPredefinedState_Identity_Flip) GetCid() []byte {
@@ -4498,7 +5115,7 @@ type ProtoPredefinedState_Identity_TxAddr struct {
 func (x *ProtoPredefinedState_Identity_TxAddr) Reset() {
 	*x = ProtoPredefinedState_Identity_TxAddr{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[67]
+		mi := &file_protobuf_models_proto_msgTypes[74]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4511,7 +5128,7 @@ func (x *ProtoPredefinedState_Identity_TxAddr) String() string {
 func (*ProtoPredefinedState_Identity_TxAddr) ProtoMessage() {}
 
 func (x *ProtoPredefinedState_Identity_TxAddr) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[67]
+	mi := &file_protobuf_models_proto_msgTypes[74]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4524,7 +5141,7 @@ func (x *ProtoPredefinedState_Identity_TxAddr) ProtoReflect() protoreflect.Messa
 
 // Deprecated: Use ProtoPredefinedState_Identity_TxAddr.ProtoReflect.Descriptor instead.
 func (*ProtoPredefinedState_Identity_TxAddr) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{44, 3, 1}
+	return file_protobuf_models_proto_rawDescGZIP(), []int{47, 3, 1}
 }
 
 func (x *ProtoPredefinedState_Identity_TxAddr) GetHash() []byte {
@@ -4546,14 +5163,14 @@ var File_protobuf_models_proto protoreflect.FileDescriptor
 var file_protobuf_models_proto_rawDesc = []byte{
 	0x0a, 0x15, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
 	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x06, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x22,
-	0xb2, 0x02, 0x0a, 0x10, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0xea, 0x02, 0x0a, 0x10, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
 	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x31, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01,
 	0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74,
 	0x6f, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x44, 0x61, 0x74,
 	0x61, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61,
 	0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e,
 	0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x52, 0x6c, 0x70, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x75, 0x73, 0x65, 0x52, 0x6c, 0x70, 0x1a, 0xb4, 0x01,
+	0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x75, 0x73, 0x65, 0x52, 0x6c, 0x70, 0x1a, 0xec, 0x01,
 	0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18,
 	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05,
 	0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x65, 0x70, 0x6f,
@@ -4565,545 +5182,654 @@ var file_protobuf_models_proto_rawDesc = []byte{
 	0x6d, 0x61, 0x78, 0x46, 0x65, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x70, 0x73, 0x18, 0x07,
 	0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x74, 0x69, 0x70, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61,
 	0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x70, 0x61, 0x79,
-	0x6c, 0x6f, 0x61, 0x64, 0x22, 0xaa, 0x06, 0x0a, 0x10, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x42, 0x6c,
-	0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x49, 0x0a, 0x0e, 0x70, 0x72, 0x6f,
-	0x70, 0x6f, 0x73, 0x65, 0x64, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x21, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f,
-	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x2e, 0x50, 0x72, 0x6f, 0x70,
-	0x6f, 0x73, 0x65, 0x64, 0x52, 0x0e, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x64, 0x48, 0x65,
-	0x61, 0x64, 0x65, 0x72, 0x12, 0x40, 0x0a, 0x0b, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x48, 0x65, 0x61,
-	0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6d, 0x6f, 0x64, 0x65,
+	0x6c, 0x6f, 0x61, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x61, 0x78, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x54, 0x6f, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x08, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x54, 0x6f, 0x22, 0xc8, 0x07, 0x0a,
+	0x10, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x12, 0x49, 0x0a, 0x0e, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x64, 0x48, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6d, 0x6f, 0x64, 0x65,
 	0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61,
-	0x64, 0x65, 0x72, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x0b, 0x65, 0x6d, 0x70, 0x74, 0x79,
-	0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x1a, 0xbc, 0x03, 0x0a, 0x08, 0x50, 0x72, 0x6f, 0x70, 0x6f,
-	0x73, 0x65, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x48, 0x61, 0x73,
-	0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x48,
-	0x61, 0x73, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x74,
-	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
-	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x78, 0x48,
-	0x61, 0x73, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x74, 0x78, 0x48, 0x61, 0x73,
-	0x68, 0x12, 0x26, 0x0a, 0x0e, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x50, 0x75, 0x62,
-	0x4b, 0x65, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0e, 0x70, 0x72, 0x6f, 0x70, 0x6f,
-	0x73, 0x65, 0x72, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6f,
-	0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x72, 0x6f, 0x6f, 0x74, 0x12, 0x22, 0x0a,
-	0x0c, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x6f, 0x6f, 0x74, 0x18, 0x07, 0x20,
+	0x64, 0x65, 0x72, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x64, 0x52, 0x0e, 0x70, 0x72,
+	0x6f, 0x70, 0x6f, 0x73, 0x65, 0x64, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x40, 0x0a, 0x0b,
+	0x65, 0x6d, 0x70, 0x74, 0x79, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1e, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x2e, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x52, 0x0b, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x1a, 0xf6,
+	0x03, 0x0a, 0x08, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x70,
+	0x61, 0x72, 0x65, 0x6e, 0x74, 0x48, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x0a, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x48, 0x61, 0x73, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x68,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x68, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x78, 0x48, 0x61, 0x73, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x06, 0x74, 0x78, 0x48, 0x61, 0x73, 0x68, 0x12, 0x26, 0x0a, 0x0e, 0x70, 0x72, 0x6f,
+	0x70, 0x6f, 0x73, 0x65, 0x72, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x0e, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x50, 0x75, 0x62, 0x4b, 0x65,
+	0x79, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x04, 0x72, 0x6f, 0x6f, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x52, 0x6f, 0x6f, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x69, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6c, 0x61,
+	0x67, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x12,
+	0x1a, 0x0a, 0x08, 0x69, 0x70, 0x66, 0x73, 0x48, 0x61, 0x73, 0x68, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x08, 0x69, 0x70, 0x66, 0x73, 0x48, 0x61, 0x73, 0x68, 0x12, 0x20, 0x0a, 0x0b, 0x6f,
+	0x66, 0x66, 0x6c, 0x69, 0x6e, 0x65, 0x41, 0x64, 0x64, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x0b, 0x6f, 0x66, 0x66, 0x6c, 0x69, 0x6e, 0x65, 0x41, 0x64, 0x64, 0x72, 0x12, 0x18, 0x0a,
+	0x07, 0x74, 0x78, 0x42, 0x6c, 0x6f, 0x6f, 0x6d, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07,
+	0x74, 0x78, 0x42, 0x6c, 0x6f, 0x6f, 0x6d, 0x12, 0x1c, 0x0a, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x53, 0x65, 0x65, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x53, 0x65, 0x65, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x66, 0x65, 0x65, 0x50, 0x65, 0x72, 0x42,
+	0x79, 0x74, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x66, 0x65, 0x65, 0x50, 0x65,
+	0x72, 0x42, 0x79, 0x74, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65,
+	0x18, 0x0e, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x75, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x12,
+	0x1c, 0x0a, 0x09, 0x73, 0x65, 0x65, 0x64, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x18, 0x0f, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x09, 0x73, 0x65, 0x65, 0x64, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x1c, 0x0a,
+	0x09, 0x65, 0x78, 0x74, 0x72, 0x61, 0x44, 0x61, 0x74, 0x61, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x09, 0x65, 0x78, 0x74, 0x72, 0x61, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1a, 0x0a, 0x08, 0x62,
+	0x6f, 0x64, 0x79, 0x52, 0x6f, 0x6f, 0x74, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x62,
+	0x6f, 0x64, 0x79, 0x52, 0x6f, 0x6f, 0x74, 0x1a, 0xad, 0x02, 0x0a, 0x05, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x48, 0x61, 0x73, 0x68, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x48, 0x61, 0x73,
+	0x68, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6f,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x72, 0x6f, 0x6f, 0x74, 0x12, 0x22, 0x0a,
+	0x0c, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x6f, 0x6f, 0x74, 0x18, 0x04, 0x20,
 	0x01, 0x28, 0x0c, 0x52, 0x0c, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x6f, 0x6f,
-	0x74, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x70, 0x66, 0x73, 0x48,
-	0x61, 0x73, 0x68, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x69, 0x70, 0x66, 0x73, 0x48,
-	0x61, 0x73, 0x68, 0x12, 0x20, 0x0a, 0x0b, 0x6f, 0x66, 0x66, 0x6c, 0x69, 0x6e, 0x65, 0x41, 0x64,
-	0x64, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x6f, 0x66, 0x66, 0x6c, 0x69, 0x6e,
-	0x65, 0x41, 0x64, 0x64, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x78, 0x42, 0x6c, 0x6f, 0x6f, 0x6d,
-	0x18, 0x0b, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x74, 0x78, 0x42, 0x6c, 0x6f, 0x6f, 0x6d, 0x12,
-	0x1c, 0x0a, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x65, 0x65, 0x64, 0x18, 0x0c, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x65, 0x65, 0x64, 0x12, 0x1e, 0x0a,
-	0x0a, 0x66, 0x65, 0x65, 0x50, 0x65, 0x72, 0x42, 0x79, 0x74, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x0a, 0x66, 0x65, 0x65, 0x50, 0x65, 0x72, 0x42, 0x79, 0x74, 0x65, 0x12, 0x18, 0x0a,
-	0x07, 0x75, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07,
-	0x75, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x65, 0x64, 0x50,
-	0x72, 0x6f, 0x6f, 0x66, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x65, 0x65, 0x64,
-	0x50, 0x72, 0x6f, 0x6f, 0x66, 0x1a, 0xc9, 0x01, 0x0a, 0x05, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12,
-	0x1e, 0x0a, 0x0a, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x48, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x0a, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x48, 0x61, 0x73, 0x68, 0x12,
+	0x74, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12,
+	0x1c, 0x0a, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x65, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x65, 0x65, 0x64, 0x12, 0x14, 0x0a,
+	0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x66, 0x6c,
+	0x61, 0x67, 0x73, 0x12, 0x26, 0x0a, 0x0e, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x50,
+	0x75, 0x62, 0x4b, 0x65, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0e, 0x70, 0x72, 0x6f,
+	0x70, 0x6f, 0x73, 0x65, 0x72, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x73,
+	0x65, 0x65, 0x64, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09,
+	0x73, 0x65, 0x65, 0x64, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x78, 0x74,
+	0x72, 0x61, 0x44, 0x61, 0x74, 0x61, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x65, 0x78,
+	0x74, 0x72, 0x61, 0x44, 0x61, 0x74, 0x61, 0x22, 0x4e, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x74, 0x6f,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x3c, 0x0a, 0x0c, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x18, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x74, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x6a, 0x0a, 0x0a, 0x50, 0x72, 0x6f, 0x74, 0x6f,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x30, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52,
+	0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x2a, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x42, 0x6f, 0x64, 0x79, 0x52, 0x04, 0x62,
+	0x6f, 0x64, 0x79, 0x22, 0xe3, 0x01, 0x0a, 0x12, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x12, 0x33, 0x0a, 0x04, 0x64, 0x61,
+	0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
+	0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x50, 0x72, 0x6f, 0x70,
+	0x6f, 0x73, 0x61, 0x6c, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12,
+	0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x1a, 0x7a, 0x0a,
+	0x04, 0x44, 0x61, 0x74, 0x61, 0x12, 0x30, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52,
+	0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x2a, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x42, 0x6f, 0x64, 0x79, 0x52, 0x04, 0x62,
+	0x6f, 0x64, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x05, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x22, 0x69, 0x0a, 0x0d, 0x50, 0x72, 0x6f,
+	0x74, 0x6f, 0x49, 0x70, 0x66, 0x73, 0x46, 0x6c, 0x69, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x75,
+	0x62, 0x4b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x70, 0x75, 0x62, 0x4b,
+	0x65, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x50, 0x61, 0x72, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x50, 0x61,
+	0x72, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65,
+	0x50, 0x61, 0x72, 0x74, 0x22, 0x81, 0x02, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x43, 0x65, 0x72, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x73, 0x74, 0x65, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x73, 0x74, 0x65,
+	0x70, 0x12, 0x1c, 0x0a, 0x09, 0x76, 0x6f, 0x74, 0x65, 0x64, 0x48, 0x61, 0x73, 0x68, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x76, 0x6f, 0x74, 0x65, 0x64, 0x48, 0x61, 0x73, 0x68, 0x12,
+	0x40, 0x0a, 0x0a, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f,
+	0x74, 0x6f, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x43, 0x65, 0x72, 0x74, 0x2e, 0x53, 0x69, 0x67, 0x6e,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x0a, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x73, 0x1a, 0x65, 0x0a, 0x09, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x20,
+	0x0a, 0x0b, 0x74, 0x75, 0x72, 0x6e, 0x4f, 0x66, 0x66, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0b, 0x74, 0x75, 0x72, 0x6e, 0x4f, 0x66, 0x66, 0x6c, 0x69, 0x6e, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x75, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x07, 0x75, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69,
+	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73,
+	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x2f, 0x0a, 0x15, 0x50, 0x72, 0x6f, 0x74,
+	0x6f, 0x57, 0x65, 0x61, 0x6b, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65,
+	0x73, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x61, 0x73, 0x68, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0c, 0x52, 0x06, 0x68, 0x61, 0x73, 0x68, 0x65, 0x73, 0x22, 0x47, 0x0a, 0x15, 0x50, 0x72, 0x6f,
+	0x74, 0x6f, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x64,
+	0x65, 0x78, 0x12, 0x1c, 0x0a, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68,
+	0x12, 0x10, 0x0a, 0x03, 0x69, 0x64, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x69,
+	0x64, 0x78, 0x22, 0x2a, 0x0a, 0x14, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x46, 0x6c, 0x69, 0x70, 0x50,
+	0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x65,
+	0x79, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x22, 0x3e,
+	0x0a, 0x0c, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x1a,
+	0x0a, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x6e,
+	0x66, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x22, 0xb4,
+	0x01, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x48, 0x61, 0x6e, 0x64, 0x73, 0x68, 0x61, 0x6b,
+	0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x64, 0x12,
 	0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6f, 0x74, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x72, 0x6f, 0x6f, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x69,
-	0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x6f, 0x6f, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x0c, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x6f, 0x6f, 0x74, 0x12,
-	0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x1c, 0x0a,
-	0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x65, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x65, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x66,
-	0x6c, 0x61, 0x67, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x66, 0x6c, 0x61, 0x67,
-	0x73, 0x22, 0x4e, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x42,
-	0x6f, 0x64, 0x79, 0x12, 0x3c, 0x0a, 0x0c, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6d, 0x6f, 0x64, 0x65,
-	0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x22, 0x6a, 0x0a, 0x0a, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12,
-	0x30, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x18, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x42, 0x6c,
-	0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65,
-	0x72, 0x12, 0x2a, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x16, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x42, 0x6c,
-	0x6f, 0x63, 0x6b, 0x42, 0x6f, 0x64, 0x79, 0x52, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x22, 0xe3, 0x01,
-	0x0a, 0x12, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x50, 0x72, 0x6f, 0x70,
-	0x6f, 0x73, 0x61, 0x6c, 0x12, 0x33, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74,
-	0x6f, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x2e, 0x44,
-	0x61, 0x74, 0x61, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67,
-	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69,
-	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x1a, 0x7a, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x12,
-	0x30, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x18, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x42, 0x6c,
-	0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65,
-	0x72, 0x12, 0x2a, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x16, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x42, 0x6c,
-	0x6f, 0x63, 0x6b, 0x42, 0x6f, 0x64, 0x79, 0x52, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x12, 0x14, 0x0a,
-	0x05, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x70, 0x72,
-	0x6f, 0x6f, 0x66, 0x22, 0x69, 0x0a, 0x0d, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x49, 0x70, 0x66, 0x73,
-	0x46, 0x6c, 0x69, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x70, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x12, 0x1e, 0x0a, 0x0a,
-	0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x50, 0x61, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x0a, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x50, 0x61, 0x72, 0x74, 0x12, 0x20, 0x0a, 0x0b,
-	0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x0b, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x74, 0x22, 0x81,
-	0x02, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x43, 0x65, 0x72,
-	0x74, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x74, 0x65, 0x70, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x73, 0x74, 0x65, 0x70, 0x12, 0x1c, 0x0a, 0x09, 0x76,
-	0x6f, 0x74, 0x65, 0x64, 0x48, 0x61, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09,
-	0x76, 0x6f, 0x74, 0x65, 0x64, 0x48, 0x61, 0x73, 0x68, 0x12, 0x40, 0x0a, 0x0a, 0x73, 0x69, 0x67,
-	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e,
-	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x42, 0x6c, 0x6f, 0x63,
-	0x6b, 0x43, 0x65, 0x72, 0x74, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52,
-	0x0a, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x1a, 0x65, 0x0a, 0x09, 0x53,
-	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x74, 0x75, 0x72, 0x6e,
-	0x4f, 0x66, 0x66, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x74,
-	0x75, 0x72, 0x6e, 0x4f, 0x66, 0x66, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x70,
-	0x67, 0x72, 0x61, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x75, 0x70, 0x67,
-	0x72, 0x61, 0x64, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72,
-	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
-	0x72, 0x65, 0x22, 0x2f, 0x0a, 0x15, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x57, 0x65, 0x61, 0x6b, 0x43,
-	0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x68,
-	0x61, 0x73, 0x68, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x06, 0x68, 0x61, 0x73,
-	0x68, 0x65, 0x73, 0x22, 0x47, 0x0a, 0x15, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x54, 0x72, 0x61, 0x6e,
-	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x1c, 0x0a, 0x09,
-	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
-	0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x64,
-	0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x69, 0x64, 0x78, 0x22, 0x2a, 0x0a, 0x14,
-	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x46, 0x6c, 0x69, 0x70, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65,
-	0x4b, 0x65, 0x79, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x0c, 0x52, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x22, 0x3e, 0x0a, 0x0c, 0x50, 0x72, 0x6f, 0x74,
-	0x6f, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x69, 0x63, 0x6b,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x6e, 0x69, 0x63, 0x6b,
-	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x22, 0xb4, 0x01, 0x0a, 0x0e, 0x50, 0x72, 0x6f,
-	0x74, 0x6f, 0x48, 0x61, 0x6e, 0x64, 0x73, 0x68, 0x61, 0x6b, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6e,
-	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09,
-	0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69,
-	0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68,
-	0x74, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x07, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x74,
-	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
-	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x70, 0x70,
-	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61,
-	0x70, 0x70, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x65, 0x65,
-	0x72, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x70, 0x65, 0x65, 0x72, 0x73, 0x22,
-	0x38, 0x0a, 0x08, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x4d, 0x73, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x63,
-	0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12,
-	0x18, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x22, 0xcb, 0x01, 0x0a, 0x16, 0x50, 0x72,
-	0x6f, 0x74, 0x6f, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x53, 0x74, 0x61, 0x74, 0x65,
-	0x44, 0x69, 0x66, 0x66, 0x12, 0x4d, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72,
-	0x6f, 0x74, 0x6f, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x53, 0x74, 0x61, 0x74, 0x65,
-	0x44, 0x69, 0x66, 0x66, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x53, 0x74, 0x61,
-	0x74, 0x65, 0x44, 0x69, 0x66, 0x66, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x06, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x73, 0x1a, 0x62, 0x0a, 0x16, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x53,
-	0x74, 0x61, 0x74, 0x65, 0x44, 0x69, 0x66, 0x66, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x18, 0x0a,
-	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07,
-	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65,
-	0x64, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x81, 0x01, 0x0a, 0x12, 0x50, 0x72, 0x6f, 0x74,
-	0x6f, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x37,
-	0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6d,
-	0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x53, 0x6e, 0x61, 0x70, 0x73,
-	0x68, 0x6f, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x4b, 0x65, 0x79, 0x56, 0x61, 0x6c, 0x75,
-	0x65, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x1a, 0x32, 0x0a, 0x08, 0x4b, 0x65, 0x79, 0x56, 0x61,
-	0x6c, 0x75, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x8a, 0x02, 0x0a, 0x15,
-	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x47, 0x6f, 0x73, 0x73, 0x69, 0x70, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
-	0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x61, 0x74, 0x63, 0x68, 0x49, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x62, 0x61, 0x74, 0x63, 0x68, 0x49, 0x64, 0x12,
-	0x3b, 0x0a, 0x06, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x23, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x47, 0x6f,
-	0x73, 0x73, 0x69, 0x70, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x2e, 0x42,
-	0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x06, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x1a, 0x99, 0x01, 0x0a,
-	0x05, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x30, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e,
-	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72,
-	0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x2a, 0x0a, 0x04, 0x63, 0x65, 0x72, 0x74,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e,
-	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x43, 0x65, 0x72, 0x74, 0x52, 0x04,
-	0x63, 0x65, 0x72, 0x74, 0x12, 0x32, 0x0a, 0x04, 0x64, 0x69, 0x66, 0x66, 0x18, 0x03, 0x20, 0x01,
+	0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x65, 0x6e, 0x65, 0x73,
+	0x69, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69,
+	0x73, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12,
+	0x1e, 0x0a, 0x0a, 0x61, 0x70, 0x70, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x70, 0x70, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12,
+	0x14, 0x0a, 0x05, 0x70, 0x65, 0x65, 0x72, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05,
+	0x70, 0x65, 0x65, 0x72, 0x73, 0x22, 0x38, 0x0a, 0x08, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x4d, 0x73,
+	0x67, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x22,
+	0xcb, 0x01, 0x0a, 0x16, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x53, 0x74, 0x61, 0x74, 0x65, 0x44, 0x69, 0x66, 0x66, 0x12, 0x4d, 0x0a, 0x06, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x53, 0x74, 0x61, 0x74, 0x65, 0x44, 0x69, 0x66, 0x66, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x53, 0x74, 0x61, 0x74, 0x65, 0x44, 0x69, 0x66, 0x66, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x1a, 0x62, 0x0a, 0x16, 0x49, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x53, 0x74, 0x61, 0x74, 0x65, 0x44, 0x69, 0x66, 0x66, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a,
+	0x07, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
+	0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x81, 0x01,
+	0x0a, 0x12, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x37, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74,
+	0x6f, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x4b,
+	0x65, 0x79, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x1a, 0x32, 0x0a,
+	0x08, 0x4b, 0x65, 0x79, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x22, 0x8a, 0x02, 0x0a, 0x15, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x47, 0x6f, 0x73, 0x73, 0x69,
+	0x70, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x62,
+	0x61, 0x74, 0x63, 0x68, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x62, 0x61,
+	0x74, 0x63, 0x68, 0x49, 0x64, 0x12, 0x3b, 0x0a, 0x06, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x47, 0x6f, 0x73, 0x73, 0x69, 0x70, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52,
+	0x61, 0x6e, 0x67, 0x65, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x06, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x73, 0x1a, 0x99, 0x01, 0x0a, 0x05, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x30, 0x0a, 0x06,
+	0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x2a,
+	0x0a, 0x04, 0x63, 0x65, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x43, 0x65, 0x72, 0x74, 0x52, 0x04, 0x63, 0x65, 0x72, 0x74, 0x12, 0x32, 0x0a, 0x04, 0x64, 0x69,
+	0x66, 0x66, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
+	0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x44, 0x69, 0x66, 0x66, 0x52, 0x04, 0x64, 0x69, 0x66, 0x66, 0x22, 0x99,
+	0x01, 0x0a, 0x11, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x50,
+	0x72, 0x6f, 0x6f, 0x66, 0x12, 0x32, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01,
 	0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74,
-	0x6f, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x53, 0x74, 0x61, 0x74, 0x65, 0x44, 0x69,
-	0x66, 0x66, 0x52, 0x04, 0x64, 0x69, 0x66, 0x66, 0x22, 0x99, 0x01, 0x0a, 0x11, 0x50, 0x72, 0x6f,
-	0x74, 0x6f, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x32,
-	0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6d,
-	0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x72, 0x6f, 0x70, 0x6f,
-	0x73, 0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x52, 0x04, 0x64, 0x61,
-	0x74, 0x61, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
-	0x1a, 0x32, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x6f, 0x6f,
-	0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x14,
-	0x0a, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x72,
-	0x6f, 0x75, 0x6e, 0x64, 0x22, 0x82, 0x02, 0x0a, 0x09, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x56, 0x6f,
-	0x74, 0x65, 0x12, 0x2a, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x16, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x56,
-	0x6f, 0x74, 0x65, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1c,
-	0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x1a, 0xaa, 0x01, 0x0a,
-	0x04, 0x44, 0x61, 0x74, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x73,
-	0x74, 0x65, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x73, 0x74, 0x65, 0x70, 0x12,
-	0x1e, 0x0a, 0x0a, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x48, 0x61, 0x73, 0x68, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x0a, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x48, 0x61, 0x73, 0x68, 0x12,
-	0x1c, 0x0a, 0x09, 0x76, 0x6f, 0x74, 0x65, 0x64, 0x48, 0x61, 0x73, 0x68, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x09, 0x76, 0x6f, 0x74, 0x65, 0x64, 0x48, 0x61, 0x73, 0x68, 0x12, 0x20, 0x0a,
-	0x0b, 0x74, 0x75, 0x72, 0x6e, 0x4f, 0x66, 0x66, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x0b, 0x74, 0x75, 0x72, 0x6e, 0x4f, 0x66, 0x66, 0x6c, 0x69, 0x6e, 0x65, 0x12,
-	0x18, 0x0a, 0x07, 0x75, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x07, 0x75, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x22, 0x30, 0x0a, 0x1a, 0x50, 0x72, 0x6f,
-	0x74, 0x6f, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x42, 0x79, 0x48, 0x61, 0x73, 0x68,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x22, 0x5a, 0x0a, 0x1a, 0x50,
-	0x72, 0x6f, 0x74, 0x6f, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x52, 0x61, 0x6e,
-	0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x61, 0x74,
-	0x63, 0x68, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x62, 0x61, 0x74, 0x63,
-	0x68, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x02, 0x74, 0x6f, 0x22, 0x51, 0x0a, 0x1d, 0x50, 0x72, 0x6f, 0x74, 0x6f,
-	0x47, 0x65, 0x74, 0x46, 0x6f, 0x72, 0x6b, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x61, 0x6e, 0x67,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x61, 0x74, 0x63,
-	0x68, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x62, 0x61, 0x74, 0x63, 0x68,
-	0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x02, 0x20, 0x03,
-	0x28, 0x0c, 0x52, 0x06, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x22, 0x89, 0x01, 0x0a, 0x09, 0x50,
-	0x72, 0x6f, 0x74, 0x6f, 0x46, 0x6c, 0x69, 0x70, 0x12, 0x3a, 0x0a, 0x0b, 0x74, 0x72, 0x61, 0x6e,
-	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e,
-	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x54, 0x72, 0x61, 0x6e,
-	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x50, 0x61,
-	0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63,
-	0x50, 0x61, 0x72, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x50,
-	0x61, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x70, 0x72, 0x69, 0x76, 0x61,
-	0x74, 0x65, 0x50, 0x61, 0x72, 0x74, 0x22, 0x8b, 0x01, 0x0a, 0x0c, 0x50, 0x72, 0x6f, 0x74, 0x6f,
-	0x46, 0x6c, 0x69, 0x70, 0x4b, 0x65, 0x79, 0x12, 0x2d, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50,
-	0x72, 0x6f, 0x74, 0x6f, 0x46, 0x6c, 0x69, 0x70, 0x4b, 0x65, 0x79, 0x2e, 0x44, 0x61, 0x74, 0x61,
-	0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74,
-	0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61,
-	0x74, 0x75, 0x72, 0x65, 0x1a, 0x2e, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x12, 0x10, 0x0a, 0x03,
-	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14,
-	0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x65,
-	0x70, 0x6f, 0x63, 0x68, 0x22, 0x4d, 0x0a, 0x0d, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x4d, 0x61, 0x6e,
-	0x69, 0x66, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x6f, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x2e, 0x44, 0x61,
+	0x74, 0x61, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67,
+	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x1a, 0x32, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x12, 0x14,
+	0x0a, 0x05, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x70,
+	0x72, 0x6f, 0x6f, 0x66, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x22, 0x82, 0x02, 0x0a, 0x09, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x56, 0x6f, 0x74, 0x65, 0x12, 0x2a, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e,
+	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x56, 0x6f, 0x74, 0x65, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x52, 0x04,
+	0x64, 0x61, 0x74, 0x61, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x1a, 0xaa, 0x01, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x72,
+	0x6f, 0x75, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x72, 0x6f, 0x75, 0x6e,
+	0x64, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x74, 0x65, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x04, 0x73, 0x74, 0x65, 0x70, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x48,
+	0x61, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x70, 0x61, 0x72, 0x65, 0x6e,
+	0x74, 0x48, 0x61, 0x73, 0x68, 0x12, 0x1c, 0x0a, 0x09, 0x76, 0x6f, 0x74, 0x65, 0x64, 0x48, 0x61,
+	0x73, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x76, 0x6f, 0x74, 0x65, 0x64, 0x48,
+	0x61, 0x73, 0x68, 0x12, 0x20, 0x0a, 0x0b, 0x74, 0x75, 0x72, 0x6e, 0x4f, 0x66, 0x66, 0x6c, 0x69,
+	0x6e, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x74, 0x75, 0x72, 0x6e, 0x4f, 0x66,
+	0x66, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x75, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x22,
+	0x30, 0x0a, 0x1a, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x42, 0x79, 0x48, 0x61, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x68, 0x61, 0x73,
+	0x68, 0x22, 0x5a, 0x0a, 0x1a, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x73, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x18, 0x0a, 0x07, 0x62, 0x61, 0x74, 0x63, 0x68, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x07, 0x62, 0x61, 0x74, 0x63, 0x68, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f,
+	0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a,
+	0x02, 0x74, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x74, 0x6f, 0x22, 0x51, 0x0a,
+	0x1d, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x47, 0x65, 0x74, 0x46, 0x6f, 0x72, 0x6b, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18,
+	0x0a, 0x07, 0x62, 0x61, 0x74, 0x63, 0x68, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x07, 0x62, 0x61, 0x74, 0x63, 0x68, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x06, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73,
+	0x22, 0x89, 0x01, 0x0a, 0x09, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x46, 0x6c, 0x69, 0x70, 0x12, 0x3a,
+	0x0a, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f,
+	0x74, 0x6f, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x74,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x75,
+	0x62, 0x6c, 0x69, 0x63, 0x50, 0x61, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a,
+	0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x50, 0x61, 0x72, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x72,
+	0x69, 0x76, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x0b, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x74, 0x22, 0x8b, 0x01, 0x0a,
+	0x0c, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x46, 0x6c, 0x69, 0x70, 0x4b, 0x65, 0x79, 0x12, 0x2d, 0x0a,
+	0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x46, 0x6c, 0x69, 0x70, 0x4b, 0x65,
+	0x79, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1c, 0x0a, 0x09,
+	0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x1a, 0x2e, 0x0a, 0x04, 0x44, 0x61,
+	0x74, 0x61, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x22, 0x4d, 0x0a, 0x0d, 0x50, 0x72,
+	0x6f, 0x74, 0x6f, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x63,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x63, 0x69, 0x64, 0x12, 0x16, 0x0a,
+	0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x68,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x04, 0x72, 0x6f, 0x6f, 0x74, 0x22, 0xb1, 0x01, 0x0a, 0x1b, 0x50, 0x72,
+	0x6f, 0x74, 0x6f, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x46, 0x6c, 0x69, 0x70, 0x4b, 0x65,
+	0x79, 0x73, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x12, 0x3c, 0x0a, 0x04, 0x64, 0x61, 0x74,
+	0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73,
+	0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x46, 0x6c, 0x69,
+	0x70, 0x4b, 0x65, 0x79, 0x73, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x2e, 0x44, 0x61, 0x74,
+	0x61, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x1a, 0x36, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x12, 0x18, 0x0a,
+	0x07, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07,
+	0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x22, 0x3b, 0x0a,
+	0x11, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x75, 0x6c, 0x6c, 0x50, 0x75, 0x73, 0x68, 0x48, 0x61,
+	0x73, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x22, 0x73, 0x0a, 0x17, 0x50, 0x72,
+	0x6f, 0x74, 0x6f, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x4d, 0x61, 0x6e, 0x69, 0x66,
+	0x65, 0x73, 0x74, 0x44, 0x62, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
 	0x28, 0x0c, 0x52, 0x03, 0x63, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68,
 	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12,
-	0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x72,
-	0x6f, 0x6f, 0x74, 0x22, 0xb1, 0x01, 0x0a, 0x1b, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x72, 0x69,
-	0x76, 0x61, 0x74, 0x65, 0x46, 0x6c, 0x69, 0x70, 0x4b, 0x65, 0x79, 0x73, 0x50, 0x61, 0x63, 0x6b,
-	0x61, 0x67, 0x65, 0x12, 0x3c, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x28, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f,
-	0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x46, 0x6c, 0x69, 0x70, 0x4b, 0x65, 0x79, 0x73, 0x50,
-	0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x52, 0x04, 0x64, 0x61, 0x74,
-	0x61, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x1a,
-	0x36, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x63, 0x6b, 0x61,
-	0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67,
-	0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x22, 0x3b, 0x0a, 0x11, 0x50, 0x72, 0x6f, 0x74, 0x6f,
-	0x50, 0x75, 0x6c, 0x6c, 0x50, 0x75, 0x73, 0x68, 0x48, 0x61, 0x73, 0x68, 0x12, 0x12, 0x0a, 0x04,
-	0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65,
-	0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04,
-	0x68, 0x61, 0x73, 0x68, 0x22, 0x73, 0x0a, 0x17, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x53, 0x6e, 0x61,
-	0x70, 0x73, 0x68, 0x6f, 0x74, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x44, 0x62, 0x12,
-	0x10, 0x0a, 0x03, 0x63, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x63, 0x69,
-	0x64, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c,
-	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c,
-	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x04, 0x72, 0x6f, 0x6f, 0x74, 0x22, 0x46, 0x0a, 0x12, 0x50, 0x72, 0x6f,
-	0x74, 0x6f, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x44, 0x62, 0x12,
-	0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x68,
-	0x61, 0x73, 0x68, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x22, 0x87, 0x01, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x41, 0x6e, 0x73, 0x77, 0x65,
-	0x72, 0x73, 0x44, 0x62, 0x12, 0x37, 0x0a, 0x07, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50,
-	0x72, 0x6f, 0x74, 0x6f, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x73, 0x44, 0x62, 0x2e, 0x41, 0x6e,
-	0x73, 0x77, 0x65, 0x72, 0x52, 0x07, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x73, 0x1a, 0x3c, 0x0a,
-	0x06, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x07, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x73, 0x22, 0x55, 0x0a, 0x0f, 0x50,
-	0x72, 0x6f, 0x74, 0x6f, 0x42, 0x75, 0x72, 0x6e, 0x74, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x12, 0x18,
+	0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x72,
+	0x6f, 0x6f, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x72, 0x6f, 0x6f, 0x74, 0x22,
+	0x46, 0x0a, 0x12, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x41, 0x6e, 0x73,
+	0x77, 0x65, 0x72, 0x44, 0x62, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x87, 0x01, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x74,
+	0x6f, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x73, 0x44, 0x62, 0x12, 0x37, 0x0a, 0x07, 0x61, 0x6e,
+	0x73, 0x77, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72,
+	0x73, 0x44, 0x62, 0x2e, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x52, 0x07, 0x61, 0x6e, 0x73, 0x77,
+	0x65, 0x72, 0x73, 0x1a, 0x3c, 0x0a, 0x06, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x12, 0x18, 0x0a,
+	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x6e, 0x73, 0x77, 0x65,
+	0x72, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72,
+	0x73, 0x22, 0x55, 0x0a, 0x0f, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x42, 0x75, 0x72, 0x6e, 0x74, 0x43,
+	0x6f, 0x69, 0x6e, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x7c, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x74,
+	0x6f, 0x46, 0x65, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x46, 0x65, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x46, 0x65, 0x65, 0x12, 0x1c,
+	0x0a, 0x09, 0x62, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x46, 0x65, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x09, 0x62, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x46, 0x65, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x74, 0x78, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x74,
+	0x78, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x9b, 0x01, 0x0a, 0x15, 0x50, 0x72, 0x6f, 0x74, 0x6f,
+	0x53, 0x61, 0x76, 0x65, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x28, 0x0a, 0x02, 0x74, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x02, 0x74, 0x78, 0x12, 0x1c, 0x0a, 0x09, 0x66, 0x65,
+	0x65, 0x50, 0x65, 0x72, 0x42, 0x79, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x66,
+	0x65, 0x65, 0x50, 0x65, 0x72, 0x42, 0x79, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x48, 0x61, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x22, 0xbf, 0x01, 0x0a, 0x14, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x41, 0x63,
+	0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x12, 0x1c, 0x0a,
+	0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x45, 0x0a, 0x0a, 0x61,
+	0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x25, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x41, 0x63,
+	0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x2e, 0x41, 0x63,
+	0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x52, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x69,
+	0x65, 0x73, 0x1a, 0x42, 0x0a, 0x08, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x12, 0x18,
 	0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
-	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d,
-	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75,
-	0x6e, 0x74, 0x22, 0x9b, 0x01, 0x0a, 0x15, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x53, 0x61, 0x76, 0x65,
-	0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x28, 0x0a, 0x02,
-	0x74, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
-	0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x52, 0x02, 0x74, 0x78, 0x12, 0x1c, 0x0a, 0x09, 0x66, 0x65, 0x65, 0x50, 0x65, 0x72,
-	0x42, 0x79, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x66, 0x65, 0x65, 0x50, 0x65,
-	0x72, 0x42, 0x79, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73,
-	0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61,
-	0x73, 0x68, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
-	0x22, 0xbf, 0x01, 0x0a, 0x14, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69,
-	0x74, 0x79, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d,
-	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69,
-	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x45, 0x0a, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x76,
-	0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6d, 0x6f,
-	0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69,
-	0x74, 0x79, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69,
-	0x74, 0x79, 0x52, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x69, 0x65, 0x73, 0x1a, 0x42,
-	0x0a, 0x08, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
-	0x6d, 0x70, 0x22, 0x48, 0x0a, 0x1a, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x53, 0x68, 0x6f, 0x72, 0x74,
-	0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74,
-	0x12, 0x18, 0x0a, 0x07, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x07, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x72, 0x6e,
-	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x72, 0x6e, 0x64, 0x22, 0x71, 0x0a, 0x19,
-	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x4c, 0x6f, 0x6e, 0x67, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x41,
-	0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x6e, 0x73,
-	0x77, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x6e, 0x73, 0x77,
-	0x65, 0x72, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x05, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x73,
-	0x61, 0x6c, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x73, 0x61, 0x6c, 0x74, 0x22,
-	0x41, 0x0a, 0x19, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x46, 0x6c, 0x69, 0x70, 0x53, 0x75, 0x62, 0x6d,
-	0x69, 0x74, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x10, 0x0a, 0x03,
-	0x63, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x63, 0x69, 0x64, 0x12, 0x12,
-	0x0a, 0x04, 0x70, 0x61, 0x69, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x70, 0x61,
-	0x69, 0x72, 0x22, 0x35, 0x0a, 0x1b, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x4f, 0x6e, 0x6c, 0x69, 0x6e,
-	0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e,
-	0x74, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x06, 0x6f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x22, 0x27, 0x0a, 0x13, 0x50, 0x72, 0x6f,
-	0x74, 0x6f, 0x42, 0x75, 0x72, 0x6e, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74,
-	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
-	0x65, 0x79, 0x22, 0x32, 0x0a, 0x1c, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x43, 0x68, 0x61, 0x6e, 0x67,
-	0x65, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65,
-	0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x22, 0x2d, 0x0a, 0x19, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x44,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x6c, 0x69, 0x70, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d,
-	0x65, 0x6e, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x03, 0x63, 0x69, 0x64, 0x22, 0x59, 0x0a, 0x11, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x53, 0x74,
-	0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f,
-	0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65,
-	0x12, 0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52,
-	0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63,
-	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
-	0x22, 0xe3, 0x05, 0x0a, 0x12, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x53, 0x74, 0x61, 0x74, 0x65, 0x49,
-	0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x6b, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x12, 0x18, 0x0a,
-	0x07, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07,
-	0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x62, 0x69, 0x72, 0x74, 0x68,
-	0x64, 0x61, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x62, 0x69, 0x72, 0x74, 0x68,
-	0x64, 0x61, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x71, 0x75, 0x61,
-	0x6c, 0x69, 0x66, 0x69, 0x65, 0x64, 0x46, 0x6c, 0x69, 0x70, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x0e, 0x71, 0x75, 0x61, 0x6c, 0x69, 0x66, 0x69, 0x65, 0x64, 0x46, 0x6c, 0x69, 0x70,
-	0x73, 0x12, 0x28, 0x0a, 0x0f, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x46, 0x6c, 0x69, 0x70, 0x50, 0x6f,
-	0x69, 0x6e, 0x74, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x73, 0x68, 0x6f, 0x72,
-	0x74, 0x46, 0x6c, 0x69, 0x70, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x70,
-	0x75, 0x62, 0x4b, 0x65, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x70, 0x75, 0x62,
-	0x4b, 0x65, 0x79, 0x12, 0x24, 0x0a, 0x0d, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x46,
-	0x6c, 0x69, 0x70, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x72, 0x65, 0x71, 0x75,
-	0x69, 0x72, 0x65, 0x64, 0x46, 0x6c, 0x69, 0x70, 0x73, 0x12, 0x35, 0x0a, 0x05, 0x66, 0x6c, 0x69,
-	0x70, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
-	0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x53, 0x74, 0x61, 0x74, 0x65, 0x49, 0x64, 0x65, 0x6e,
-	0x74, 0x69, 0x74, 0x79, 0x2e, 0x46, 0x6c, 0x69, 0x70, 0x52, 0x05, 0x66, 0x6c, 0x69, 0x70, 0x73,
-	0x12, 0x1e, 0x0a, 0x0a, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0a,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04,
-	0x63, 0x6f, 0x64, 0x65, 0x12, 0x3d, 0x0a, 0x08, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x65, 0x73,
-	0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e,
+	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x48, 0x0a, 0x1a, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x53,
+	0x68, 0x6f, 0x72, 0x74, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68,
+	0x6d, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x73, 0x12, 0x10,
+	0x0a, 0x03, 0x72, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x72, 0x6e, 0x64,
+	0x22, 0x71, 0x0a, 0x19, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x4c, 0x6f, 0x6e, 0x67, 0x41, 0x6e, 0x73,
+	0x77, 0x65, 0x72, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a,
+	0x07, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07,
+	0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x6f, 0x6f, 0x66,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x12, 0x0a, 0x04, 0x73, 0x61, 0x6c, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x73,
+	0x61, 0x6c, 0x74, 0x22, 0x41, 0x0a, 0x19, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x46, 0x6c, 0x69, 0x70,
+	0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74,
+	0x12, 0x10, 0x0a, 0x03, 0x63, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x63,
+	0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x69, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x04, 0x70, 0x61, 0x69, 0x72, 0x22, 0x35, 0x0a, 0x1b, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x4f,
+	0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x41, 0x74, 0x74, 0x61, 0x63,
+	0x68, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x6f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x22, 0x27, 0x0a,
+	0x13, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x42, 0x75, 0x72, 0x6e, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68,
+	0x6d, 0x65, 0x6e, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x22, 0x32, 0x0a, 0x1c, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x43,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x41, 0x74, 0x74, 0x61,
+	0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x22, 0x2d, 0x0a, 0x19, 0x50, 0x72,
+	0x6f, 0x74, 0x6f, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x6c, 0x69, 0x70, 0x41, 0x74, 0x74,
+	0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x63, 0x69, 0x64, 0x22, 0x44, 0x0a, 0x1a, 0x50, 0x72, 0x6f,
+	0x74, 0x6f, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x41, 0x74, 0x74,
+	0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22,
+	0xe9, 0x01, 0x0a, 0x11, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x53, 0x74, 0x61, 0x74, 0x65, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65,
+	0x70, 0x6f, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63,
+	0x68, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x4f, 0x0a, 0x0e, 0x70,
+	0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f,
+	0x74, 0x6f, 0x53, 0x74, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x2e, 0x50,
+	0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x52, 0x0e, 0x70, 0x65,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x1a, 0x3d, 0x0a, 0x0d,
+	0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x12, 0x16, 0x0a,
+	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x22, 0xe1, 0x08, 0x0a, 0x12,
 	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x53, 0x74, 0x61, 0x74, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69,
-	0x74, 0x79, 0x2e, 0x54, 0x78, 0x41, 0x64, 0x64, 0x72, 0x52, 0x08, 0x69, 0x6e, 0x76, 0x69, 0x74,
-	0x65, 0x65, 0x73, 0x12, 0x3b, 0x0a, 0x07, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x72, 0x18, 0x0d,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72,
-	0x6f, 0x74, 0x6f, 0x53, 0x74, 0x61, 0x74, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79,
-	0x2e, 0x54, 0x78, 0x41, 0x64, 0x64, 0x72, 0x52, 0x07, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x72,
-	0x12, 0x18, 0x0a, 0x07, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x18, 0x0e, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x07, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x12, 0x26, 0x0a, 0x0e, 0x76, 0x61,
-	0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x69, 0x74, 0x73, 0x18, 0x0f, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x0e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x69,
-	0x74, 0x73, 0x12, 0x2a, 0x0a, 0x10, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x76, 0x61,
-	0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x20,
-	0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x48, 0x61, 0x73, 0x68, 0x18, 0x11, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x48, 0x61, 0x73, 0x68,
-	0x1a, 0x2c, 0x0a, 0x04, 0x46, 0x6c, 0x69, 0x70, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x63, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61,
-	0x69, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x70, 0x61, 0x69, 0x72, 0x1a, 0x36,
-	0x0a, 0x06, 0x54, 0x78, 0x41, 0x64, 0x64, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x18, 0x0a, 0x07,
-	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0xf8, 0x03, 0x0a, 0x10, 0x50, 0x72, 0x6f, 0x74, 0x6f,
-	0x53, 0x74, 0x61, 0x74, 0x65, 0x47, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x65,
-	0x70, 0x6f, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63,
-	0x68, 0x12, 0x2e, 0x0a, 0x12, 0x6e, 0x65, 0x78, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x6e,
-	0x65, 0x78, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d,
-	0x65, 0x12, 0x2a, 0x0a, 0x10, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50,
-	0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x76, 0x61, 0x6c,
-	0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x1e, 0x0a,
-	0x0a, 0x67, 0x6f, 0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x0a, 0x67, 0x6f, 0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1c, 0x0a,
-	0x09, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x53, 0x65, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x09, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x53, 0x65, 0x65, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x6c,
-	0x61, 0x73, 0x74, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12,
-	0x1e, 0x0a, 0x0a, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x07, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x0a, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12,
-	0x1e, 0x0a, 0x0a, 0x66, 0x65, 0x65, 0x50, 0x65, 0x72, 0x42, 0x79, 0x74, 0x65, 0x18, 0x08, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x0a, 0x66, 0x65, 0x65, 0x50, 0x65, 0x72, 0x42, 0x79, 0x74, 0x65, 0x12,
-	0x32, 0x0a, 0x14, 0x76, 0x72, 0x66, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x54, 0x68,
-	0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x14, 0x76,
-	0x72, 0x66, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68,
-	0x6f, 0x6c, 0x64, 0x12, 0x28, 0x0a, 0x0f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x42, 0x6c, 0x6f, 0x63,
-	0x6b, 0x73, 0x42, 0x69, 0x74, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0f, 0x65, 0x6d,
-	0x70, 0x74, 0x79, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x42, 0x69, 0x74, 0x73, 0x12, 0x2c, 0x0a,
-	0x11, 0x67, 0x6f, 0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x49, 0x6e, 0x76, 0x69, 0x74,
-	0x65, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x67, 0x6f, 0x64, 0x41, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x73, 0x12, 0x44, 0x0a, 0x1d, 0x62,
-	0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x43, 0x6e, 0x74, 0x57, 0x69, 0x74, 0x68, 0x6f, 0x75, 0x74, 0x43,
-	0x65, 0x72, 0x65, 0x6d, 0x6f, 0x6e, 0x69, 0x61, 0x6c, 0x54, 0x78, 0x73, 0x18, 0x0c, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x1d, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x43, 0x6e, 0x74, 0x57, 0x69, 0x74,
-	0x68, 0x6f, 0x75, 0x74, 0x43, 0x65, 0x72, 0x65, 0x6d, 0x6f, 0x6e, 0x69, 0x61, 0x6c, 0x54, 0x78,
-	0x73, 0x22, 0x50, 0x0a, 0x1a, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x53, 0x74, 0x61, 0x74, 0x65, 0x41,
-	0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12,
-	0x1a, 0x0a, 0x08, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x08, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6f,
-	0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x6f, 0x6e, 0x6c,
-	0x69, 0x6e, 0x65, 0x22, 0x3e, 0x0a, 0x1e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x53, 0x74, 0x61, 0x74,
+	0x74, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x05, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x69, 0x6e, 0x76, 0x69,
+	0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x69, 0x6e, 0x76, 0x69, 0x74,
+	0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x62, 0x69, 0x72, 0x74, 0x68, 0x64, 0x61, 0x79, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x62, 0x69, 0x72, 0x74, 0x68, 0x64, 0x61, 0x79, 0x12, 0x14,
+	0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x73,
+	0x74, 0x61, 0x74, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x71, 0x75, 0x61, 0x6c, 0x69, 0x66, 0x69, 0x65,
+	0x64, 0x46, 0x6c, 0x69, 0x70, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x71, 0x75,
+	0x61, 0x6c, 0x69, 0x66, 0x69, 0x65, 0x64, 0x46, 0x6c, 0x69, 0x70, 0x73, 0x12, 0x28, 0x0a, 0x0f,
+	0x73, 0x68, 0x6f, 0x72, 0x74, 0x46, 0x6c, 0x69, 0x70, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x46, 0x6c, 0x69, 0x70,
+	0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x75, 0x62, 0x4b, 0x65, 0x79,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x70, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x12, 0x24,
+	0x0a, 0x0d, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x46, 0x6c, 0x69, 0x70, 0x73, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x46,
+	0x6c, 0x69, 0x70, 0x73, 0x12, 0x35, 0x0a, 0x05, 0x66, 0x6c, 0x69, 0x70, 0x73, 0x18, 0x09, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f,
+	0x74, 0x6f, 0x53, 0x74, 0x61, 0x74, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e,
+	0x46, 0x6c, 0x69, 0x70, 0x52, 0x05, 0x66, 0x6c, 0x69, 0x70, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x67,
+	0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0a, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x63,
+	0x6f, 0x64, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12,
+	0x3d, 0x0a, 0x08, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x65, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x21, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x54, 0x78,
+	0x41, 0x64, 0x64, 0x72, 0x52, 0x08, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x65, 0x73, 0x12, 0x3b,
+	0x0a, 0x07, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x72, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x21, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x54, 0x78, 0x41, 0x64,
+	0x64, 0x72, 0x52, 0x07, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x70,
+	0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x70, 0x65,
+	0x6e, 0x61, 0x6c, 0x74, 0x79, 0x12, 0x26, 0x0a, 0x0e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x42, 0x69, 0x74, 0x73, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x69, 0x74, 0x73, 0x12, 0x2a, 0x0a,
+	0x10, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x72, 0x6f,
+	0x66, 0x69, 0x6c, 0x65, 0x48, 0x61, 0x73, 0x68, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b,
+	0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x48, 0x61, 0x73, 0x68, 0x12, 0x1c, 0x0a, 0x09, 0x64,
+	0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x65, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09,
+	0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x13, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x12, 0x20, 0x0a, 0x0b, 0x66, 0x6c, 0x69, 0x70, 0x4b, 0x65, 0x79, 0x48, 0x61, 0x73, 0x68,
+	0x18, 0x14, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x66, 0x6c, 0x69, 0x70, 0x4b, 0x65, 0x79, 0x48,
+	0x61, 0x73, 0x68, 0x12, 0x34, 0x0a, 0x15, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x46, 0x72, 0x6f, 0x6d,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x18, 0x15, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x15, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x12, 0x30, 0x0a, 0x13, 0x73, 0x74, 0x61,
+	0x6b, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x46, 0x65, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73,
+	0x18, 0x16, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x13, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x46, 0x72, 0x6f,
+	0x6d, 0x46, 0x65, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x12, 0x3c, 0x0a, 0x19, 0x73,
+	0x74, 0x61, 0x6b, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65,
+	0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x18, 0x17, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x19,
+	0x73, 0x74, 0x61, 0x6b, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74,
+	0x65, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x12, 0x2a, 0x0a, 0x10, 0x73, 0x74, 0x61,
+	0x6b, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x73, 0x18, 0x18, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x10, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x49, 0x6e,
+	0x76, 0x69, 0x74, 0x65, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x41,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x19, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x69, 0x6e, 0x76,
+	0x69, 0x74, 0x65, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x65, 0x6c,
+	0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x64,
+	0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x1a, 0x2c, 0x0a, 0x04, 0x46, 0x6c, 0x69,
+	0x70, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03,
+	0x63, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x69, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x04, 0x70, 0x61, 0x69, 0x72, 0x1a, 0x36, 0x0a, 0x06, 0x54, 0x78, 0x41, 0x64, 0x64,
+	0x72, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22,
+	0x81, 0x07, 0x0a, 0x10, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x53, 0x74, 0x61, 0x74, 0x65, 0x47, 0x6c,
+	0x6f, 0x62, 0x61, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x2e, 0x0a, 0x12, 0x6e, 0x65,
+	0x78, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x6e, 0x65, 0x78, 0x74, 0x56, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x2a, 0x0a, 0x10, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x67, 0x6f, 0x64, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x67, 0x6f, 0x64, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x53,
+	0x65, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x77, 0x6f, 0x72, 0x64, 0x73,
+	0x53, 0x65, 0x65, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x6e, 0x61, 0x70,
+	0x73, 0x68, 0x6f, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x6c, 0x61, 0x73, 0x74,
+	0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x70, 0x6f, 0x63,
+	0x68, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x65, 0x70,
+	0x6f, 0x63, 0x68, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x1e, 0x0a, 0x0a, 0x66, 0x65, 0x65, 0x50,
+	0x65, 0x72, 0x42, 0x79, 0x74, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x66, 0x65,
+	0x65, 0x50, 0x65, 0x72, 0x42, 0x79, 0x74, 0x65, 0x12, 0x32, 0x0a, 0x14, 0x76, 0x72, 0x66, 0x50,
+	0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x14, 0x76, 0x72, 0x66, 0x50, 0x72, 0x6f, 0x70, 0x6f,
+	0x73, 0x65, 0x72, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x28, 0x0a, 0x0f,
+	0x65, 0x6d, 0x70, 0x74, 0x79, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x42, 0x69, 0x74, 0x73, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x73, 0x42, 0x69, 0x74, 0x73, 0x12, 0x2c, 0x0a, 0x11, 0x67, 0x6f, 0x64, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x11, 0x67, 0x6f, 0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x49, 0x6e, 0x76,
+	0x69, 0x74, 0x65, 0x73, 0x12, 0x44, 0x0a, 0x1d, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x43, 0x6e,
+	0x74, 0x57, 0x69, 0x74, 0x68, 0x6f, 0x75, 0x74, 0x43, 0x65, 0x72, 0x65, 0x6d, 0x6f, 0x6e, 0x69,
+	0x61, 0x6c, 0x54, 0x78, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x1d, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x73, 0x43, 0x6e, 0x74, 0x57, 0x69, 0x74, 0x68, 0x6f, 0x75, 0x74, 0x43, 0x65, 0x72,
+	0x65, 0x6d, 0x6f, 0x6e, 0x69, 0x61, 0x6c, 0x54, 0x78, 0x73, 0x12, 0x5a, 0x0a, 0x12, 0x70, 0x65,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x73,
+	0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e,
+	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x53, 0x74, 0x61, 0x74, 0x65, 0x47, 0x6c, 0x6f, 0x62, 0x61, 0x6c,
+	0x2e, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77,
+	0x61, 0x6c, 0x52, 0x12, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x57, 0x69, 0x74, 0x68, 0x64,
+	0x72, 0x61, 0x77, 0x61, 0x6c, 0x73, 0x12, 0x36, 0x0a, 0x16, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x63,
+	0x75, 0x74, 0x69, 0x76, 0x65, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73,
+	0x18, 0x0e, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x16, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x63, 0x75, 0x74,
+	0x69, 0x76, 0x65, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x34,
+	0x0a, 0x15, 0x72, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x73, 0x42, 0x69, 0x74, 0x73, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x15, 0x72,
+	0x65, 0x63, 0x65, 0x6e, 0x74, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73,
+	0x42, 0x69, 0x74, 0x73, 0x12, 0x30, 0x0a, 0x13, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65,
+	0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x50, 0x6f, 0x6f, 0x6c, 0x18, 0x13, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x13, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x65, 0x52, 0x65, 0x77, 0x61,
+	0x72, 0x64, 0x50, 0x6f, 0x6f, 0x6c, 0x12, 0x22, 0x0a, 0x0c, 0x70, 0x61, 0x74, 0x63, 0x68, 0x56,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x70, 0x61,
+	0x74, 0x63, 0x68, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x1a, 0x67, 0x0a, 0x11, 0x50, 0x65,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x12,
+	0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x20, 0x0a, 0x0b, 0x75, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x75, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x22, 0x6e, 0x0a, 0x1a, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x08, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x12, 0x16, 0x0a,
+	0x06, 0x6f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x6f,
+	0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74,
+	0x65, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61,
+	0x74, 0x65, 0x65, 0x22, 0x3e, 0x0a, 0x1e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x53, 0x74, 0x61, 0x74,
 	0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x53,
 	0x77, 0x69, 0x74, 0x63, 0x68, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
 	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x65, 0x73, 0x22, 0xb7, 0x0f, 0x0a, 0x14, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x72, 0x65,
-	0x64, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05,
-	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x62, 0x6c, 0x6f,
-	0x63, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x65, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x04, 0x73, 0x65, 0x65, 0x64, 0x12, 0x3b, 0x0a, 0x06, 0x67, 0x6c, 0x6f, 0x62, 0x61, 0x6c,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e,
-	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x72, 0x65, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x64, 0x53,
-	0x74, 0x61, 0x74, 0x65, 0x2e, 0x47, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x52, 0x06, 0x67, 0x6c, 0x6f,
-	0x62, 0x61, 0x6c, 0x12, 0x4d, 0x0a, 0x0c, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x53, 0x77, 0x69,
-	0x74, 0x63, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x6d, 0x6f, 0x64, 0x65,
-	0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x72, 0x65, 0x64, 0x65, 0x66, 0x69, 0x6e,
-	0x65, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x53, 0x77,
-	0x69, 0x74, 0x63, 0x68, 0x52, 0x0c, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x53, 0x77, 0x69, 0x74,
-	0x63, 0x68, 0x12, 0x40, 0x0a, 0x08, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x05,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72,
-	0x6f, 0x74, 0x6f, 0x50, 0x72, 0x65, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x64, 0x53, 0x74, 0x61,
-	0x74, 0x65, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x08, 0x61, 0x63, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x73, 0x12, 0x45, 0x0a, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69,
-	0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
+	0x73, 0x65, 0x73, 0x22, 0x91, 0x02, 0x0a, 0x19, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x43, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x50, 0x61, 0x72, 0x61, 0x6d,
+	0x73, 0x12, 0x3f, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x27, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x50, 0x61,
+	0x72, 0x61, 0x6d, 0x73, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61,
+	0x6d, 0x73, 0x12, 0x3c, 0x0a, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x26, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x50, 0x61,
+	0x72, 0x61, 0x6d, 0x73, 0x2e, 0x56, 0x6f, 0x74, 0x65, 0x52, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x73,
+	0x1a, 0x2f, 0x0a, 0x05, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x1a, 0x44, 0x0a, 0x04, 0x56, 0x6f, 0x74, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x72, 0x22, 0xa5, 0x10, 0x0a, 0x14, 0x50, 0x72, 0x6f, 0x74,
+	0x6f, 0x50, 0x72, 0x65, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x05, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x65, 0x65, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x73, 0x65, 0x65, 0x64, 0x12, 0x3b, 0x0a, 0x06, 0x67, 0x6c,
+	0x6f, 0x62, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x72, 0x65, 0x64, 0x65, 0x66, 0x69,
+	0x6e, 0x65, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x47, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x52,
+	0x06, 0x67, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x12, 0x4d, 0x0a, 0x0c, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x72, 0x65, 0x64,
+	0x65, 0x66, 0x69, 0x6e, 0x65, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x52, 0x0c, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x12, 0x40, 0x0a, 0x08, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
 	0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x72, 0x65, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x65,
-	0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52,
-	0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x5d, 0x0a, 0x12, 0x61,
-	0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65,
-	0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73,
-	0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x72, 0x65, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x64,
-	0x53, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x49, 0x64,
-	0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x12, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64,
-	0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x1a, 0xee, 0x03, 0x0a, 0x06, 0x47,
-	0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x2e, 0x0a, 0x12, 0x6e,
-	0x65, 0x78, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x6e, 0x65, 0x78, 0x74, 0x56, 0x61, 0x6c,
-	0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x2a, 0x0a, 0x10, 0x76,
-	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x67, 0x6f, 0x64, 0x41, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x67, 0x6f, 0x64,
-	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x77, 0x6f, 0x72, 0x64, 0x73,
-	0x53, 0x65, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x77, 0x6f, 0x72, 0x64,
-	0x73, 0x53, 0x65, 0x65, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x6e, 0x61,
-	0x70, 0x73, 0x68, 0x6f, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x6c, 0x61, 0x73,
-	0x74, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x70, 0x6f,
-	0x63, 0x68, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x65,
-	0x70, 0x6f, 0x63, 0x68, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x1e, 0x0a, 0x0a, 0x66, 0x65, 0x65,
-	0x50, 0x65, 0x72, 0x42, 0x79, 0x74, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x66,
-	0x65, 0x65, 0x50, 0x65, 0x72, 0x42, 0x79, 0x74, 0x65, 0x12, 0x32, 0x0a, 0x14, 0x76, 0x72, 0x66,
-	0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c,
-	0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x14, 0x76, 0x72, 0x66, 0x50, 0x72, 0x6f, 0x70,
-	0x6f, 0x73, 0x65, 0x72, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x28, 0x0a,
-	0x0f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x42, 0x69, 0x74, 0x73,
-	0x18, 0x0a, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x42, 0x6c, 0x6f,
-	0x63, 0x6b, 0x73, 0x42, 0x69, 0x74, 0x73, 0x12, 0x2c, 0x0a, 0x11, 0x67, 0x6f, 0x64, 0x41, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x73, 0x18, 0x0b, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x11, 0x67, 0x6f, 0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x49, 0x6e,
-	0x76, 0x69, 0x74, 0x65, 0x73, 0x12, 0x44, 0x0a, 0x1d, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x43,
-	0x6e, 0x74, 0x57, 0x69, 0x74, 0x68, 0x6f, 0x75, 0x74, 0x43, 0x65, 0x72, 0x65, 0x6d, 0x6f, 0x6e,
-	0x69, 0x61, 0x6c, 0x54, 0x78, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x1d, 0x62, 0x6c,
-	0x6f, 0x63, 0x6b, 0x73, 0x43, 0x6e, 0x74, 0x57, 0x69, 0x74, 0x68, 0x6f, 0x75, 0x74, 0x43, 0x65,
-	0x72, 0x65, 0x6d, 0x6f, 0x6e, 0x69, 0x61, 0x6c, 0x54, 0x78, 0x73, 0x1a, 0x2c, 0x0a, 0x0c, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x12, 0x1c, 0x0a, 0x09, 0x61,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x09,
-	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x1a, 0x69, 0x0a, 0x07, 0x41, 0x63, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x14,
-	0x0a, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6e,
-	0x6f, 0x6e, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x0d, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x61,
-	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x62, 0x61, 0x6c,
-	0x61, 0x6e, 0x63, 0x65, 0x1a, 0x94, 0x06, 0x0a, 0x08, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74,
-	0x79, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x73,
-	0x74, 0x61, 0x6b, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x73, 0x74, 0x61, 0x6b,
-	0x65, 0x12, 0x18, 0x0a, 0x07, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x07, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x62,
-	0x69, 0x72, 0x74, 0x68, 0x64, 0x61, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x62,
-	0x69, 0x72, 0x74, 0x68, 0x64, 0x61, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x26, 0x0a,
-	0x0e, 0x71, 0x75, 0x61, 0x6c, 0x69, 0x66, 0x69, 0x65, 0x64, 0x46, 0x6c, 0x69, 0x70, 0x73, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x71, 0x75, 0x61, 0x6c, 0x69, 0x66, 0x69, 0x65, 0x64,
-	0x46, 0x6c, 0x69, 0x70, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x46, 0x6c,
-	0x69, 0x70, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f,
-	0x73, 0x68, 0x6f, 0x72, 0x74, 0x46, 0x6c, 0x69, 0x70, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12,
-	0x16, 0x0a, 0x06, 0x70, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0c, 0x52,
-	0x06, 0x70, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x12, 0x24, 0x0a, 0x0d, 0x72, 0x65, 0x71, 0x75, 0x69,
-	0x72, 0x65, 0x64, 0x46, 0x6c, 0x69, 0x70, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d,
-	0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x46, 0x6c, 0x69, 0x70, 0x73, 0x12, 0x40, 0x0a,
-	0x05, 0x66, 0x6c, 0x69, 0x70, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x6d,
+	0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x08,
+	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x45, 0x0a, 0x0a, 0x69, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6d,
 	0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x72, 0x65, 0x64, 0x65,
 	0x66, 0x69, 0x6e, 0x65, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74,
-	0x69, 0x74, 0x79, 0x2e, 0x46, 0x6c, 0x69, 0x70, 0x52, 0x05, 0x66, 0x6c, 0x69, 0x70, 0x73, 0x12,
-	0x1e, 0x0a, 0x0a, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0b, 0x20,
-	0x01, 0x28, 0x0d, 0x52, 0x0a, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x63,
-	0x6f, 0x64, 0x65, 0x12, 0x48, 0x0a, 0x08, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x65, 0x73, 0x18,
-	0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50,
-	0x72, 0x6f, 0x74, 0x6f, 0x50, 0x72, 0x65, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x64, 0x53, 0x74,
-	0x61, 0x74, 0x65, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x54, 0x78, 0x41,
-	0x64, 0x64, 0x72, 0x52, 0x08, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x65, 0x73, 0x12, 0x46, 0x0a,
-	0x07, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x72, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c,
+	0x69, 0x74, 0x79, 0x52, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12,
+	0x5d, 0x0a, 0x12, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x49, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x72, 0x65, 0x64, 0x65, 0x66,
+	0x69, 0x6e, 0x65, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76,
+	0x65, 0x64, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x12, 0x61, 0x70, 0x70, 0x72,
+	0x6f, 0x76, 0x65, 0x64, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x1a, 0xdc,
+	0x04, 0x0a, 0x06, 0x47, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f,
+	0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12,
+	0x2e, 0x0a, 0x12, 0x6e, 0x65, 0x78, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x6e, 0x65, 0x78,
+	0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x12,
+	0x2a, 0x0a, 0x10, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x65, 0x72,
+	0x69, 0x6f, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x67,
+	0x6f, 0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x0a, 0x67, 0x6f, 0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x77,
+	0x6f, 0x72, 0x64, 0x73, 0x53, 0x65, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09,
+	0x77, 0x6f, 0x72, 0x64, 0x73, 0x53, 0x65, 0x65, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x6c, 0x61, 0x73,
+	0x74, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0c, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x1e, 0x0a,
+	0x0a, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0a, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x1e, 0x0a,
+	0x0a, 0x66, 0x65, 0x65, 0x50, 0x65, 0x72, 0x42, 0x79, 0x74, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x0a, 0x66, 0x65, 0x65, 0x50, 0x65, 0x72, 0x42, 0x79, 0x74, 0x65, 0x12, 0x32, 0x0a,
+	0x14, 0x76, 0x72, 0x66, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x54, 0x68, 0x72, 0x65,
+	0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x14, 0x76, 0x72, 0x66,
+	0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c,
+	0x64, 0x12, 0x28, 0x0a, 0x0f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73,
+	0x42, 0x69, 0x74, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0f, 0x65, 0x6d, 0x70, 0x74,
+	0x79, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x42, 0x69, 0x74, 0x73, 0x12, 0x2c, 0x0a, 0x11, 0x67,
+	0x6f, 0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x73,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x67, 0x6f, 0x64, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x73, 0x12, 0x44, 0x0a, 0x1d, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x73, 0x43, 0x6e, 0x74, 0x57, 0x69, 0x74, 0x68, 0x6f, 0x75, 0x74, 0x43, 0x65, 0x72,
+	0x65, 0x6d, 0x6f, 0x6e, 0x69, 0x61, 0x6c, 0x54, 0x78, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x1d, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x43, 0x6e, 0x74, 0x57, 0x69, 0x74, 0x68, 0x6f,
+	0x75, 0x74, 0x43, 0x65, 0x72, 0x65, 0x6d, 0x6f, 0x6e, 0x69, 0x61, 0x6c, 0x54, 0x78, 0x73, 0x12,
+	0x36, 0x0a, 0x16, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x63, 0x75, 0x74, 0x69, 0x76, 0x65, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x16, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x63, 0x75, 0x74, 0x69, 0x76, 0x65, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x34, 0x0a, 0x15, 0x72, 0x65, 0x63, 0x65, 0x6e,
+	0x74, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x42, 0x69, 0x74, 0x73,
+	0x18, 0x0e, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x15, 0x72, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x42, 0x69, 0x74, 0x73, 0x1a, 0x2c, 0x0a,
+	0x0c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x12, 0x1c, 0x0a,
+	0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c,
+	0x52, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x1a, 0x69, 0x0a, 0x07, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x18, 0x0a, 0x07,
+	0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x62,
+	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x1a, 0x94, 0x06, 0x0a, 0x08, 0x49, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a,
+	0x05, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x73, 0x74,
+	0x61, 0x6b, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x73, 0x12, 0x1a, 0x0a,
+	0x08, 0x62, 0x69, 0x72, 0x74, 0x68, 0x64, 0x61, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x08, 0x62, 0x69, 0x72, 0x74, 0x68, 0x64, 0x61, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61,
+	0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12,
+	0x26, 0x0a, 0x0e, 0x71, 0x75, 0x61, 0x6c, 0x69, 0x66, 0x69, 0x65, 0x64, 0x46, 0x6c, 0x69, 0x70,
+	0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x71, 0x75, 0x61, 0x6c, 0x69, 0x66, 0x69,
+	0x65, 0x64, 0x46, 0x6c, 0x69, 0x70, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x73, 0x68, 0x6f, 0x72, 0x74,
+	0x46, 0x6c, 0x69, 0x70, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0f, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x46, 0x6c, 0x69, 0x70, 0x50, 0x6f, 0x69, 0x6e, 0x74,
+	0x73, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x06, 0x70, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x12, 0x24, 0x0a, 0x0d, 0x72, 0x65, 0x71,
+	0x75, 0x69, 0x72, 0x65, 0x64, 0x46, 0x6c, 0x69, 0x70, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0d, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x46, 0x6c, 0x69, 0x70, 0x73, 0x12,
+	0x40, 0x0a, 0x05, 0x66, 0x6c, 0x69, 0x70, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a,
 	0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x72, 0x65,
 	0x64, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x49, 0x64, 0x65,
-	0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x54, 0x78, 0x41, 0x64, 0x64, 0x72, 0x52, 0x07, 0x69, 0x6e,
-	0x76, 0x69, 0x74, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79,
-	0x18, 0x0f, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x12,
-	0x26, 0x0a, 0x0e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x69, 0x74,
-	0x73, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x42, 0x69, 0x74, 0x73, 0x12, 0x2a, 0x0a, 0x10, 0x76, 0x61, 0x6c, 0x69, 0x64,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x11, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x10, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x48, 0x61,
-	0x73, 0x68, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c,
-	0x65, 0x48, 0x61, 0x73, 0x68, 0x1a, 0x2c, 0x0a, 0x04, 0x46, 0x6c, 0x69, 0x70, 0x12, 0x10, 0x0a,
-	0x03, 0x63, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x63, 0x69, 0x64, 0x12,
-	0x12, 0x0a, 0x04, 0x70, 0x61, 0x69, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x70,
-	0x61, 0x69, 0x72, 0x1a, 0x36, 0x0a, 0x06, 0x54, 0x78, 0x41, 0x64, 0x64, 0x72, 0x12, 0x12, 0x0a,
-	0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x68, 0x61, 0x73,
-	0x68, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x1a, 0x60, 0x0a, 0x10, 0x41,
-	0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12,
-	0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x70, 0x70,
-	0x72, 0x6f, 0x76, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x61, 0x70, 0x70,
-	0x72, 0x6f, 0x76, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x6f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x46, 0x6c, 0x69, 0x70, 0x52, 0x05, 0x66, 0x6c, 0x69, 0x70,
+	0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x48, 0x0a, 0x08, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x65,
+	0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73,
+	0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x72, 0x65, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x64,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x54,
+	0x78, 0x41, 0x64, 0x64, 0x72, 0x52, 0x08, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x65, 0x73, 0x12,
+	0x46, 0x0a, 0x07, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x72, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x2c, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50,
+	0x72, 0x65, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x49,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x54, 0x78, 0x41, 0x64, 0x64, 0x72, 0x52, 0x07,
+	0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x65, 0x6e, 0x61, 0x6c,
+	0x74, 0x79, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74,
+	0x79, 0x12, 0x26, 0x0a, 0x0e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42,
+	0x69, 0x74, 0x73, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x69, 0x74, 0x73, 0x12, 0x2a, 0x0a, 0x10, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x11, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x10, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
+	0x48, 0x61, 0x73, 0x68, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x66,
+	0x69, 0x6c, 0x65, 0x48, 0x61, 0x73, 0x68, 0x1a, 0x2c, 0x0a, 0x04, 0x46, 0x6c, 0x69, 0x70, 0x12,
+	0x10, 0x0a, 0x03, 0x63, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x63, 0x69,
+	0x64, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x69, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x04, 0x70, 0x61, 0x69, 0x72, 0x1a, 0x36, 0x0a, 0x06, 0x54, 0x78, 0x41, 0x64, 0x64, 0x72, 0x12,
+	0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x68,
+	0x61, 0x73, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x1a, 0x60, 0x0a,
+	0x10, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x61,
+	0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x61,
+	0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x6e, 0x6c, 0x69, 0x6e,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x6f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -5118,7 +5844,7 @@ func file_protobuf_models_proto_rawDescGZIP() []byte {
 	return file_protobuf_models_proto_rawDescData
 }
 
-var file_protobuf_models_proto_msgTypes = make([]protoimpl.MessageInfo, 68)
+var file_protobuf_models_proto_msgTypes = make([]protoimpl.MessageInfo, 75)
 var file_protobuf_models_proto_goTypes = []interface{}{
 	(*ProtoTransaction)(nil),                              // 0: models.ProtoTransaction
 	(*ProtoBlockHeader)(nil),                              // 1: models.ProtoBlockHeader
@@ -5150,86 +5876,97 @@ var file_protobuf_models_proto_goTypes = []interface{}{
 	(*ProtoShortAnswerDb)(nil),                            // 27: models.ProtoShortAnswerDb
 	(*ProtoAnswersDb)(nil),                                // 28: models.ProtoAnswersDb
 	(*ProtoBurntCoins)(nil),                               // 29: models.ProtoBurntCoins
-	(*ProtoSavedTransaction)(nil),                         // 30: models.ProtoSavedTransaction
-	(*ProtoActivityMonitor)(nil),                          // 31: models.ProtoActivityMonitor
-	(*ProtoShortAnswerAttachment)(nil),                    // 32: models.ProtoShortAnswerAttachment
-	(*ProtoLongAnswerAttachment)(nil),                     // 33: models.ProtoLongAnswerAttachment
-	(*ProtoFlipSubmitAttachment)(nil),                     // 34: models.ProtoFlipSubmitAttachment
-	(*ProtoOnlineStatusAttachment)(nil),                   // 35: models.ProtoOnlineStatusAttachment
-	(*ProtoBurnAttachment)(nil),                           // 36: models.ProtoBurnAttachment
-	(*ProtoChangeProfileAttachment)(nil),                  // 37: models.ProtoChangeProfileAttachment
-	(*ProtoDeleteFlipAttachment)(nil),                     // 38: models.ProtoDeleteFlipAttachment
-	(*ProtoStateAccount)(nil),                             // 39: models.ProtoStateAccount
-	(*ProtoStateIdentity)(nil),                            // 40: models.ProtoStateIdentity
-	(*ProtoStateGlobal)(nil),                              // 41: models.ProtoStateGlobal
-	(*ProtoStateApprovedIdentity)(nil),                    // 42: models.ProtoStateApprovedIdentity
-	(*ProtoStateIdentityStatusSwitch)(nil),                // 43: models.ProtoStateIdentityStatusSwitch
-	(*ProtoPredefinedState)(nil),                          // 44: models.ProtoPredefinedState
-	(*ProtoTransaction_Data)(nil),                         // 45: models.ProtoTransaction.Data
-	(*ProtoBlockHeader_Proposed)(nil),                     // 46: models.ProtoBlockHeader.Proposed
-	(*ProtoBlockHeader_Empty)(nil),                        // 47: models.ProtoBlockHeader.Empty
-	(*ProtoBlockProposal_Data)(nil),                       // 48: models.ProtoBlockProposal.Data
-	(*ProtoBlockCert_Signature)(nil),                      // 49: models.ProtoBlockCert.Signature
-	(*ProtoIdentityStateDiff_IdentityStateDiffValue)(nil), // 50: models.ProtoIdentityStateDiff.IdentityStateDiffValue
-	(*ProtoSnapshotBlock_KeyValue)(nil),                   // 51: models.ProtoSnapshotBlock.KeyValue
-	(*ProtoGossipBlockRange_Block)(nil),                   // 52: models.ProtoGossipBlockRange.Block
-	(*ProtoProposeProof_Data)(nil),                        // 53: models.ProtoProposeProof.Data
-	(*ProtoVote_Data)(nil),                                // 54: models.ProtoVote.Data
-	(*ProtoFlipKey_Data)(nil),                             // 55: models.ProtoFlipKey.Data
-	(*ProtoPrivateFlipKeysPackage_Data)(nil),              // 56: models.ProtoPrivateFlipKeysPackage.Data
-	(*ProtoAnswersDb_Answer)(nil),                         // 57: models.ProtoAnswersDb.Answer
-	(*ProtoActivityMonitor_Activity)(nil),                 // 58: models.ProtoActivityMonitor.Activity
-	(*ProtoStateIdentity_Flip)(nil),                       // 59: models.ProtoStateIdentity.Flip
-	(*ProtoStateIdentity_TxAddr)(nil),                     // 60: models.ProtoStateIdentity.TxAddr
-	(*ProtoPredefinedState_Global)(nil),                   // 61: models.ProtoPredefinedState.Global
-	(*ProtoPredefinedState_StatusSwitch)(nil),             // 62: models.ProtoPredefinedState.StatusSwitch
-	(*ProtoPredefinedState_Account)(nil),                  // 63: models.ProtoPredefinedState.Account
-	(*ProtoPredefinedState_Identity)(nil),                 // 64: models.ProtoPredefinedState.Identity
-	(*ProtoPredefinedState_ApprovedIdentity)(nil),         // 65: models.ProtoPredefinedState.ApprovedIdentity
-	(*ProtoPredefinedState_Identity_Flip)(nil),            // 66: models.ProtoPredefinedState.Identity.Flip
-	(*ProtoPredefinedState_Identity_TxAddr)(nil),          // 67: models.ProtoPredefinedState.Identity.TxAddr
+	(*ProtoFeeRecord)(nil),                                // 30: models.ProtoFeeRecord
+	(*ProtoSavedTransaction)(nil),                         // 31: models.ProtoSavedTransaction
+	(*ProtoActivityMonitor)(nil),                          // 32: models.ProtoActivityMonitor
+	(*ProtoShortAnswerAttachment)(nil),                    // 33: models.ProtoShortAnswerAttachment
+	(*ProtoLongAnswerAttachment)(nil),                     // 34: models.ProtoLongAnswerAttachment
+	(*ProtoFlipSubmitAttachment)(nil),                     // 35: models.ProtoFlipSubmitAttachment
+	(*ProtoOnlineStatusAttachment)(nil),                   // 36: models.ProtoOnlineStatusAttachment
+	(*ProtoBurnAttachment)(nil),                           // 37: models.ProtoBurnAttachment
+	(*ProtoChangeProfileAttachment)(nil),                  // 38: models.ProtoChangeProfileAttachment
+	(*ProtoDeleteFlipAttachment)(nil),                     // 39: models.ProtoDeleteFlipAttachment
+	(*ProtoParamChangeAttachment)(nil),                    // 40: models.ProtoParamChangeAttachment
+	(*ProtoStateAccount)(nil),                             // 41: models.ProtoStateAccount
+	(*ProtoStateIdentity)(nil),                            // 42: models.ProtoStateIdentity
+	(*ProtoStateGlobal)(nil),                              // 43: models.ProtoStateGlobal
+	(*ProtoStateApprovedIdentity)(nil),                    // 44: models.ProtoStateApprovedIdentity
+	(*ProtoStateIdentityStatusSwitch)(nil),                // 45: models.ProtoStateIdentityStatusSwitch
+	(*ProtoStateConsensusParams)(nil),                     // 46: models.ProtoStateConsensusParams
+	(*ProtoPredefinedState)(nil),                          // 47: models.ProtoPredefinedState
+	(*ProtoTransaction_Data)(nil),                         // 48: models.ProtoTransaction.Data
+	(*ProtoBlockHeader_Proposed)(nil),                     // 49: models.ProtoBlockHeader.Proposed
+	(*ProtoBlockHeader_Empty)(nil),                        // 50: models.ProtoBlockHeader.Empty
+	(*ProtoBlockProposal_Data)(nil),                       // 51: models.ProtoBlockProposal.Data
+	(*ProtoBlockCert_Signature)(nil),                      // 52: models.ProtoBlockCert.Signature
+	(*ProtoIdentityStateDiff_IdentityStateDiffValue)(nil), // 53: models.ProtoIdentityStateDiff.IdentityStateDiffValue
+	(*ProtoSnapshotBlock_KeyValue)(nil),                   // 54: models.ProtoSnapshotBlock.KeyValue
+	(*ProtoGossipBlockRange_Block)(nil),                   // 55: models.ProtoGossipBlockRange.Block
+	(*ProtoProposeProof_Data)(nil),                        // 56: models.ProtoProposeProof.Data
+	(*ProtoVote_Data)(nil),                                // 57: models.ProtoVote.Data
+	(*ProtoFlipKey_Data)(nil),                             // 58: models.ProtoFlipKey.Data
+	(*ProtoPrivateFlipKeysPackage_Data)(nil),              // 59: models.ProtoPrivateFlipKeysPackage.Data
+	(*ProtoAnswersDb_Answer)(nil),                         // 60: models.ProtoAnswersDb.Answer
+	(*ProtoActivityMonitor_Activity)(nil),                 // 61: models.ProtoActivityMonitor.Activity
+	(*ProtoStateAccount_PendingReward)(nil),               // 62: models.ProtoStateAccount.PendingReward
+	(*ProtoStateIdentity_Flip)(nil),                       // 63: models.ProtoStateIdentity.Flip
+	(*ProtoStateIdentity_TxAddr)(nil),                     // 64: models.ProtoStateIdentity.TxAddr
+	(*ProtoStateGlobal_PendingWithdrawal)(nil),            // 65: models.ProtoStateGlobal.PendingWithdrawal
+	(*ProtoStateConsensusParams_Param)(nil),               // 66: models.ProtoStateConsensusParams.Param
+	(*ProtoStateConsensusParams_Vote)(nil),                // 67: models.ProtoStateConsensusParams.Vote
+	(*ProtoPredefinedState_Global)(nil),                   // 68: models.ProtoPredefinedState.Global
+	(*ProtoPredefinedState_StatusSwitch)(nil),             // 69: models.ProtoPredefinedState.StatusSwitch
+	(*ProtoPredefinedState_Account)(nil),                  // 70: models.ProtoPredefinedState.Account
+	(*ProtoPredefinedState_Identity)(nil),                 // 71: models.ProtoPredefinedState.Identity
+	(*ProtoPredefinedState_ApprovedIdentity)(nil),         // 72: models.ProtoPredefinedState.ApprovedIdentity
+	(*ProtoPredefinedState_Identity_Flip)(nil),            // 73: models.ProtoPredefinedState.Identity.Flip
+	(*ProtoPredefinedState_Identity_TxAddr)(nil),          // 74: models.ProtoPredefinedState.Identity.TxAddr
 }
 var file_protobuf_models_proto_depIdxs = []int32{
-	45, // 0: models.ProtoTransaction.data:type_name -> models.ProtoTransaction.Data
-	46, // 1: models.ProtoBlockHeader.proposedHeader:type_name -> models.ProtoBlockHeader.Proposed
-	47, // 2: models.ProtoBlockHeader.emptyHeader:type_name -> models.ProtoBlockHeader.Empty
+	48, // 0: models.ProtoTransaction.data:type_name -> models.ProtoTransaction.Data
+	49, // 1: models.ProtoBlockHeader.proposedHeader:type_name -> models.ProtoBlockHeader.Proposed
+	50, // 2: models.ProtoBlockHeader.emptyHeader:type_name -> models.ProtoBlockHeader.Empty
 	0,  // 3: models.ProtoBlockBody.transactions:type_name -> models.ProtoTransaction
 	1,  // 4: models.ProtoBlock.header:type_name -> models.ProtoBlockHeader
 	2,  // 5: models.ProtoBlock.body:type_name -> models.ProtoBlockBody
-	48, // 6: models.ProtoBlockProposal.data:type_name -> models.ProtoBlockProposal.Data
-	49, // 7: models.ProtoBlockCert.signatures:type_name -> models.ProtoBlockCert.Signature
-	50, // 8: models.ProtoIdentityStateDiff.values:type_name -> models.ProtoIdentityStateDiff.IdentityStateDiffValue
-	51, // 9: models.ProtoSnapshotBlock.data:type_name -> models.ProtoSnapshotBlock.KeyValue
-	52, // 10: models.ProtoGossipBlockRange.blocks:type_name -> models.ProtoGossipBlockRange.Block
-	53, // 11: models.ProtoProposeProof.data:type_name -> models.ProtoProposeProof.Data
-	54, // 12: models.ProtoVote.data:type_name -> models.ProtoVote.Data
+	51, // 6: models.ProtoBlockProposal.data:type_name -> models.ProtoBlockProposal.Data
+	52, // 7: models.ProtoBlockCert.signatures:type_name -> models.ProtoBlockCert.Signature
+	53, // 8: models.ProtoIdentityStateDiff.values:type_name -> models.ProtoIdentityStateDiff.IdentityStateDiffValue
+	54, // 9: models.ProtoSnapshotBlock.data:type_name -> models.ProtoSnapshotBlock.KeyValue
+	55, // 10: models.ProtoGossipBlockRange.blocks:type_name -> models.ProtoGossipBlockRange.Block
+	56, // 11: models.ProtoProposeProof.data:type_name -> models.ProtoProposeProof.Data
+	57, // 12: models.ProtoVote.data:type_name -> models.ProtoVote.Data
 	0,  // 13: models.ProtoFlip.transaction:type_name -> models.ProtoTransaction
-	55, // 14: models.ProtoFlipKey.data:type_name -> models.ProtoFlipKey.Data
-	56, // 15: models.ProtoPrivateFlipKeysPackage.data:type_name -> models.ProtoPrivateFlipKeysPackage.Data
-	57, // 16: models.ProtoAnswersDb.answers:type_name -> models.ProtoAnswersDb.Answer
+	58, // 14: models.ProtoFlipKey.data:type_name -> models.ProtoFlipKey.Data
+	59, // 15: models.ProtoPrivateFlipKeysPackage.data:type_name -> models.ProtoPrivateFlipKeysPackage.Data
+	60, // 16: models.ProtoAnswersDb.answers:type_name -> models.ProtoAnswersDb.Answer
 	0,  // 17: models.ProtoSavedTransaction.tx:type_name -> models.ProtoTransaction
-	58, // 18: models.ProtoActivityMonitor.activities:type_name -> models.ProtoActivityMonitor.Activity
-	59, // 19: models.ProtoStateIdentity.flips:type_name -> models.ProtoStateIdentity.Flip
-	60, // 20: models.ProtoStateIdentity.invitees:type_name -> models.ProtoStateIdentity.TxAddr
-	60, // 21: models.ProtoStateIdentity.inviter:type_name -> models.ProtoStateIdentity.TxAddr
-	61, // 22: models.ProtoPredefinedState.global:type_name -> models.ProtoPredefinedState.Global
-	62, // 23: models.ProtoPredefinedState.statusSwitch:type_name -> models.ProtoPredefinedState.StatusSwitch
-	63, // 24: models.ProtoPredefinedState.accounts:type_name -> models.ProtoPredefinedState.Account
-	64, // 25: models.ProtoPredefinedState.identities:type_name -> models.ProtoPredefinedState.Identity
-	65, // 26: models.ProtoPredefinedState.approvedIdentities:type_name -> models.ProtoPredefinedState.ApprovedIdentity
-	1,  // 27: models.ProtoBlockProposal.Data.header:type_name -> models.ProtoBlockHeader
-	2,  // 28: models.ProtoBlockProposal.Data.body:type_name -> models.ProtoBlockBody
-	1,  // 29: models.ProtoGossipBlockRange.Block.header:type_name -> models.ProtoBlockHeader
-	6,  // 30: models.ProtoGossipBlockRange.Block.cert:type_name -> models.ProtoBlockCert
-	13, // 31: models.ProtoGossipBlockRange.Block.diff:type_name -> models.ProtoIdentityStateDiff
-	66, // 32: models.ProtoPredefinedState.Identity.flips:type_name -> models.ProtoPredefinedState.Identity.Flip
-	67, // 33: models.ProtoPredefinedState.Identity.invitees:type_name -> models.ProtoPredefinedState.Identity.TxAddr
-	67, // 34: models.ProtoPredefinedState.Identity.inviter:type_name -> models.ProtoPredefinedState.Identity.TxAddr
-	35, // [35:35] is the sub-list for method output_type
-	35, // [35:35] is the sub-list for method input_type
-	35, // [35:35] is the sub-list for extension type_name
-	35, // [35:35] is the sub-list for extension extendee
-	0,  // [0:35] is the sub-list for field type_name
+	61, // 18: models.ProtoActivityMonitor.activities:type_name -> models.ProtoActivityMonitor.Activity
+	62, // 19: models.ProtoStateAccount.pendingRewards:type_name -> models.ProtoStateAccount.PendingReward
+	63, // 20: models.ProtoStateIdentity.flips:type_name -> models.ProtoStateIdentity.Flip
+	64, // 21: models.ProtoStateIdentity.invitees:type_name -> models.ProtoStateIdentity.TxAddr
+	64, // 22: models.ProtoStateIdentity.inviter:type_name -> models.ProtoStateIdentity.TxAddr
+	65, // 23: models.ProtoStateGlobal.pendingWithdrawals:type_name -> models.ProtoStateGlobal.PendingWithdrawal
+	66, // 24: models.ProtoStateConsensusParams.params:type_name -> models.ProtoStateConsensusParams.Param
+	67, // 25: models.ProtoStateConsensusParams.votes:type_name -> models.ProtoStateConsensusParams.Vote
+	68, // 26: models.ProtoPredefinedState.global:type_name -> models.ProtoPredefinedState.Global
+	69, // 27: models.ProtoPredefinedState.statusSwitch:type_name -> models.ProtoPredefinedState.StatusSwitch
+	70, // 28: models.ProtoPredefinedState.accounts:type_name -> models.ProtoPredefinedState.Account
+	71, // 29: models.ProtoPredefinedState.identities:type_name -> models.ProtoPredefinedState.Identity
+	72, // 30: models.ProtoPredefinedState.approvedIdentities:type_name -> models.ProtoPredefinedState.ApprovedIdentity
+	1,  // 31: models.ProtoBlockProposal.Data.header:type_name -> models.ProtoBlockHeader
+	2,  // 32: models.ProtoBlockProposal.Data.body:type_name -> models.ProtoBlockBody
+	1,  // 33: models.ProtoGossipBlockRange.Block.header:type_name -> models.ProtoBlockHeader
+	6,  // 34: models.ProtoGossipBlockRange.Block.cert:type_name -> models.ProtoBlockCert
+	13, // 35: models.ProtoGossipBlockRange.Block.diff:type_name -> models.ProtoIdentityStateDiff
+	73, // 36: models.ProtoPredefinedState.Identity.flips:type_name -> models.ProtoPredefinedState.Identity.Flip
+	74, // 37: models.ProtoPredefinedState.Identity.invitees:type_name -> models.ProtoPredefinedState.Identity.TxAddr
+	74, // 38: models.ProtoPredefinedState.Identity.inviter:type_name -> models.ProtoPredefinedState.Identity.TxAddr
+	39, // [39:39] is the sub-list for method output_type
+	39, // [39:39] is the sub-list for method input_type
+	39, // [39:39] is the sub-list for extension type_name
+	39, // [39:39] is the sub-list for extension extendee
+	0,  // [0:39] is the sub-list for field type_name
 }
 
 func init() { file_protobuf_models_proto_init() }
@@ -5599,7 +6336,7 @@ func file_protobuf_models_proto_init() {
 			}
 		}
 		file_protobuf_models_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoSavedTransaction); i {
+			switch v := v.(*ProtoFeeRecord); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5611,7 +6348,7 @@ func file_protobuf_models_proto_init() {
 			}
 		}
 		file_protobuf_models_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoActivityMonitor); i {
+			switch v := v.(*ProtoSavedTransaction); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5623,7 +6360,7 @@ func file_protobuf_models_proto_init() {
 			}
 		}
 		file_protobuf_models_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoShortAnswerAttachment); i {
+			switch v := v.(*ProtoActivityMonitor); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5635,7 +6372,7 @@ func file_protobuf_models_proto_init() {
 			}
 		}
 		file_protobuf_models_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoLongAnswerAttachment); i {
+			switch v := v.(*ProtoShortAnswerAttachment); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5647,7 +6384,7 @@ func file_protobuf_models_proto_init() {
 			}
 		}
 		file_protobuf_models_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoFlipSubmitAttachment); i {
+			switch v := v.(*ProtoLongAnswerAttachment); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5659,7 +6396,7 @@ func file_protobuf_models_proto_init() {
 			}
 		}
 		file_protobuf_models_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoOnlineStatusAttachment); i {
+			switch v := v.(*ProtoFlipSubmitAttachment); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5671,7 +6408,7 @@ func file_protobuf_models_proto_init() {
 			}
 		}
 		file_protobuf_models_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoBurnAttachment); i {
+			switch v := v.(*ProtoOnlineStatusAttachment); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5683,7 +6420,7 @@ func file_protobuf_models_proto_init() {
 			}
 		}
 		file_protobuf_models_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoChangeProfileAttachment); i {
+			switch v := v.(*ProtoBurnAttachment); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5695,7 +6432,7 @@ func file_protobuf_models_proto_init() {
 			}
 		}
 		file_protobuf_models_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoDeleteFlipAttachment); i {
+			switch v := v.(*ProtoChangeProfileAttachment); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5707,7 +6444,7 @@ func file_protobuf_models_proto_init() {
 			}
 		}
 		file_protobuf_models_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoStateAccount); i {
+			switch v := v.(*ProtoDeleteFlipAttachment); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5719,7 +6456,7 @@ func file_protobuf_models_proto_init() {
 			}
 		}
 		file_protobuf_models_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoStateIdentity); i {
+			switch v := v.(*ProtoParamChangeAttachment); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5731,7 +6468,7 @@ func file_protobuf_models_proto_init() {
 			}
 		}
 		file_protobuf_models_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoStateGlobal); i {
+			switch v := v.(*ProtoStateAccount); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5743,7 +6480,7 @@ func file_protobuf_models_proto_init() {
 			}
 		}
 		file_protobuf_models_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoStateApprovedIdentity); i {
+			switch v := v.(*ProtoStateIdentity); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5755,7 +6492,7 @@ func file_protobuf_models_proto_init() {
 			}
 		}
 		file_protobuf_models_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoStateIdentityStatusSwitch); i {
+			switch v := v.(*ProtoStateGlobal); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5767,7 +6504,7 @@ func file_protobuf_models_proto_init() {
 			}
 		}
 		file_protobuf_models_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoPredefinedState); i {
+			switch v := v.(*ProtoStateApprovedIdentity); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5779,7 +6516,7 @@ func file_protobuf_models_proto_init() {
 			}
 		}
 		file_protobuf_models_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoTransaction_Data); i {
+			switch v := v.(*ProtoStateIdentityStatusSwitch); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5791,7 +6528,7 @@ func file_protobuf_models_proto_init() {
 			}
 		}
 		file_protobuf_models_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoBlockHeader_Proposed); i {
+			switch v := v.(*ProtoStateConsensusParams); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5803,7 +6540,7 @@ func file_protobuf_models_proto_init() {
 			}
 		}
 		file_protobuf_models_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoBlockHeader_Empty); i {
+			switch v := v.(*ProtoPredefinedState); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5815,7 +6552,7 @@ func file_protobuf_models_proto_init() {
 			}
 		}
 		file_protobuf_models_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoBlockProposal_Data); i {
+			switch v := v.(*ProtoTransaction_Data); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5827,7 +6564,7 @@ func file_protobuf_models_proto_init() {
 			}
 		}
 		file_protobuf_models_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoBlockCert_Signature); i {
+			switch v := v.(*ProtoBlockHeader_Proposed); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5839,7 +6576,7 @@ func file_protobuf_models_proto_init() {
 			}
 		}
 		file_protobuf_models_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoIdentityStateDiff_IdentityStateDiffValue); i {
+			switch v := v.(*ProtoBlockHeader_Empty); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5851,7 +6588,7 @@ func file_protobuf_models_proto_init() {
 			}
 		}
 		file_protobuf_models_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoSnapshotBlock_KeyValue); i {
+			switch v := v.(*ProtoBlockProposal_Data); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5863,7 +6600,7 @@ func file_protobuf_models_proto_init() {
 			}
 		}
 		file_protobuf_models_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoGossipBlockRange_Block); i {
+			switch v := v.(*ProtoBlockCert_Signature); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5875,7 +6612,7 @@ func file_protobuf_models_proto_init() {
 			}
 		}
 		file_protobuf_models_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoProposeProof_Data); i {
+			switch v := v.(*ProtoIdentityStateDiff_IdentityStateDiffValue); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5887,7 +6624,7 @@ func file_protobuf_models_proto_init() {
 			}
 		}
 		file_protobuf_models_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoVote_Data); i {
+			switch v := v.(*ProtoSnapshotBlock_KeyValue); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5899,7 +6636,7 @@ func file_protobuf_models_proto_init() {
 			}
 		}
 		file_protobuf_models_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoFlipKey_Data); i {
+			switch v := v.(*ProtoGossipBlockRange_Block); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5911,7 +6648,7 @@ func file_protobuf_models_proto_init() {
 			}
 		}
 		file_protobuf_models_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoPrivateFlipKeysPackage_Data); i {
+			switch v := v.(*ProtoProposeProof_Data); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5923,7 +6660,7 @@ func file_protobuf_models_proto_init() {
 			}
 		}
 		file_protobuf_models_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoAnswersDb_Answer); i {
+			switch v := v.(*ProtoVote_Data); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5935,7 +6672,7 @@ func file_protobuf_models_proto_init() {
 			}
 		}
 		file_protobuf_models_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoActivityMonitor_Activity); i {
+			switch v := v.(*ProtoFlipKey_Data); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5947,7 +6684,7 @@ func file_protobuf_models_proto_init() {
 			}
 		}
 		file_protobuf_models_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoStateIdentity_Flip); i {
+			switch v := v.(*ProtoPrivateFlipKeysPackage_Data); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5959,7 +6696,7 @@ func file_protobuf_models_proto_init() {
 			}
 		}
 		file_protobuf_models_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoStateIdentity_TxAddr); i {
+			switch v := v.(*ProtoAnswersDb_Answer); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5971,7 +6708,7 @@ func file_protobuf_models_proto_init() {
 			}
 		}
 		file_protobuf_models_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoPredefinedState_Global); i {
+			switch v := v.(*ProtoActivityMonitor_Activity); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5983,7 +6720,7 @@ func file_protobuf_models_proto_init() {
 			}
 		}
 		file_protobuf_models_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoPredefinedState_StatusSwitch); i {
+			switch v := v.(*ProtoStateAccount_PendingReward); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5995,7 +6732,7 @@ func file_protobuf_models_proto_init() {
 			}
 		}
 		file_protobuf_models_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoPredefinedState_Account); i {
+			switch v := v.(*ProtoStateIdentity_Flip); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6007,7 +6744,7 @@ func file_protobuf_models_proto_init() {
 			}
 		}
 		file_protobuf_models_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoPredefinedState_Identity); i {
+			switch v := v.(*ProtoStateIdentity_TxAddr); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6019,7 +6756,7 @@ func file_protobuf_models_proto_init() {
 			}
 		}
 		file_protobuf_models_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoPredefinedState_ApprovedIdentity); i {
+			switch v := v.(*ProtoStateGlobal_PendingWithdrawal); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6031,7 +6768,7 @@ func file_protobuf_models_proto_init() {
 			}
 		}
 		file_protobuf_models_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoPredefinedState_Identity_Flip); i {
+			switch v := v.(*ProtoStateConsensusParams_Param); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6043,6 +6780,90 @@ func file_protobuf_models_proto_init() {
 			}
 		}
 		file_protobuf_models_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtoStateConsensusParams_Vote); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_models_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtoPredefinedState_Global); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_models_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtoPredefinedState_StatusSwitch); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_models_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtoPredefinedState_Account); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_models_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtoPredefinedState_Identity); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_models_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtoPredefinedState_ApprovedIdentity); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_models_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtoPredefinedState_Identity_Flip); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_models_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ProtoPredefinedState_Identity_TxAddr); i {
 			case 0:
 				return &v.state
@@ -6061,7 +6882,7 @@ func file_protobuf_models_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_protobuf_models_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   68,
+			NumMessages:   75,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
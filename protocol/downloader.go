@@ -70,7 +70,7 @@ func (d *Downloader) IsSyncing() bool {
 }
 
 func (d *Downloader) SyncProgress() (head uint64, top uint64) {
-	height := d.chain.Head.Height()
+	height := d.chain.GetCurrentHead().Height()
 	if d.chain.PreliminaryHead != nil {
 		height = math.Max(height, d.chain.PreliminaryHead.Height())
 	}
@@ -138,7 +138,7 @@ func (d *Downloader) SyncBlockchain(forkResolver ForkResolver) error {
 			return errors.New("all connected peers are in fork")
 		}
 
-		head := d.chain.Head
+		head := d.chain.GetCurrentHead()
 		d.top = getTopHeight(knownHeights)
 		if head.Height() >= d.top {
 			d.log.Info(fmt.Sprintf("Node is synchronized"))
@@ -154,7 +154,7 @@ func (d *Downloader) SyncBlockchain(forkResolver ForkResolver) error {
 
 func (d *Downloader) Load() {
 
-	head := d.chain.Head
+	head := d.chain.GetCurrentHead()
 
 	applier, toHeight := d.createBlockApplier()
 
@@ -271,13 +271,13 @@ func (d *Downloader) createBlockApplier() (loader blockApplier, toHeight uint64)
 
 	canUseFastSync := d.cfg.Sync.FastSync
 
-	if d.top-d.chain.Head.Height() < d.cfg.Sync.ForceFullSync {
+	if d.top-d.chain.GetCurrentHead().Height() < d.cfg.Sync.ForceFullSync {
 		canUseFastSync = false
 	}
 	var manifest *snapshot.Manifest
 	if canUseFastSync {
 		manifest = d.getBestManifest()
-		if manifest == nil || d.chain.Head.Height() > manifest.Height || manifest.Height-d.chain.Head.Height() < d.cfg.Sync.ForceFullSync {
+		if manifest == nil || d.chain.GetCurrentHead().Height() > manifest.Height || manifest.Height-d.chain.GetCurrentHead().Height() < d.cfg.Sync.ForceFullSync {
 			canUseFastSync = false
 		}
 	}
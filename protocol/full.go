@@ -82,7 +82,7 @@ func (fs *fullSync) applyDeferredBlocks(checkState *appstate.AppState) (uint64,
 				}
 			}*/
 			if err := fs.chain.AddBlock(block, checkState, fs.statsCollector); err != nil {
-				if err := fs.appState.ResetTo(fs.chain.Head.Height()); err != nil {
+				if err := fs.appState.ResetTo(fs.chain.GetCurrentHead().Height()); err != nil {
 					return block.Height(), err
 				}
 				if errors.Cause(err) != blockchain.BlockInsertionErr {
@@ -129,7 +129,7 @@ func (fs *fullSync) processBatch(batch *batch, attemptNum int) error {
 		return errors.New("number of attempts exceeded limit")
 	}
 
-	checkState, err := fs.appState.ForCheckWithOverwrite(fs.chain.Head.Height())
+	checkState, err := fs.appState.ForCheckWithOverwrite(fs.chain.GetCurrentHead().Height())
 	if err != nil {
 		return err
 	}
@@ -181,7 +181,7 @@ func (fs *fullSync) processBatch(batch *batch, attemptNum int) error {
 }
 
 func (fs *fullSync) validateHeader(block *block, p *protoPeer) error {
-	prevBlock := fs.chain.Head
+	prevBlock := fs.chain.GetCurrentHead()
 	if len(fs.deferredHeaders) > 0 {
 		prevBlock = fs.deferredHeaders[len(fs.deferredHeaders)-1].Header
 	}
@@ -221,8 +221,25 @@ func NewHTTPServer(cors []string, vhosts []string, timeouts HTTPTimeouts, srv *S
 	}
 }
 
+// RegisterHTTPHandler serves plain HTTP requests to path with handler instead of JSON-RPC
+func (srv *Server) RegisterHTTPHandler(path string, handler http.Handler) {
+	srv.httpHandlersMu.Lock()
+	defer srv.httpHandlersMu.Unlock()
+	if srv.httpHandlers == nil {
+		srv.httpHandlers = make(map[string]http.Handler)
+	}
+	srv.httpHandlers[path] = handler
+}
+
 // ServeHTTP serves JSON-RPC requests over HTTP.
 func (srv *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	srv.httpHandlersMu.RLock()
+	handler, ok := srv.httpHandlers[r.URL.Path]
+	srv.httpHandlersMu.RUnlock()
+	if ok {
+		handler.ServeHTTP(w, r)
+		return
+	}
 	// Permit dumb empty requests for remote health-checks (AWS)
 	if r.Method == http.MethodGet && r.ContentLength == 0 && r.URL.RawQuery == "" {
 		return
@@ -52,3 +52,22 @@ func testHTTPErrorResponse(t *testing.T, method, contentType, body string, expec
 		t.Fatalf("response code should be %d not %d", expected, code)
 	}
 }
+
+func TestServer_RegisterHTTPHandler(t *testing.T) {
+	srv := NewServer("")
+	srv.RegisterHTTPHandler("/debug/metrics", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("metrics"))
+	}))
+
+	recorder := httptest.NewRecorder()
+	srv.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "http://url.com/debug/metrics", nil))
+	if body := recorder.Body.String(); body != "metrics" {
+		t.Fatalf("unexpected response %q", body)
+	}
+
+	recorder = httptest.NewRecorder()
+	srv.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, "http://url.com/", strings.NewReader("")))
+	if recorder.Code != http.StatusUnsupportedMediaType {
+		t.Fatalf("response code should be %d not %d", http.StatusUnsupportedMediaType, recorder.Code)
+	}
+}
@@ -19,6 +19,7 @@ package rpc
 import (
 	"fmt"
 	"math"
+	"net/http"
 	"reflect"
 	"strings"
 	"sync"
@@ -75,6 +76,9 @@ type Server struct {
 	run      int32
 	codecsMu sync.Mutex
 	codecs   mapset.Set
+
+	httpHandlersMu sync.RWMutex
+	httpHandlers   map[string]http.Handler
 }
 
 // rpcRequest represents a raw incoming RPC request
@@ -82,7 +82,7 @@ func TestTxPool_BuildBlockTransactions2(t *testing.T) {
 	app.Commit(nil)
 
 	block := chain.GenerateEmptyBlock()
-	chain.Head = block.Header
+	chain.SetCurrentHead(block.Header)
 	pool.ResetTo(block)
 
 	for _, key := range keys {
@@ -159,7 +159,7 @@ func TestTxPool_InvalidEpoch(t *testing.T) {
 	app.Commit(nil)
 
 	// need to emulate new block
-	chain.Head.ProposedHeader.Height++
+	chain.GetCurrentHead().ProposedHeader.Height++
 
 	app.Commit(nil)
 	err := pool.Add(GetTx(1, 1, key))
@@ -265,11 +265,16 @@ func TestTxPool_ResetTo(t *testing.T) {
 
 	txs := pool.GetPendingTransaction()
 
+	// the pool stores copies of added txs
+	var hashes []common.Hash
+	for _, tx := range txs {
+		hashes = append(hashes, tx.Hash())
+	}
 	require.Equal(4, len(txs))
-	require.Contains(txs, tx1)
-	require.Contains(txs, tx2)
-	require.Contains(txs, tx5)
-	require.Contains(txs, tx9)
+	require.Contains(hashes, tx1.Hash())
+	require.Contains(hashes, tx2.Hash())
+	require.Contains(hashes, tx5.Hash())
+	require.Contains(hashes, tx9.Hash())
 
 	require.Equal(uint32(2), app.NonceCache.GetNonce(addr, 1))
 	require.Equal(uint32(1), app.NonceCache.GetNonce(addr2, 1))
@@ -304,7 +309,7 @@ func TestTxPool_BuildBlockTransactionsWithPriorityTypes(t *testing.T) {
 	app.Commit(nil)
 
 	block := chain.GenerateEmptyBlock()
-	chain.Head = block.Header
+	chain.SetCurrentHead(block.Header)
 	pool.ResetTo(block)
 
 	addressIndex := 0
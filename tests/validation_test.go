@@ -11,7 +11,7 @@ import (
 )
 
 func Test_BigFeeTx(t *testing.T) {
-	_, appState, _, _ := blockchain.NewTestBlockchain(true, nil)
+	chain, appState, _, _ := blockchain.NewTestBlockchain(true, nil)
 
 	key, _ := crypto.GenerateKey()
 	sender := crypto.PubkeyToAddress(key.PublicKey)
@@ -30,16 +30,16 @@ func Test_BigFeeTx(t *testing.T) {
 	minFeePerByte := big.NewInt(1)
 
 	appState.State.SetFeePerByte(big.NewInt(2))
-	require.Nil(t, validation.ValidateTx(appState, signedTx, minFeePerByte, validation.InboundTx))
-	require.Equal(t, validation.BigFee, validation.ValidateTx(appState, signedTx, minFeePerByte, validation.InBlockTx))
+	require.Nil(t, validation.ValidateTx(appState, signedTx, minFeePerByte, validation.InboundTx, chain.Config().Consensus))
+	require.Equal(t, validation.BigFee, validation.ValidateTx(appState, signedTx, minFeePerByte, validation.InBlockTx, chain.Config().Consensus))
 
 	appState.State.SetFeePerByte(big.NewInt(1))
-	require.Nil(t, validation.ValidateTx(appState, signedTx, minFeePerByte, validation.InboundTx))
-	require.Nil(t, validation.ValidateTx(appState, signedTx, minFeePerByte, validation.InBlockTx))
+	require.Nil(t, validation.ValidateTx(appState, signedTx, minFeePerByte, validation.InboundTx, chain.Config().Consensus))
+	require.Nil(t, validation.ValidateTx(appState, signedTx, minFeePerByte, validation.InBlockTx, chain.Config().Consensus))
 }
 
 func Test_InvalidMaxFeeTx(t *testing.T) {
-	_, appState, _, _ := blockchain.NewTestBlockchain(true, nil)
+	chain, appState, _, _ := blockchain.NewTestBlockchain(true, nil)
 
 	key, _ := crypto.GenerateKey()
 	sender := crypto.PubkeyToAddress(key.PublicKey)
@@ -57,10 +57,10 @@ func Test_InvalidMaxFeeTx(t *testing.T) {
 	signedTx, _ := types.SignTx(tx, key) // tx size 97
 
 	// 97 * 1 < 190
-	require.Nil(t, validation.ValidateTx(appState, signedTx, big.NewInt(1), validation.InboundTx))
-	require.Nil(t, validation.ValidateTx(appState, signedTx, big.NewInt(1), validation.InBlockTx))
+	require.Nil(t, validation.ValidateTx(appState, signedTx, big.NewInt(1), validation.InboundTx, chain.Config().Consensus))
+	require.Nil(t, validation.ValidateTx(appState, signedTx, big.NewInt(1), validation.InBlockTx, chain.Config().Consensus))
 
 	// 97 * 2 > 190
-	require.Equal(t, validation.InvalidMaxFee, validation.ValidateTx(appState, signedTx, big.NewInt(2), validation.InBlockTx))
-	require.Equal(t, validation.InvalidMaxFee, validation.ValidateTx(appState, signedTx, big.NewInt(2), validation.InBlockTx))
+	require.Equal(t, validation.InvalidMaxFee, validation.ValidateTx(appState, signedTx, big.NewInt(2), validation.InBlockTx, chain.Config().Consensus))
+	require.Equal(t, validation.InvalidMaxFee, validation.ValidateTx(appState, signedTx, big.NewInt(2), validation.InBlockTx, chain.Config().Consensus))
 }